@@ -18,14 +18,28 @@ SET row_security = off;
 
 ALTER TABLE ONLY public.reports DROP CONSTRAINT reports_fk_1;
 ALTER TABLE ONLY public.reports DROP CONSTRAINT reports_fk;
+ALTER TABLE ONLY public.pairs DROP CONSTRAINT pairs_section_fk;
 ALTER TABLE ONLY public.pairs DROP CONSTRAINT pairs_fk;
 ALTER TABLE ONLY public.followers DROP CONSTRAINT followers_users_follower;
 ALTER TABLE ONLY public.followers DROP CONSTRAINT followers_users_followed;
+ALTER TABLE ONLY public.follow_requests DROP CONSTRAINT follow_requests_users_follower;
+ALTER TABLE ONLY public.follow_requests DROP CONSTRAINT follow_requests_users_followed;
 ALTER TABLE ONLY public.comments DROP CONSTRAINT comments_user_fk;
 ALTER TABLE ONLY public.comments DROP CONSTRAINT comments_pin_fk;
+ALTER TABLE ONLY public.comments DROP CONSTRAINT comments_parent_fk;
 ALTER TABLE ONLY public.boards DROP CONSTRAINT boards_fk;
+ALTER TABLE ONLY public.board_sections DROP CONSTRAINT board_sections_boards;
+ALTER TABLE ONLY public.board_followers DROP CONSTRAINT board_followers_users;
+ALTER TABLE ONLY public.board_followers DROP CONSTRAINT board_followers_boards;
+ALTER TABLE ONLY public.board_collaborators DROP CONSTRAINT board_collaborators_users;
+ALTER TABLE ONLY public.board_collaborators DROP CONSTRAINT board_collaborators_boards;
+ALTER TABLE ONLY public.boards DROP CONSTRAINT boards_cover_pin_fk;
+ALTER TABLE ONLY public.blocks DROP CONSTRAINT blocks_users_blocker;
+ALTER TABLE ONLY public.blocks DROP CONSTRAINT blocks_users_blocked;
 DROP INDEX public.users_vk_id_idx;
 DROP INDEX public.users_un_avatar;
+DROP INDEX public.comments_pin_threads_idx;
+DROP INDEX public.comments_parentid_idx;
 ALTER TABLE ONLY public.users DROP CONSTRAINT users_un_username;
 ALTER TABLE ONLY public.users DROP CONSTRAINT users_un_email;
 ALTER TABLE ONLY public.boards DROP CONSTRAINT users_un_boards;
@@ -33,13 +47,20 @@ ALTER TABLE ONLY public.users DROP CONSTRAINT users_pk_id;
 ALTER TABLE ONLY public.pins DROP CONSTRAINT pins_pk_pinid;
 ALTER TABLE ONLY public.reports DROP CONSTRAINT one_pin_per_sender;
 ALTER TABLE ONLY public.followers DROP CONSTRAINT followers_pk;
+ALTER TABLE ONLY public.follow_requests DROP CONSTRAINT follow_requests_pk;
 ALTER TABLE ONLY public.comments DROP CONSTRAINT comments_pk_id;
 ALTER TABLE ONLY public.boards DROP CONSTRAINT boards_pk_oardid;
+ALTER TABLE ONLY public.board_sections DROP CONSTRAINT board_sections_un_title;
+ALTER TABLE ONLY public.board_sections DROP CONSTRAINT board_sections_pk;
+ALTER TABLE ONLY public.board_followers DROP CONSTRAINT board_followers_pk;
+ALTER TABLE ONLY public.board_collaborators DROP CONSTRAINT board_collaborators_pk;
+ALTER TABLE ONLY public.blocks DROP CONSTRAINT blocks_pk;
 ALTER TABLE public.users ALTER COLUMN userid DROP DEFAULT;
 ALTER TABLE public.reports ALTER COLUMN reportid DROP DEFAULT;
 ALTER TABLE public.pins ALTER COLUMN pinid DROP DEFAULT;
 ALTER TABLE public.comments ALTER COLUMN id DROP DEFAULT;
 ALTER TABLE public.boards ALTER COLUMN boardid DROP DEFAULT;
+ALTER TABLE public.board_sections ALTER COLUMN sectionid DROP DEFAULT;
 DROP SEQUENCE public.users_userid_seq;
 DROP TABLE public.users;
 DROP SEQUENCE public.reports_reportid_seq;
@@ -48,14 +69,136 @@ DROP SEQUENCE public.pins_pinid_seq;
 DROP TABLE public.pins;
 DROP TABLE public.pairs;
 DROP TABLE public.followers;
+DROP TABLE public.follow_requests;
 DROP SEQUENCE public.comments_id_seq;
 DROP TABLE public.comments;
 DROP SEQUENCE public.boards_boardid_seq;
 DROP TABLE public.boards;
+DROP SEQUENCE public.board_sections_sectionid_seq;
+DROP TABLE public.board_sections;
+DROP TABLE public.board_followers;
+DROP TABLE public.board_collaborators;
+DROP TABLE public.blocks;
 SET default_tablespace = '';
 
 SET default_table_access_method = heap;
 
+--
+-- Name: blocks; Type: TABLE; Schema: public; Owner: postgres
+--
+
+CREATE TABLE public.blocks (
+                               blockerid integer NOT NULL,
+                               blockedid integer NOT NULL
+);
+
+
+ALTER TABLE public.blocks OWNER TO postgres;
+
+--
+-- Name: COLUMN blocks.blockerid; Type: COMMENT; Schema: public; Owner: postgres
+--
+
+COMMENT ON COLUMN public.blocks.blockerid IS 'User who blocked';
+
+
+--
+-- Name: COLUMN blocks.blockedid; Type: COMMENT; Schema: public; Owner: postgres
+--
+
+COMMENT ON COLUMN public.blocks.blockedid IS 'User who is blocked';
+
+
+--
+-- Name: board_collaborators; Type: TABLE; Schema: public; Owner: postgres
+--
+
+CREATE TABLE public.board_collaborators (
+                               boardid integer NOT NULL,
+                               userid integer NOT NULL,
+                               role character varying(6) NOT NULL,
+                               is_accepted boolean DEFAULT false NOT NULL
+);
+
+
+ALTER TABLE public.board_collaborators OWNER TO postgres;
+
+--
+-- Name: COLUMN board_collaborators.role; Type: COMMENT; Schema: public; Owner: postgres
+--
+
+COMMENT ON COLUMN public.board_collaborators.role IS 'editor or viewer';
+
+
+--
+-- Name: COLUMN board_collaborators.is_accepted; Type: COMMENT; Schema: public; Owner: postgres
+--
+
+COMMENT ON COLUMN public.board_collaborators.is_accepted IS 'False while invitation is pending';
+
+
+--
+-- Name: board_followers; Type: TABLE; Schema: public; Owner: postgres
+--
+
+CREATE TABLE public.board_followers (
+                               boardid integer NOT NULL,
+                               userid integer NOT NULL
+);
+
+
+ALTER TABLE public.board_followers OWNER TO postgres;
+
+--
+-- Name: TABLE board_followers; Type: COMMENT; Schema: public; Owner: postgres
+--
+
+COMMENT ON TABLE public.board_followers IS 'Users who follow single boards instead of their owners';
+
+
+--
+-- Name: board_sections; Type: TABLE; Schema: public; Owner: postgres
+--
+
+CREATE TABLE public.board_sections (
+                               sectionid integer NOT NULL,
+                               boardid integer NOT NULL,
+                               title character varying(100) NOT NULL,
+                               "position" integer DEFAULT 0 NOT NULL
+);
+
+
+ALTER TABLE public.board_sections OWNER TO postgres;
+
+--
+-- Name: TABLE board_sections; Type: COMMENT; Schema: public; Owner: postgres
+--
+
+COMMENT ON TABLE public.board_sections IS 'Named groups of pins inside boards';
+
+
+--
+-- Name: board_sections_sectionid_seq; Type: SEQUENCE; Schema: public; Owner: postgres
+--
+
+CREATE SEQUENCE public.board_sections_sectionid_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+ALTER TABLE public.board_sections_sectionid_seq OWNER TO postgres;
+
+--
+-- Name: board_sections_sectionid_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: postgres
+--
+
+ALTER SEQUENCE public.board_sections_sectionid_seq OWNED BY public.board_sections.sectionid;
+
+
 --
 -- Name: boards; Type: TABLE; Schema: public; Owner: postgres
 --
@@ -68,7 +211,12 @@ CREATE TABLE public.boards (
                                imagelink character varying(70) DEFAULT 'assets/img/default-board-avatar.jpg'::character varying NOT NULL,
                                imageheight integer DEFAULT 480 NOT NULL,
                                imagewidth integer DEFAULT 1200 NOT NULL,
-                               imageavgcolor character(6) DEFAULT '5a5a5a'::bpchar NOT NULL
+                               imageavgcolor character(6) DEFAULT '5a5a5a'::bpchar NOT NULL,
+                               coverpinid integer,
+                               is_archived boolean DEFAULT false NOT NULL,
+                               is_secret boolean DEFAULT false NOT NULL,
+                               layout_version integer DEFAULT 0 NOT NULL,
+                               followers_count integer DEFAULT 0 NOT NULL
 );
 
 
@@ -81,6 +229,34 @@ ALTER TABLE public.boards OWNER TO postgres;
 COMMENT ON TABLE public.boards IS 'Boards that users have created';
 
 
+--
+-- Name: COLUMN boards.coverpinid; Type: COMMENT; Schema: public; Owner: postgres
+--
+
+COMMENT ON COLUMN public.boards.coverpinid IS 'Pin chosen as board''s cover, NULL if last pin is used';
+
+
+--
+-- Name: COLUMN boards.is_secret; Type: COMMENT; Schema: public; Owner: postgres
+--
+
+COMMENT ON COLUMN public.boards.is_secret IS 'Secret boards and pins which are only on secret boards are seen only by board''s owner';
+
+
+--
+-- Name: COLUMN boards.layout_version; Type: COMMENT; Schema: public; Owner: postgres
+--
+
+COMMENT ON COLUMN public.boards.layout_version IS 'Is increased on every change of board''s pins, sections or their order';
+
+
+--
+-- Name: COLUMN boards.followers_count; Type: COMMENT; Schema: public; Owner: postgres
+--
+
+COMMENT ON COLUMN public.boards.followers_count IS 'Number of rows in board_followers for this board';
+
+
 --
 -- Name: boards_boardid_seq; Type: SEQUENCE; Schema: public; Owner: postgres
 --
@@ -111,12 +287,37 @@ CREATE TABLE public.comments (
                                  userid integer NOT NULL,
                                  pinid integer NOT NULL,
                                  id integer NOT NULL,
-                                 text text NOT NULL
+                                 text text NOT NULL,
+                                 creationdate timestamp(0) without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+                                 editdate timestamp(0) without time zone,
+                                 parentid integer,
+                                 replies_count integer DEFAULT 0 NOT NULL
 );
 
 
 ALTER TABLE public.comments OWNER TO postgres;
 
+--
+-- Name: COLUMN comments.editdate; Type: COMMENT; Schema: public; Owner: postgres
+--
+
+COMMENT ON COLUMN public.comments.editdate IS 'Time of last edit, NULL if comment was never edited';
+
+
+--
+-- Name: COLUMN comments.parentid; Type: COMMENT; Schema: public; Owner: postgres
+--
+
+COMMENT ON COLUMN public.comments.parentid IS 'Thread''s root comment, NULL if comment is not a reply';
+
+
+--
+-- Name: COLUMN comments.replies_count; Type: COMMENT; Schema: public; Owner: postgres
+--
+
+COMMENT ON COLUMN public.comments.replies_count IS 'Number of replies in comment''s thread';
+
+
 --
 -- Name: comments_id_seq; Type: SEQUENCE; Schema: public; Owner: postgres
 --
@@ -139,6 +340,32 @@ ALTER TABLE public.comments_id_seq OWNER TO postgres;
 ALTER SEQUENCE public.comments_id_seq OWNED BY public.comments.id;
 
 
+--
+-- Name: follow_requests; Type: TABLE; Schema: public; Owner: postgres
+--
+
+CREATE TABLE public.follow_requests (
+                                        followerid integer NOT NULL,
+                                        followedid integer NOT NULL
+);
+
+
+ALTER TABLE public.follow_requests OWNER TO postgres;
+
+--
+-- Name: COLUMN follow_requests.followerid; Type: COMMENT; Schema: public; Owner: postgres
+--
+
+COMMENT ON COLUMN public.follow_requests.followerid IS 'User who wants to follow';
+
+
+--
+-- Name: COLUMN follow_requests.followedid; Type: COMMENT; Schema: public; Owner: postgres
+--
+
+COMMENT ON COLUMN public.follow_requests.followedid IS 'User with private account who has to accept request';
+
+
 --
 -- Name: followers; Type: TABLE; Schema: public; Owner: postgres
 --
@@ -171,7 +398,9 @@ COMMENT ON COLUMN public.followers.followedid IS 'User who is followed';
 
 CREATE TABLE public.pairs (
                               boardid integer NOT NULL,
-                              pinid integer NOT NULL
+                              pinid integer NOT NULL,
+                              sectionid integer,
+                              "position" integer DEFAULT 0 NOT NULL
 );
 
 
@@ -184,6 +413,20 @@ ALTER TABLE public.pairs OWNER TO postgres;
 COMMENT ON TABLE public.pairs IS 'Pairs board-pin that users have created';
 
 
+--
+-- Name: COLUMN pairs.sectionid; Type: COMMENT; Schema: public; Owner: postgres
+--
+
+COMMENT ON COLUMN public.pairs.sectionid IS 'Board''s section pin is in, NULL if pin is not in any section';
+
+
+--
+-- Name: COLUMN pairs."position"; Type: COMMENT; Schema: public; Owner: postgres
+--
+
+COMMENT ON COLUMN public.pairs."position" IS 'Pin''s place on the board, pins with equal positions are ordered by pinid';
+
+
 --
 -- Name: pins; Type: TABLE; Schema: public; Owner: postgres
 --
@@ -275,8 +518,8 @@ ALTER SEQUENCE public.reports_reportid_seq OWNED BY public.reports.reportid;
 
 CREATE TABLE public.users (
                               username character varying(45) NOT NULL,
-                              passwordhash character varying(40) NOT NULL,
-                              salt character(8) NOT NULL,
+                              passwordhash character varying(128) NOT NULL,
+                              salt character varying(32) NOT NULL,
                               email character varying(254) NOT NULL,
                               last_name character varying(42),
                               first_name character varying(42),
@@ -286,7 +529,9 @@ CREATE TABLE public.users (
                               followed_by integer DEFAULT 0 NOT NULL,
                               pins_count integer DEFAULT 0 NOT NULL,
                               boards_count integer DEFAULT 0 NOT NULL,
-                              vk_id integer DEFAULT 0 NOT NULL
+                              vk_id integer DEFAULT 0 NOT NULL,
+                              hide_presence boolean DEFAULT false NOT NULL,
+                              is_private boolean DEFAULT false NOT NULL
 );
 
 
@@ -321,6 +566,13 @@ ALTER TABLE public.users_userid_seq OWNER TO postgres;
 ALTER SEQUENCE public.users_userid_seq OWNED BY public.users.userid;
 
 
+--
+-- Name: board_sections sectionid; Type: DEFAULT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.board_sections ALTER COLUMN sectionid SET DEFAULT nextval('public.board_sections_sectionid_seq'::regclass);
+
+
 --
 -- Name: boards boardid; Type: DEFAULT; Schema: public; Owner: postgres
 --
@@ -356,6 +608,38 @@ ALTER TABLE ONLY public.reports ALTER COLUMN reportid SET DEFAULT nextval('publi
 ALTER TABLE ONLY public.users ALTER COLUMN userid SET DEFAULT nextval('public.users_userid_seq'::regclass);
 
 
+--
+-- Data for Name: blocks; Type: TABLE DATA; Schema: public; Owner: postgres
+--
+
+COPY public.blocks (blockerid, blockedid) FROM stdin;
+\.
+
+
+--
+-- Data for Name: board_collaborators; Type: TABLE DATA; Schema: public; Owner: postgres
+--
+
+COPY public.board_collaborators (boardid, userid, role, is_accepted) FROM stdin;
+\.
+
+
+--
+-- Data for Name: board_followers; Type: TABLE DATA; Schema: public; Owner: postgres
+--
+
+COPY public.board_followers (boardid, userid) FROM stdin;
+\.
+
+
+--
+-- Data for Name: board_sections; Type: TABLE DATA; Schema: public; Owner: postgres
+--
+
+COPY public.board_sections (sectionid, boardid, title, "position") FROM stdin;
+\.
+
+
 --
 -- Data for Name: boards; Type: TABLE DATA; Schema: public; Owner: postgres
 --
@@ -372,6 +656,14 @@ COPY public.comments (userid, pinid, id, text) FROM stdin;
 \.
 
 
+--
+-- Data for Name: follow_requests; Type: TABLE DATA; Schema: public; Owner: postgres
+--
+
+COPY public.follow_requests (followerid, followedid) FROM stdin;
+\.
+
+
 --
 -- Data for Name: followers; Type: TABLE DATA; Schema: public; Owner: postgres
 --
@@ -384,7 +676,7 @@ COPY public.followers (followerid, followedid) FROM stdin;
 -- Data for Name: pairs; Type: TABLE DATA; Schema: public; Owner: postgres
 --
 
-COPY public.pairs (boardid, pinid) FROM stdin;
+COPY public.pairs (boardid, pinid, sectionid, "position") FROM stdin;
 \.
 
 
@@ -412,6 +704,13 @@ COPY public.users (username, passwordhash, salt, email, last_name, first_name, a
 \.
 
 
+--
+-- Name: board_sections_sectionid_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
+--
+
+SELECT pg_catalog.setval('public.board_sections_sectionid_seq', 1, false);
+
+
 --
 -- Name: boards_boardid_seq; Type: SEQUENCE SET; Schema: public; Owner: postgres
 --
@@ -447,6 +746,46 @@ SELECT pg_catalog.setval('public.reports_reportid_seq', 1, false);
 SELECT pg_catalog.setval('public.users_userid_seq', 97, true);
 
 
+--
+-- Name: blocks blocks_pk; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.blocks
+    ADD CONSTRAINT blocks_pk PRIMARY KEY (blockerid, blockedid);
+
+
+--
+-- Name: board_collaborators board_collaborators_pk; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.board_collaborators
+    ADD CONSTRAINT board_collaborators_pk PRIMARY KEY (boardid, userid);
+
+
+--
+-- Name: board_followers board_followers_pk; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.board_followers
+    ADD CONSTRAINT board_followers_pk PRIMARY KEY (boardid, userid);
+
+
+--
+-- Name: board_sections board_sections_pk; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.board_sections
+    ADD CONSTRAINT board_sections_pk PRIMARY KEY (sectionid);
+
+
+--
+-- Name: board_sections board_sections_un_title; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.board_sections
+    ADD CONSTRAINT board_sections_un_title UNIQUE (boardid, title);
+
+
 --
 -- Name: boards boards_pk_oardid; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
@@ -463,6 +802,14 @@ ALTER TABLE ONLY public.comments
     ADD CONSTRAINT comments_pk_id PRIMARY KEY (id);
 
 
+--
+-- Name: follow_requests follow_requests_pk; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.follow_requests
+    ADD CONSTRAINT follow_requests_pk PRIMARY KEY (followerid, followedid);
+
+
 --
 -- Name: followers followers_pk; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
@@ -519,6 +866,21 @@ ALTER TABLE ONLY public.users
     ADD CONSTRAINT users_un_username UNIQUE (username);
 
 
+--
+-- Name: comments_parentid_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX comments_parentid_idx ON public.comments USING btree (parentid);
+
+
+--
+-- Name: comments_pin_threads_idx; Type: INDEX; Schema: public; Owner: postgres
+--
+
+CREATE INDEX comments_pin_threads_idx ON public.comments USING btree (pinid, replies_count, id)
+WHERE parentid IS NULL;
+
+
 --
 -- Name: users_un_avatar; Type: INDEX; Schema: public; Owner: postgres
 --
@@ -534,6 +896,62 @@ CREATE UNIQUE INDEX users_vk_id_idx ON public.users USING btree (vk_id)
 WHERE NOT vk_id = 0;
 
 
+--
+-- Name: blocks blocks_users_blocked; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.blocks
+    ADD CONSTRAINT blocks_users_blocked FOREIGN KEY (blockedid) REFERENCES public.users(userid) ON UPDATE CASCADE ON DELETE CASCADE;
+
+
+--
+-- Name: blocks blocks_users_blocker; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.blocks
+    ADD CONSTRAINT blocks_users_blocker FOREIGN KEY (blockerid) REFERENCES public.users(userid) ON UPDATE CASCADE ON DELETE CASCADE;
+
+
+--
+-- Name: board_collaborators board_collaborators_boards; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.board_collaborators
+    ADD CONSTRAINT board_collaborators_boards FOREIGN KEY (boardid) REFERENCES public.boards(boardid) ON UPDATE CASCADE ON DELETE CASCADE;
+
+
+--
+-- Name: board_collaborators board_collaborators_users; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.board_collaborators
+    ADD CONSTRAINT board_collaborators_users FOREIGN KEY (userid) REFERENCES public.users(userid) ON UPDATE CASCADE ON DELETE CASCADE;
+
+
+--
+-- Name: board_followers board_followers_boards; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.board_followers
+    ADD CONSTRAINT board_followers_boards FOREIGN KEY (boardid) REFERENCES public.boards(boardid) ON UPDATE CASCADE ON DELETE CASCADE;
+
+
+--
+-- Name: board_followers board_followers_users; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.board_followers
+    ADD CONSTRAINT board_followers_users FOREIGN KEY (userid) REFERENCES public.users(userid) ON UPDATE CASCADE ON DELETE CASCADE;
+
+
+--
+-- Name: board_sections board_sections_boards; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.board_sections
+    ADD CONSTRAINT board_sections_boards FOREIGN KEY (boardid) REFERENCES public.boards(boardid) ON UPDATE CASCADE ON DELETE CASCADE;
+
+
 --
 -- Name: boards boards_fk; Type: FK CONSTRAINT; Schema: public; Owner: postgres
 --
@@ -542,6 +960,22 @@ ALTER TABLE ONLY public.boards
     ADD CONSTRAINT boards_fk FOREIGN KEY (userid) REFERENCES public.users(userid) ON UPDATE CASCADE ON DELETE CASCADE;
 
 
+--
+-- Name: boards boards_cover_pin_fk; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.boards
+    ADD CONSTRAINT boards_cover_pin_fk FOREIGN KEY (coverpinid) REFERENCES public.pins(pinid) ON UPDATE CASCADE ON DELETE SET NULL;
+
+
+--
+-- Name: comments comments_parent_fk; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.comments
+    ADD CONSTRAINT comments_parent_fk FOREIGN KEY (parentid) REFERENCES public.comments(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+
 --
 -- Name: comments comments_pin_fk; Type: FK CONSTRAINT; Schema: public; Owner: postgres
 --
@@ -558,6 +992,22 @@ ALTER TABLE ONLY public.comments
     ADD CONSTRAINT comments_user_fk FOREIGN KEY (userid) REFERENCES public.users(userid) ON UPDATE CASCADE ON DELETE CASCADE;
 
 
+--
+-- Name: follow_requests follow_requests_users_followed; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.follow_requests
+    ADD CONSTRAINT follow_requests_users_followed FOREIGN KEY (followedid) REFERENCES public.users(userid) ON UPDATE CASCADE ON DELETE CASCADE;
+
+
+--
+-- Name: follow_requests follow_requests_users_follower; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.follow_requests
+    ADD CONSTRAINT follow_requests_users_follower FOREIGN KEY (followerid) REFERENCES public.users(userid) ON UPDATE CASCADE ON DELETE CASCADE;
+
+
 --
 -- Name: followers followers_users_followed; Type: FK CONSTRAINT; Schema: public; Owner: postgres
 --
@@ -582,6 +1032,14 @@ ALTER TABLE ONLY public.pairs
     ADD CONSTRAINT pairs_fk FOREIGN KEY (boardid) REFERENCES public.boards(boardid) ON UPDATE CASCADE ON DELETE CASCADE;
 
 
+--
+-- Name: pairs pairs_section_fk; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.pairs
+    ADD CONSTRAINT pairs_section_fk FOREIGN KEY (sectionid) REFERENCES public.board_sections(sectionid) ON UPDATE CASCADE ON DELETE SET NULL;
+
+
 --
 -- Name: reports reports_fk; Type: FK CONSTRAINT; Schema: public; Owner: postgres
 --
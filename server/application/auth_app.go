@@ -33,16 +33,20 @@ func NewAuthApp(grpcClient grpcAuth.AuthClient, us UserAppInterface, cookieApp C
 }
 
 type AuthAppInterface interface {
-	CheckUserCredentials(username string, password string) (*entity.CookieInfo, error)
-	LogoutUser(userID int) error
-	CheckCookie(cookie *http.Cookie) (*entity.CookieInfo, bool)                      // Check if passed cookie value is present in any active session
-	CheckVkCode(code string, redirectURI string) (*entity.CookieInfo, error)         // Use public vk token to get private one and log user with that token in
-	AddVkCode(userID int, code string, redirectURI string) error                     // Use public vk token to get private one and associate it with user
-	AddVkID(userID int, vkID int) error                                              // Add token to database
-	VkCodeToToken(code string, redirectURI string) (*entity.UserVkTokenInput, error) // Get private token from vk using code
+	CheckUserCredentials(username string, password string, session *entity.Session) (*entity.CookieInfo, error)
+	LogoutUser(userID int) error                                                                      // Log user out of all their sessions
+	LogoutSession(cookieInfo *entity.CookieInfo) error                                                // Log user out of session passed cookie belongs to
+	LogoutSessionByID(userID int, sessionID int) error                                                // Log user out of one of their sessions
+	LogoutOtherSessions(cookieInfo *entity.CookieInfo) error                                          // Log user out of all sessions except the one passed cookie belongs to
+	GetSessions(cookieInfo *entity.CookieInfo) ([]*entity.Session, error)                             // Get all of user's active sessions, marking the one passed cookie belongs to
+	CheckCookie(cookie *http.Cookie) (*entity.CookieInfo, bool)                                       // Check if passed cookie value is present in any active session
+	CheckVkCode(code string, redirectURI string, session *entity.Session) (*entity.CookieInfo, error) // Use public vk token to get private one and log user with that token in
+	AddVkCode(userID int, code string, redirectURI string) error                                      // Use public vk token to get private one and associate it with user
+	AddVkID(userID int, vkID int) error                                                               // Add token to database
+	VkCodeToToken(code string, redirectURI string) (*entity.UserVkTokenInput, error)                  // Get private token from vk using code
 }
 
-func (authApp *AuthApp) CheckUserCredentials(username string, password string) (*entity.CookieInfo, error) {
+func (authApp *AuthApp) CheckUserCredentials(username string, password string, session *entity.Session) (*entity.CookieInfo, error) {
 	user, err := authApp.us.GetUserByUsername(username)
 	if err != nil {
 		return nil, err
@@ -53,7 +57,7 @@ func (authApp *AuthApp) CheckUserCredentials(username string, password string) (
 	_, err = authApp.grpcClient.CheckUserCredentials(context.Background(),
 		&grpcAuth.UserAuth{Username: username, Password: password})
 
-	if err != nil { // TODO: hashing
+	if err != nil {
 		if strings.Contains(err.Error(), entity.IncorrectPasswordError.Error()) {
 			return nil, entity.IncorrectPasswordError
 		}
@@ -67,7 +71,7 @@ func (authApp *AuthApp) CheckUserCredentials(username string, password string) (
 		}
 	}
 
-	resultCookieInfo := &entity.CookieInfo{UserID: user.UserID, Cookie: cookie}
+	resultCookieInfo := &entity.CookieInfo{UserID: user.UserID, Cookie: cookie, Session: session}
 	err = authApp.cookieApp.AddCookieInfo(resultCookieInfo)
 	if err != nil {
 		return nil, err
@@ -77,12 +81,37 @@ func (authApp *AuthApp) CheckUserCredentials(username string, password string) (
 }
 
 func (authApp *AuthApp) LogoutUser(userID int) error {
-	cookieInfo, found := authApp.cookieApp.SearchByUserID(userID)
-	if !found {
-		return entity.UserNotLoggedInError
+	return authApp.cookieApp.RemoveAllSessions(userID, "")
+}
+
+func (authApp *AuthApp) LogoutSession(cookieInfo *entity.CookieInfo) error {
+	grpcCookie := grpcAuth.Cookie{}
+	FillGRPCCookie(&grpcCookie, cookieInfo.Cookie)
+	return authApp.cookieApp.RemoveCookie(&grpcAuth.CookieInfo{UserID: int64(cookieInfo.UserID), Cookie: &grpcCookie})
+}
+
+func (authApp *AuthApp) LogoutSessionByID(userID int, sessionID int) error {
+	return authApp.cookieApp.RemoveSession(userID, sessionID)
+}
+
+func (authApp *AuthApp) LogoutOtherSessions(cookieInfo *entity.CookieInfo) error {
+	return authApp.cookieApp.RemoveAllSessions(cookieInfo.UserID, cookieInfo.Cookie.Value)
+}
+
+func (authApp *AuthApp) GetSessions(cookieInfo *entity.CookieInfo) ([]*entity.Session, error) {
+	grpcSessions, err := authApp.cookieApp.GetSessions(cookieInfo.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*entity.Session, 0, len(grpcSessions))
+	for _, grpcCookieInfo := range grpcSessions {
+		session := ConvertFromGRPCSession(grpcCookieInfo.Session)
+		session.IsCurrent = grpcCookieInfo.Cookie.Value == cookieInfo.Cookie.Value
+		sessions = append(sessions, session)
 	}
 
-	return authApp.cookieApp.RemoveCookie(cookieInfo)
+	return sessions, nil
 }
 
 func (authApp *AuthApp) CheckCookie(cookie *http.Cookie) (*entity.CookieInfo, bool) {
@@ -93,12 +122,13 @@ func (authApp *AuthApp) CheckCookie(cookie *http.Cookie) (*entity.CookieInfo, bo
 	}
 
 	return &entity.CookieInfo{
-		UserID: int(grpcCookieInfo.UserID),
-		Cookie: cookie,
+		UserID:  int(grpcCookieInfo.UserID),
+		Cookie:  cookie,
+		Session: ConvertFromGRPCSession(grpcCookieInfo.Session),
 	}, isCookie
 }
 
-func (authApp *AuthApp) CheckVkCode(code string, redirectURI string) (*entity.CookieInfo, error) {
+func (authApp *AuthApp) CheckVkCode(code string, redirectURI string, session *entity.Session) (*entity.CookieInfo, error) {
 	tokenInput, err := authApp.VkCodeToToken(code, redirectURI)
 	if err != nil {
 		return nil, err
@@ -119,7 +149,7 @@ func (authApp *AuthApp) CheckVkCode(code string, redirectURI string) (*entity.Co
 		cookie, err = authApp.cookieApp.GenerateCookie()
 	}
 
-	resultCookieInfo := &entity.CookieInfo{UserID: int(userID.Uid), Cookie: cookie}
+	resultCookieInfo := &entity.CookieInfo{UserID: int(userID.Uid), Cookie: cookie, Session: session}
 	err = authApp.cookieApp.AddCookieInfo(resultCookieInfo)
 	if err != nil {
 		return nil, err
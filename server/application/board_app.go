@@ -16,13 +16,30 @@ func NewBoardApp(grpcClient grpcPins.PinsClient) *BoardApp {
 }
 
 type BoardAppInterface interface {
-	CreateBoard(board *entity.Board) (int, error) // Creating user's board
-	GetBoard(boardID int) (*entity.Board, error)  // Get description of the board
-	GetBoards(userID int) ([]entity.Board, error) // Get boards by authorID
+	CreateBoard(board *entity.Board) (int, error)                  // Creating user's board
+	GetBoard(boardID int, requesterID int) (*entity.Board, error)  // Get description of the board, secret board is not found unless requester is its owner or collaborator
+	GetBoards(userID int, requesterID int) ([]entity.Board, error) // Get boards by authorID, skipping secret ones unless requester is author or collaborator
 	GetInitUserBoard(userID int) (int, error)
-	DeleteBoard(userID int, boardID int) error // Removes user's board by ID
-	CheckBoard(userID int, boardID int) error  // Check whether board belongs to user
+	UpdateBoard(board *entity.Board) error                      // Change board's title, description, cover pin, archived and secret flags
+	DeleteBoard(userID int, boardID int) error                  // Removes user's board by ID
+	CheckBoard(userID int, boardID int, role string) error      // Check whether user has at least passed role on board
+	CheckBoardVisibility(userID int, board *entity.Board) error // Check whether user can see board
 	UploadBoardAvatar(boardID int, imageLink string, imageHeight int, imageWidth int, imageAvgColor string) error
+	RefreshBoardAvatar(userID int, boardID int) error                                 // Use board's last pin as its avatar, unless cover pin was chosen
+	InviteCollaborator(ownerID int, boardID int, userID int, role string) error       // Invite user to board as editor or viewer
+	AcceptInvitation(userID int, boardID int) error                                   // Make invited user board's collaborator
+	RemoveCollaborator(userID int, boardID int, collaboratorID int) error             // Remove collaborator or invitation (owner can remove anyone, others only themselves)
+	GetCollaborators(boardID int) ([]entity.BoardCollaborator, error)                 // Get users who accepted invitation to board
+	GetInvitations(userID int) ([]entity.BoardInvitation, error)                      // Get boards user was invited to
+	CreateSection(userID int, boardID int, title string) (int, error)                 // Add section to the end of board
+	RenameSection(userID int, boardID int, sectionID int, title string) error         // Change title of board's section
+	DeleteSection(userID int, boardID int, sectionID int) error                       // Remove board's section, leaving its pins on board
+	GetSections(boardID int) ([]entity.BoardSection, error)                           // Get board's sections in board's order
+	MovePin(userID int, boardID int, pinID int, sectionID int) error                  // Move pin to the end of another section of the board
+	ReorderBoard(userID int, boardID int, order *entity.BoardOrderInput) (int, error) // Save order of board's sections and pins, if board was not changed since order's layout version
+	FollowBoard(userID int, board *entity.Board) error                                // Make user follow someone else's board
+	UnfollowBoard(userID int, boardID int) error                                      // Make user stop following board
+	GetBoardFollowers(boardID int) ([]int, error)                                     // Get IDs of users who follow board
 }
 
 // CreateBoard adds user's board to database
@@ -46,10 +63,11 @@ func (boardApp *BoardApp) CreateBoard(board *entity.Board) (int, error) {
 	return int(grpcBoardID.BoardID), nil
 }
 
-// GetBoard returns board with passed boardID
+// GetBoard returns board with passed boardID, secret board is not found unless requester is its owner or collaborator
 // It returns that board and nil on success, nil and error on failure
-func (boardApp *BoardApp) GetBoard(boardID int) (*entity.Board, error) {
-	board, err := boardApp.grpcClient.GetBoard(context.Background(), &grpcPins.BoardID{BoardID: int64(boardID)})
+func (boardApp *BoardApp) GetBoard(boardID int, requesterID int) (*entity.Board, error) {
+	board, err := boardApp.grpcClient.GetBoard(context.Background(),
+		&grpcPins.BoardRequest{BoardID: int64(boardID), RequesterID: int64(requesterID)})
 	if err != nil {
 		if strings.Contains(err.Error(), entity.BoardNotFoundError.Error()) {
 			return nil, entity.BoardNotFoundError
@@ -57,29 +75,68 @@ func (boardApp *BoardApp) GetBoard(boardID int) (*entity.Board, error) {
 		return nil, err
 	}
 
-	boardInfo := &entity.Board{
-		BoardID:       int(board.BoardID),
-		UserID:        int(board.UserID),
-		Title:         board.Title,
-		Description:   board.Description,
-		ImageLink:     board.ImageLink,
-		ImageHeight:   int(board.ImageHeight),
-		ImageWidth:    int(board.ImageWidth),
-		ImageAvgColor: board.ImageAvgColor,
-	}
+	boardInfo := &entity.Board{}
+	ConvertFromGrpcBoard(boardInfo, board)
 	return boardInfo, nil
 }
 
 // GetBoards returns all the boards with passed authorsID
+// Secret boards are returned only if requester is their author or collaborator
 // It returns slice of boards and nil on success, nil and error on failure
-func (boardApp *BoardApp) GetBoards(authorID int) ([]entity.Board, error) {
-	grpcBoardsList, err := boardApp.grpcClient.GetBoards(context.Background(), &grpcPins.UserID{Uid: int64(authorID)})
+func (boardApp *BoardApp) GetBoards(authorID int, requesterID int) ([]entity.Board, error) {
+	grpcBoardsList, err := boardApp.grpcClient.GetBoards(context.Background(),
+		&grpcPins.BoardsOfUser{UserID: int64(authorID), RequesterID: int64(requesterID)})
 	if err != nil {
 		return nil, err
 	}
 	return ConvertGrpcBoards(grpcBoardsList), nil
 }
 
+// UpdateBoard saves board's new title, description, cover pin, archived and secret flags
+// If cover pin is reset, board's last pin becomes its cover again
+// It returns nil on success and error on failure
+func (boardApp *BoardApp) UpdateBoard(board *entity.Board) error {
+	oldBoard, err := boardApp.GetBoard(board.BoardID, board.UserID)
+	if err != nil {
+		return err
+	}
+
+	if board.IsArchived {
+		initBoardID, err := boardApp.GetInitUserBoard(oldBoard.UserID)
+		if err != nil {
+			return err
+		}
+
+		if board.BoardID == initBoardID { // Saved pins go to initial board, so it must stay active
+			return entity.ArchiveInitBoardError
+		}
+	}
+
+	grpcBoard := grpcPins.Board{}
+	ConvertToGrpcBoard(&grpcBoard, board)
+	_, err = boardApp.grpcClient.UpdateBoard(context.Background(), &grpcBoard)
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), entity.BoardNotFoundError.Error()):
+			return entity.BoardNotFoundError
+		case strings.Contains(err.Error(), entity.BoardTitleAlreadyExistsError.Error()):
+			return entity.BoardTitleAlreadyExistsError
+		case strings.Contains(err.Error(), entity.CoverPinNotInBoardError.Error()):
+			return entity.CoverPinNotInBoardError
+		case strings.Contains(err.Error(), entity.UpdateBoardError.Error()):
+			return entity.UpdateBoardError
+		default:
+			return err
+		}
+	}
+
+	if board.CoverPinID == 0 && oldBoard.CoverPinID != 0 {
+		return boardApp.RefreshBoardAvatar(board.UserID, board.BoardID)
+	}
+
+	return nil
+}
+
 // DeleteBoard deletes user's board with passed boardID
 // It returns nil on success and error on failure
 func (boardApp *BoardApp) DeleteBoard(boardID int, userID int) error {
@@ -92,7 +149,7 @@ func (boardApp *BoardApp) DeleteBoard(boardID int, userID int) error {
 		return entity.DeleteInitBoardError
 	}
 
-	err = boardApp.CheckBoard(userID, boardID)
+	err = boardApp.CheckBoard(userID, boardID, string(entity.OwnerBoardRoleKey))
 	if err != nil {
 		return err
 	}
@@ -116,18 +173,378 @@ func (boardApp *BoardApp) GetInitUserBoard(userID int) (int, error) {
 	return int(grpcBoardID.BoardID), nil
 }
 
-func (boardApp *BoardApp) CheckBoard(userID int, boardID int) error {
-	board, err := boardApp.GetBoard(boardID)
+// boardRoleRanks orders board roles, each role can do everything lower ones can
+var boardRoleRanks = map[string]int{
+	"":                                1, // Anyone can see boards which are not secret
+	string(entity.ViewerBoardRoleKey): 2,
+	string(entity.EditorBoardRoleKey): 3,
+	string(entity.OwnerBoardRoleKey):  4,
+}
+
+// CheckBoard checks if user's role on board is the passed one or higher
+// It returns nil if it is, CheckBoardOwnerError if it is not and other error on failure
+func (boardApp *BoardApp) CheckBoard(userID int, boardID int, role string) error {
+	userRole, err := boardApp.getBoardRole(userID, boardID)
 	if err != nil {
 		return err
 	}
 
-	if board.UserID != userID {
+	if boardRoleRanks[userRole] < boardRoleRanks[role] {
 		return entity.CheckBoardOwnerError
 	}
 	return nil
 }
 
+// getBoardRole returns user's role on board: "owner", "editor", "viewer" or empty string
+func (boardApp *BoardApp) getBoardRole(userID int, boardID int) (string, error) {
+	role, err := boardApp.grpcClient.GetBoardRole(context.Background(),
+		&grpcPins.BoardMember{UserID: int64(userID), BoardID: int64(boardID)})
+	if err != nil {
+		if strings.Contains(err.Error(), entity.BoardNotFoundError.Error()) {
+			return "", entity.BoardNotFoundError
+		}
+		return "", err
+	}
+	return role.Role, nil
+}
+
+// CheckBoardVisibility checks if user can see board, secret boards are seen only by their owner and collaborators
+// It returns nil if user can see board, BoardNotFoundError otherwise, so that secret boards can't be found out
+func (boardApp *BoardApp) CheckBoardVisibility(userID int, board *entity.Board) error {
+	if !board.IsSecret || board.UserID == userID {
+		return nil
+	}
+
+	if userID == 0 { // Anonymous users are never collaborators
+		return entity.BoardNotFoundError
+	}
+
+	err := boardApp.CheckBoard(userID, board.BoardID, string(entity.ViewerBoardRoleKey))
+	if err != nil {
+		if err == entity.CheckBoardOwnerError {
+			return entity.BoardNotFoundError
+		}
+		return err
+	}
+	return nil
+}
+
+// InviteCollaborator invites user to owner's board with passed role ("editor" or "viewer")
+// Invited user becomes collaborator after accepting invitation
+// It returns nil on success and error on failure
+func (boardApp *BoardApp) InviteCollaborator(ownerID int, boardID int, userID int, role string) error {
+	if role != string(entity.EditorBoardRoleKey) && role != string(entity.ViewerBoardRoleKey) {
+		return entity.IncorrectBoardRoleError
+	}
+
+	if ownerID == userID {
+		return entity.SelfInviteError
+	}
+
+	err := boardApp.CheckBoard(ownerID, boardID, string(entity.OwnerBoardRoleKey))
+	if err != nil {
+		return err
+	}
+
+	_, err = boardApp.grpcClient.InviteCollaborator(context.Background(), &grpcPins.Collaborator{
+		BoardID: int64(boardID),
+		UserID:  int64(userID),
+		Role:    role,
+	})
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), entity.CollaboratorAlreadyExistsError.Error()):
+			return entity.CollaboratorAlreadyExistsError
+		case strings.Contains(err.Error(), entity.UserNotFoundError.Error()):
+			return entity.UserNotFoundError
+		case strings.Contains(err.Error(), entity.BoardNotFoundError.Error()):
+			return entity.BoardNotFoundError
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// AcceptInvitation makes user collaborator of board they were invited to
+// It returns nil on success and error on failure
+func (boardApp *BoardApp) AcceptInvitation(userID int, boardID int) error {
+	_, err := boardApp.grpcClient.AcceptInvitation(context.Background(),
+		&grpcPins.BoardMember{UserID: int64(userID), BoardID: int64(boardID)})
+	if err != nil {
+		if strings.Contains(err.Error(), entity.InvitationNotFoundError.Error()) {
+			return entity.InvitationNotFoundError
+		}
+		return err
+	}
+	return nil
+}
+
+// RemoveCollaborator removes collaborator or pending invitation from board
+// Board's owner can remove anyone, other users can only leave board or reject invitation
+// It returns nil on success and error on failure
+func (boardApp *BoardApp) RemoveCollaborator(userID int, boardID int, collaboratorID int) error {
+	if userID != collaboratorID {
+		err := boardApp.CheckBoard(userID, boardID, string(entity.OwnerBoardRoleKey))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := boardApp.grpcClient.RemoveCollaborator(context.Background(),
+		&grpcPins.BoardMember{UserID: int64(collaboratorID), BoardID: int64(boardID)})
+	if err != nil {
+		if strings.Contains(err.Error(), entity.CollaboratorNotFoundError.Error()) {
+			return entity.CollaboratorNotFoundError
+		}
+		return err
+	}
+	return nil
+}
+
+// GetCollaborators returns users who accepted invitation to board with passed boardID
+// It returns slice of collaborators and nil on success, nil and error on failure
+func (boardApp *BoardApp) GetCollaborators(boardID int) ([]entity.BoardCollaborator, error) {
+	grpcCollaborators, err := boardApp.grpcClient.GetCollaborators(context.Background(),
+		&grpcPins.BoardID{BoardID: int64(boardID)})
+	if err != nil {
+		return nil, err
+	}
+
+	collaborators := make([]entity.BoardCollaborator, 0, len(grpcCollaborators.Collaborators))
+	for _, grpcCollaborator := range grpcCollaborators.Collaborators {
+		collaborators = append(collaborators, entity.BoardCollaborator{
+			UserID:     int(grpcCollaborator.UserID),
+			Username:   grpcCollaborator.Username,
+			AvatarLink: grpcCollaborator.AvatarLink,
+			Role:       grpcCollaborator.Role,
+		})
+	}
+	return collaborators, nil
+}
+
+// GetInvitations returns boards which user was invited to, but has not joined yet
+// It returns slice of invitations and nil on success, nil and error on failure
+func (boardApp *BoardApp) GetInvitations(userID int) ([]entity.BoardInvitation, error) {
+	grpcInvitations, err := boardApp.grpcClient.GetInvitations(context.Background(), &grpcPins.UserID{Uid: int64(userID)})
+	if err != nil {
+		return nil, err
+	}
+
+	invitations := make([]entity.BoardInvitation, 0, len(grpcInvitations.Invitations))
+	for _, grpcInvitation := range grpcInvitations.Invitations {
+		invitation := entity.BoardInvitation{Role: grpcInvitation.Role}
+		ConvertFromGrpcBoard(&invitation.Board, grpcInvitation.Board)
+		invitations = append(invitations, invitation)
+	}
+	return invitations, nil
+}
+
+// CreateSection adds section with passed title to the end of board, user must be at least board's editor
+// It returns section's assigned ID and nil on success, any number and error on failure
+func (boardApp *BoardApp) CreateSection(userID int, boardID int, title string) (int, error) {
+	err := boardApp.CheckBoard(userID, boardID, string(entity.EditorBoardRoleKey))
+	if err != nil {
+		return -1, err
+	}
+
+	grpcSectionID, err := boardApp.grpcClient.CreateSection(context.Background(),
+		&grpcPins.Section{BoardID: int64(boardID), Title: title})
+	if err != nil {
+		return -1, convertSectionError(err)
+	}
+	return int(grpcSectionID.SectionID), nil
+}
+
+// RenameSection changes title of board's section, user must be at least board's editor
+// It returns nil on success and error on failure
+func (boardApp *BoardApp) RenameSection(userID int, boardID int, sectionID int, title string) error {
+	err := boardApp.CheckBoard(userID, boardID, string(entity.EditorBoardRoleKey))
+	if err != nil {
+		return err
+	}
+
+	_, err = boardApp.grpcClient.RenameSection(context.Background(),
+		&grpcPins.Section{SectionID: int64(sectionID), BoardID: int64(boardID), Title: title})
+	if err != nil {
+		return convertSectionError(err)
+	}
+	return nil
+}
+
+// DeleteSection removes board's section, its pins stay on board without section
+// User must be at least board's editor
+// It returns nil on success and error on failure
+func (boardApp *BoardApp) DeleteSection(userID int, boardID int, sectionID int) error {
+	err := boardApp.CheckBoard(userID, boardID, string(entity.EditorBoardRoleKey))
+	if err != nil {
+		return err
+	}
+
+	_, err = boardApp.grpcClient.DeleteSection(context.Background(),
+		&grpcPins.Section{SectionID: int64(sectionID), BoardID: int64(boardID)})
+	if err != nil {
+		return convertSectionError(err)
+	}
+	return nil
+}
+
+// GetSections returns sections of board with passed boardID in board's order
+// It returns slice of sections and nil on success, nil and error on failure
+func (boardApp *BoardApp) GetSections(boardID int) ([]entity.BoardSection, error) {
+	grpcSections, err := boardApp.grpcClient.GetSections(context.Background(), &grpcPins.BoardID{BoardID: int64(boardID)})
+	if err != nil {
+		return nil, err
+	}
+
+	sections := make([]entity.BoardSection, 0, len(grpcSections.Sections))
+	for _, grpcSection := range grpcSections.Sections {
+		sections = append(sections, entity.BoardSection{
+			SectionID: int(grpcSection.SectionID),
+			Title:     grpcSection.Title,
+		})
+	}
+	return sections, nil
+}
+
+// MovePin moves board's pin to the end of another section of that board, sectionID 0 takes pin out of any section
+// User must be at least board's editor
+// It returns nil on success and error on failure
+func (boardApp *BoardApp) MovePin(userID int, boardID int, pinID int, sectionID int) error {
+	err := boardApp.CheckBoard(userID, boardID, string(entity.EditorBoardRoleKey))
+	if err != nil {
+		return err
+	}
+
+	_, err = boardApp.grpcClient.MovePin(context.Background(), &grpcPins.PinMove{
+		BoardID:   int64(boardID),
+		PinID:     int64(pinID),
+		SectionID: int64(sectionID),
+	})
+	if err != nil {
+		return convertSectionError(err)
+	}
+	return nil
+}
+
+// ReorderBoard saves order of board's sections and pins, together with pins' sections
+// Order is rejected with BoardLayoutConflictError if board was changed since order's layout version,
+// so that client can fetch board again instead of overwriting someone else's changes
+// User must be at least board's editor
+// It returns board's new layout version and nil on success, any number and error on failure
+func (boardApp *BoardApp) ReorderBoard(userID int, boardID int, order *entity.BoardOrderInput) (int, error) {
+	err := boardApp.CheckBoard(userID, boardID, string(entity.EditorBoardRoleKey))
+	if err != nil {
+		return -1, err
+	}
+
+	grpcOrder := grpcPins.BoardOrder{
+		BoardID:       int64(boardID),
+		LayoutVersion: int64(order.LayoutVersion),
+		Pins:          make([]*grpcPins.PinPlacement, 0, len(order.Pins)),
+		SectionIDs:    make([]int64, 0, len(order.SectionIDs)),
+	}
+	for _, placement := range order.Pins {
+		grpcOrder.Pins = append(grpcOrder.Pins, &grpcPins.PinPlacement{
+			PinID:     int64(placement.PinID),
+			SectionID: int64(placement.SectionID),
+		})
+	}
+	for _, sectionID := range order.SectionIDs {
+		grpcOrder.SectionIDs = append(grpcOrder.SectionIDs, int64(sectionID))
+	}
+
+	layoutVersion, err := boardApp.grpcClient.ReorderBoard(context.Background(), &grpcOrder)
+	if err != nil {
+		if strings.Contains(err.Error(), entity.BoardLayoutConflictError.Error()) {
+			return -1, entity.BoardLayoutConflictError
+		}
+		return -1, convertSectionError(err)
+	}
+	return int(layoutVersion.LayoutVersion), nil
+}
+
+// FollowBoard makes user follow board, so that its new pins appear in user's followed pins
+// Users can't follow their own boards and boards they can't see
+// It returns nil on success and error on failure
+func (boardApp *BoardApp) FollowBoard(userID int, board *entity.Board) error {
+	if board.UserID == userID {
+		return entity.SelfBoardFollowError
+	}
+
+	err := boardApp.CheckBoardVisibility(userID, board)
+	if err != nil {
+		return err
+	}
+
+	_, err = boardApp.grpcClient.FollowBoard(context.Background(),
+		&grpcPins.BoardMember{UserID: int64(userID), BoardID: int64(board.BoardID)})
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), entity.BoardFollowAlreadyExistsError.Error()):
+			return entity.BoardFollowAlreadyExistsError
+		case strings.Contains(err.Error(), entity.BoardNotFoundError.Error()):
+			return entity.BoardNotFoundError
+		case strings.Contains(err.Error(), entity.UserNotFoundError.Error()):
+			return entity.UserNotFoundError
+		case strings.Contains(err.Error(), entity.FollowCountUpdateError.Error()):
+			return entity.FollowCountUpdateError
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// UnfollowBoard makes user stop following board
+// It returns nil on success and error on failure
+func (boardApp *BoardApp) UnfollowBoard(userID int, boardID int) error {
+	_, err := boardApp.grpcClient.UnfollowBoard(context.Background(),
+		&grpcPins.BoardMember{UserID: int64(userID), BoardID: int64(boardID)})
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), entity.BoardFollowNotFoundError.Error()):
+			return entity.BoardFollowNotFoundError
+		case strings.Contains(err.Error(), entity.FollowCountUpdateError.Error()):
+			return entity.FollowCountUpdateError
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// GetBoardFollowers returns IDs of users who follow board with passed boardID
+// It returns slice of IDs and nil on success, nil and error on failure
+func (boardApp *BoardApp) GetBoardFollowers(boardID int) ([]int, error) {
+	grpcFollowers, err := boardApp.grpcClient.GetBoardFollowers(context.Background(), &grpcPins.BoardID{BoardID: int64(boardID)})
+	if err != nil {
+		return nil, err
+	}
+
+	followerIDs := make([]int, 0, len(grpcFollowers.Ids))
+	for _, followerID := range grpcFollowers.Ids {
+		followerIDs = append(followerIDs, int(followerID))
+	}
+	return followerIDs, nil
+}
+
+// convertSectionError turns errors of section and pin order rpcs into their entity counterparts
+func convertSectionError(err error) error {
+	switch {
+	case strings.Contains(err.Error(), entity.BoardNotFoundError.Error()):
+		return entity.BoardNotFoundError
+	case strings.Contains(err.Error(), entity.SectionNotFoundError.Error()):
+		return entity.SectionNotFoundError
+	case strings.Contains(err.Error(), entity.SectionTitleAlreadyExistsError.Error()):
+		return entity.SectionTitleAlreadyExistsError
+	case strings.Contains(err.Error(), entity.PinNotInBoardError.Error()):
+		return entity.PinNotInBoardError
+	default:
+		return err
+	}
+}
+
 func (boardApp *BoardApp) UploadBoardAvatar(boardID int, imageLink string, imageHeight int, imageWidth int, imageAvgColor string) error {
 	_, err := boardApp.grpcClient.UploadBoardAvatar(context.Background(), &grpcPins.FileInfo{
 		BoardID:       int64(boardID),
@@ -146,6 +563,31 @@ func (boardApp *BoardApp) UploadBoardAvatar(boardID int, imageLink string, image
 	return nil
 }
 
+// RefreshBoardAvatar sets image of board's last pin as board's avatar, or default image if board is empty
+// Boards with chosen cover pin are not changed, user is the one who changed board's pins
+// It returns nil on success and error on failure
+func (boardApp *BoardApp) RefreshBoardAvatar(userID int, boardID int) error {
+	board, err := boardApp.GetBoard(boardID, userID)
+	if err != nil {
+		return err
+	}
+
+	if board.CoverPinID != 0 {
+		return nil
+	}
+
+	lastPin, err := boardApp.grpcClient.GetLastBoardPin(context.Background(), &grpcPins.BoardID{BoardID: int64(boardID)})
+	switch {
+	case err == nil:
+		return boardApp.UploadBoardAvatar(boardID, lastPin.ImageLink,
+			int(lastPin.ImageHeight), int(lastPin.ImageWidth), lastPin.ImageAvgColor)
+	case strings.Contains(err.Error(), entity.PinNotFoundError.Error()): // If there are no pins left, we take default image
+		return boardApp.UploadBoardAvatar(boardID, string(entity.BoardAvatarDefaultPath), 480, 1200, "5a5a5a")
+	default:
+		return err
+	}
+}
+
 func ConvertToGrpcBoard(grpcBoard *grpcPins.Board, board *entity.Board) {
 	grpcBoard.UserID = int64(board.UserID)
 	grpcBoard.BoardID = int64(board.BoardID)
@@ -155,6 +597,11 @@ func ConvertToGrpcBoard(grpcBoard *grpcPins.Board, board *entity.Board) {
 	grpcBoard.ImageHeight = int64(board.ImageHeight)
 	grpcBoard.ImageWidth = int64(board.ImageWidth)
 	grpcBoard.ImageAvgColor = board.ImageAvgColor
+	grpcBoard.CoverPinID = int64(board.CoverPinID)
+	grpcBoard.IsArchived = board.IsArchived
+	grpcBoard.IsSecret = board.IsSecret
+	grpcBoard.LayoutVersion = int64(board.LayoutVersion)
+	grpcBoard.FollowersCount = int64(board.FollowersCount)
 }
 
 func ConvertFromGrpcBoard(board *entity.Board, grpcBoard *grpcPins.Board) {
@@ -166,6 +613,11 @@ func ConvertFromGrpcBoard(board *entity.Board, grpcBoard *grpcPins.Board) {
 	board.ImageHeight = int(grpcBoard.ImageHeight)
 	board.ImageWidth = int(grpcBoard.ImageWidth)
 	board.ImageAvgColor = grpcBoard.ImageAvgColor
+	board.CoverPinID = int(grpcBoard.CoverPinID)
+	board.IsArchived = grpcBoard.IsArchived
+	board.IsSecret = grpcBoard.IsSecret
+	board.LayoutVersion = int(grpcBoard.LayoutVersion)
+	board.FollowersCount = int(grpcBoard.FollowersCount)
 }
 
 func ConvertGrpcBoards(grpcBoards *grpcPins.BoardsList) []entity.Board {
@@ -1,34 +1,71 @@
 package application
 
 import (
+	"context"
 	"encoding/json"
+	"io"
 	"pinterest/domain/entity"
-	"pinterest/domain/repository"
+	grpcChat "pinterest/services/chat/proto"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ChatApp struct {
-	chatRepo     repository.ChatRepositoryInterface
+	grpcClient   grpcChat.ChatsClient
 	userApp      UserAppInterface
+	followApp    FollowAppInterface // Followers are allowed to see user's presence
+	pinApp       PinAppInterface    // Used to resolve attached pins into previews
+	boardApp     BoardAppInterface  // Used to resolve attached boards into previews
+	s3App        S3AppInterface
 	websocketApp WebsocketAppInterface
 }
 
-func NewChatApp(chatRepo repository.ChatRepositoryInterface,
-	userApp UserAppInterface, websocketApp WebsocketAppInterface) *ChatApp {
+func NewChatApp(grpcClient grpcChat.ChatsClient, userApp UserAppInterface, followApp FollowAppInterface, pinApp PinAppInterface,
+	boardApp BoardAppInterface, s3App S3AppInterface, websocketApp WebsocketAppInterface) *ChatApp {
 	return &ChatApp{
-		chatRepo:     chatRepo,
+		grpcClient:   grpcClient,
 		userApp:      userApp,
+		followApp:    followApp,
+		pinApp:       pinApp,
+		boardApp:     boardApp,
+		s3App:        s3App,
 		websocketApp: websocketApp,
 	}
 }
 
+const messageChangeWindow = 24 * time.Hour // Authors can edit and delete their messages only for this long
+const maxMessageAttachments = 10
+const searchContextSize = 2 // How many messages before and after every found one are returned
+
 type ChatAppInterface interface {
-	CreateChat(firstUserID int, secondUserID int) (int, error)       // Create chat between first and second user (errors if chat exists already)
-	GetChatIDByUsers(firstUserID int, secondUserID int) (int, error) // Find chat between specified users
-	AddMessage(message *entity.Message) (int, error)                 // Add message (author has to be in message's chat)
-	SendMessage(chatID int, messageID int, userID int) error         // Send specified message from specified chat to user (who must be in said chat)
-	SendChat(userID int, chatId int) error                           // Send entire specified chat to specified user (who  must be in said chat)
-	SendAllChats(userID int) error                                   // Send all chats of specified user to them
-	ReadChat(chatID int, userID int) error                           // Mark specified chat as "Read" for specified user
+	CreateChat(firstUserID int, secondUserID int) (int, error)                                                       // Create direct chat between first and second user (errors if chat exists already or one of them blocked the other)
+	CreateGroupChat(ownerID int, title string, memberIDs []int) (int, error)                                         // Create group chat owned by its creator and send it to all of its members
+	GetChatIDByUsers(firstUserID int, secondUserID int) (int, error)                                                 // Find direct chat between specified users
+	AddMessage(message *entity.Message) (int, error)                                                                 // Add message (author has to be in message's chat)
+	SendMessage(chatID int, messageID int, userID int) error                                                         // Send specified message from specified chat to user (who must be in said chat)
+	SendChat(chatID int, userID int) error                                                                           // Send specified chat with its last message to specified user (who  must be in said chat)
+	SendAllChats(userID int, client *websocket.Conn) error                                                           // Send all chats of specified user to them, with last messages and unread counts
+	GetChats(userID int) ([]entity.ChatOutput, error)                                                                // Get all chats of specified user with last messages and unread counts
+	GetMessages(userID int, chatID int, beforeMessageID int, limit int) ([]*entity.Message, error)                   // Get up to limit messages sent before specified one (0 means newest), oldest first
+	SearchMessages(userID int, query string, beforeMessageID int, limit int) ([]entity.MessageSearchResult, error)   // Find up to limit newest messages of user's chats sent before specified one (0 means newest) that match query
+	ReadChat(chatID int, userID int) error                                                                           // Mark all messages of specified chat as "Read" for specified user
+	ReadMessages(userID int, chatID int, messageID int) error                                                        // Mark messages of specified chat up to specified one as "Read" for specified user and notify chat members
+	DeliverMessages(userID int, chatID int, messageID int) error                                                     // Mark messages of specified chat up to specified one as delivered to specified user and notify chat members
+	PostMessage(authorID int, targetID int, text string, attachments []entity.MessageAttachmentInput) (int, error)   // Add message to author's direct chat with target (creating it if needed) and send it to both of them, unless one of them blocked the other
+	PostChatMessage(authorID int, chatID int, text string, attachments []entity.MessageAttachmentInput) (int, error) // Add message to chat and send it to all of chat's members
+	EditMessage(userID int, messageID int, text string) error                                                        // Change message's text and send it to all of chat's members (only author can do that, within time window)
+	DeleteMessage(userID int, messageID int) error                                                                   // Replace message with tombstone and send it to all of chat's members (only author can do that, within time window)
+	SendTyping(userID int, chatID int) error                                                                         // Tell other members of chat that user is typing
+	GetPresence(requesterID int, userID int) (*entity.Presence, error)                                               // Get user's presence (requester has to follow user or have direct chat with them and not be blocked)
+	UpdateGroupChat(userID int, chatID int, title string) error                                                      // Change group chat's title (only owner and admins can do that)
+	UpdateChatAvatar(userID int, chatID int, file io.Reader, extension string) error                                 // Replace group chat's avatar (only owner and admins can do that)
+	AddChatMember(userID int, chatID int, newMemberID int) error                                                     // Add user to group chat (only owner and admins can do that, if neither of them blocked the other)
+	RemoveChatMember(userID int, chatID int, memberID int) error                                                     // Remove user from group chat (owner can remove anyone, admins can remove members)
+	LeaveChat(userID int, chatID int) error                                                                          // Leave group chat, passing ownership on if needed
+	SetChatMemberRole(userID int, chatID int, memberID int, role string) error                                       // Change member's role (only owner can do that, passing "owner" transfers ownership)
 }
 
 func (chatApp *ChatApp) CreateChat(firstUserID int, secondUserID int) (int, error) {
@@ -41,50 +78,258 @@ func (chatApp *ChatApp) CreateChat(firstUserID int, secondUserID int) (int, erro
 		return -1, entity.UserNotFoundError
 	}
 
-	return chatApp.chatRepo.CreateChat(firstUserID, secondUserID)
+	err = chatApp.checkNotBlocked(firstUserID, secondUserID)
+	if err != nil {
+		return -1, err
+	}
+
+	chatID, err := chatApp.grpcClient.CreateChat(context.Background(),
+		&grpcChat.ChatUsers{FirstUserID: int64(firstUserID), SecondUserID: int64(secondUserID)})
+	if err != nil {
+		if strings.Contains(err.Error(), entity.ChatAlreadyExistsError.Error()) {
+			return -1, entity.ChatAlreadyExistsError
+		}
+		return -1, err
+	}
+
+	return int(chatID.ChatID), nil
+}
+
+func (chatApp *ChatApp) CreateGroupChat(ownerID int, title string, memberIDs []int) (int, error) {
+	if title == "" {
+		return -1, entity.EmptyChatTitleError
+	}
+
+	chat := entity.Chat{
+		Type:       string(entity.GroupChatTypeKey),
+		Title:      title,
+		AvatarLink: string(entity.ChatAvatarDefaultPath),
+		Members:    []*entity.ChatMember{{UserID: ownerID, Role: string(entity.OwnerChatRoleKey)}},
+	}
+	for _, memberID := range memberIDs {
+		if chat.GetMember(memberID) != nil { // Duplicates are skipped
+			continue
+		}
+
+		_, err := chatApp.userApp.GetUser(memberID)
+		if err != nil {
+			return -1, entity.UserNotFoundError
+		}
+
+		err = chatApp.checkNotBlocked(ownerID, memberID)
+		if err != nil {
+			return -1, err
+		}
+
+		chat.Members = append(chat.Members, &entity.ChatMember{UserID: memberID, Role: string(entity.MemberChatRoleKey)})
+	}
+
+	grpcChatInfo := grpcChat.Chat{}
+	ConvertToGrpcChat(&grpcChatInfo, &chat)
+	chatID, err := chatApp.grpcClient.CreateGroupChat(context.Background(), &grpcChatInfo)
+	if err != nil {
+		if strings.Contains(err.Error(), entity.ChatCreationError.Error()) {
+			return -1, entity.ChatCreationError
+		}
+		return -1, err
+	}
+
+	chat.ChatID = int(chatID.ChatID)
+	for _, member := range chat.Members {
+		err = chatApp.SendChat(chat.ChatID, member.UserID)
+		if err != nil && err != entity.ClientNotSetError {
+			return chat.ChatID, err
+		}
+	}
+
+	return chat.ChatID, nil
+}
+
+// checkNotBlocked returns UserBlockedError if one of users blocked the other
+func (chatApp *ChatApp) checkNotBlocked(firstUserID int, secondUserID int) error {
+	isBlocked, err := chatApp.followApp.CheckIfBlocked(firstUserID, secondUserID)
+	if err != nil {
+		return err
+	}
+	if isBlocked {
+		return entity.UserBlockedError
+	}
+	return nil
 }
 
 func (chatApp *ChatApp) GetChatIDByUsers(firstUserID int, secondUserID int) (int, error) {
-	return chatApp.chatRepo.GetChatIDByUsers(firstUserID, secondUserID)
+	chatID, err := chatApp.grpcClient.GetChatIDByUsers(context.Background(),
+		&grpcChat.ChatUsers{FirstUserID: int64(firstUserID), SecondUserID: int64(secondUserID)})
+	if err != nil {
+		if strings.Contains(err.Error(), entity.ChatNotFoundError.Error()) {
+			return -1, entity.ChatNotFoundError
+		}
+		return -1, err
+	}
+
+	return int(chatID.ChatID), nil
+}
+
+// getChat fetches chat with its members from chat service
+func (chatApp *ChatApp) getChat(chatID int) (*entity.Chat, error) {
+	grpcChatInfo, err := chatApp.grpcClient.GetChat(context.Background(), &grpcChat.ChatID{ChatID: int64(chatID)})
+	if err != nil {
+		if strings.Contains(err.Error(), entity.ChatNotFoundError.Error()) {
+			return nil, entity.ChatNotFoundError
+		}
+		return nil, err
+	}
+
+	chat := entity.Chat{}
+	ConvertFromGrpcChat(&chat, grpcChatInfo)
+	return &chat, nil
+}
+
+// getGroupChatAsManager fetches group chat, checking that user is its owner or admin
+func (chatApp *ChatApp) getGroupChatAsManager(chatID int, userID int) (*entity.Chat, error) {
+	chat, err := chatApp.getChat(chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if chat.Type != string(entity.GroupChatTypeKey) {
+		return nil, entity.NotGroupChatError
+	}
+
+	member := chat.GetMember(userID)
+	if member == nil {
+		return nil, entity.UserNotInChatError
+	}
+
+	if member.Role != string(entity.OwnerChatRoleKey) && member.Role != string(entity.AdminChatRoleKey) {
+		return nil, entity.ChatPermissionError
+	}
+
+	return chat, nil
+}
+
+// saveChat saves chat's title and avatar
+func (chatApp *ChatApp) saveChat(chat *entity.Chat) error {
+	grpcChatInfo := grpcChat.Chat{}
+	ConvertToGrpcChat(&grpcChatInfo, chat)
+	_, err := chatApp.grpcClient.SaveChat(context.Background(), &grpcChatInfo)
+	return err
+}
+
+// saveChatMember saves member's role, read and delivery state is changed only by moveReceipts
+func (chatApp *ChatApp) saveChatMember(chatID int, member *entity.ChatMember) error {
+	grpcMember := grpcChat.ChatMember{}
+	ConvertToGrpcChatMember(&grpcMember, chatID, member)
+	_, err := chatApp.grpcClient.SaveChatMember(context.Background(), &grpcMember)
+	if err != nil {
+		if strings.Contains(err.Error(), entity.UserNotInChatError.Error()) {
+			return entity.UserNotInChatError
+		}
+		return err
+	}
+
+	return nil
+}
+
+// moveReceipts atomically moves member's delivered and read pointers forward, so that concurrent updates can't move them back
+// It returns member with updated pointers
+func (chatApp *ChatApp) moveReceipts(chatID int, userID int, deliveredMessageID int, readMessageID int) (*entity.ChatMember, error) {
+	grpcMember, err := chatApp.grpcClient.MoveChatMemberReceipts(context.Background(), &grpcChat.ChatMember{
+		ChatID:                 int64(chatID),
+		UserID:                 int64(userID),
+		LastDeliveredMessageID: int64(deliveredMessageID),
+		LastReadMessageID:      int64(readMessageID),
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), entity.UserNotInChatError.Error()) {
+			return nil, entity.UserNotInChatError
+		}
+		return nil, err
+	}
+
+	return &entity.ChatMember{
+		UserID:                 int(grpcMember.UserID),
+		Role:                   grpcMember.Role,
+		LastReadMessageID:      int(grpcMember.LastReadMessageID),
+		LastDeliveredMessageID: int(grpcMember.LastDeliveredMessageID),
+	}, nil
+}
+
+func (chatApp *ChatApp) getMessage(messageID int) (*entity.Message, error) {
+	grpcMessage, err := chatApp.grpcClient.GetMessage(context.Background(), &grpcChat.MessageID{MessageID: int64(messageID)})
+	if err != nil {
+		if strings.Contains(err.Error(), entity.MessageNotFoundError.Error()) {
+			return nil, entity.MessageNotFoundError
+		}
+		return nil, err
+	}
+
+	message := entity.Message{}
+	ConvertFromGrpcMessage(&message, grpcMessage)
+	return &message, nil
+}
+
+// getLastMessageID returns ID of chat's newest message, 0 if chat is empty
+func (chatApp *ChatApp) getLastMessageID(chatID int) (int, error) {
+	grpcMessages, err := chatApp.grpcClient.GetMessagesPage(context.Background(),
+		&grpcChat.MessagesPage{ChatID: int64(chatID), Limit: 1})
+	if err != nil {
+		return -1, err
+	}
+
+	if len(grpcMessages.Messages) == 0 {
+		return 0, nil
+	}
+
+	return int(grpcMessages.Messages[0].MessageID), nil
 }
 
 func (chatApp *ChatApp) AddMessage(message *entity.Message) (int, error) {
-	chat, err := chatApp.chatRepo.GetChat(message.ChatID)
+	chat, err := chatApp.getChat(message.ChatID)
 	if err != nil {
 		return -1, err
 	}
 
-	switch {
-	case chat.FirstUserID == message.AuthorID:
-		chat.FirstUserRead = true
-		chat.SecondUserRead = false
-	case chat.SecondUserID == message.AuthorID:
-		chat.SecondUserRead = true
-		chat.FirstUserRead = false
-	default:
+	author := chat.GetMember(message.AuthorID)
+	if author == nil {
 		return -1, entity.UserNotInChatError
 	}
 
-	messageID, err := chatApp.chatRepo.AddMessage(message)
+	grpcMessage := grpcChat.Message{}
+	ConvertToGrpcMessage(&grpcMessage, message)
+	messageID, err := chatApp.grpcClient.AddMessage(context.Background(), &grpcMessage)
 	if err != nil {
+		if strings.Contains(err.Error(), entity.MessageAddingError.Error()) {
+			return -1, entity.MessageAddingError
+		}
 		return -1, err
 	}
 
-	chatApp.chatRepo.SaveChat(chat)
-	return messageID, nil
+	// Author has obviously received and read their own message
+	_, err = chatApp.moveReceipts(chat.ChatID, author.UserID, int(messageID.MessageID), int(messageID.MessageID))
+	if err != nil {
+		return -1, err
+	}
+
+	return int(messageID.MessageID), nil
 }
 
 func (chatApp *ChatApp) SendMessage(chatID int, messageID int, userID int) error {
-	chat, err := chatApp.chatRepo.GetChat(chatID)
+	chat, err := chatApp.getChat(chatID)
 	if err != nil {
 		return err
 	}
 
-	if chat.FirstUserID != userID && chat.SecondUserID != userID {
+	if chat.GetMember(userID) == nil {
 		return entity.UserNotInChatError
 	}
 
-	message, err := chatApp.chatRepo.GetMessage(messageID)
+	message, err := chatApp.getMessage(messageID)
+	if err != nil {
+		return err
+	}
+
+	err = chatApp.fillAttachmentPreviews(userID, []*entity.Message{message})
 	if err != nil {
 		return err
 	}
@@ -101,41 +346,66 @@ func (chatApp *ChatApp) SendMessage(chatID int, messageID int, userID int) error
 	return err
 }
 
-func (chatApp *ChatApp) SendChat(chatID int, userID int) error {
-	chat, err := chatApp.chatRepo.GetChat(chatID)
-	if err != nil {
-		return err
+// getChatOutput prepares chat summary as it is seen by specified user
+func (chatApp *ChatApp) getChatOutput(summary *grpcChat.ChatSummary, userID int) (*entity.ChatOutput, error) {
+	chat := entity.Chat{}
+	ConvertFromGrpcChat(&chat, summary.Chat)
+	if chat.GetMember(userID) == nil {
+		return nil, entity.UserNotInChatError
 	}
 
-	if chat.FirstUserID != userID && chat.SecondUserID != userID {
-		return entity.UserNotInChatError
+	profiles := make(map[int]*entity.User, len(chat.Members))
+	for _, member := range chat.Members {
+		profile, err := chatApp.userApp.GetUser(member.UserID)
+		if err != nil {
+			if chat.Type == string(entity.DirectChatTypeKey) {
+				return nil, entity.UserNotFoundError
+			}
+			continue // Group chat is still usable without one of its members
+		}
+		profiles[member.UserID] = profile
 	}
 
-	var target *entity.User
-	switch {
-	case chat.FirstUserID == userID:
-		target, err = chatApp.userApp.GetUser(chat.SecondUserID)
+	var lastMessage *entity.Message
+	if summary.LastMessage != nil {
+		lastMessage = new(entity.Message)
+		ConvertFromGrpcMessage(lastMessage, summary.LastMessage)
+		err := chatApp.fillAttachmentPreviews(userID, []*entity.Message{lastMessage})
 		if err != nil {
-			return entity.UserNotFoundError
+			return nil, err
 		}
-	case chat.SecondUserID == userID:
-		target, err = chatApp.userApp.GetUser(chat.FirstUserID)
-		if err != nil {
-			return entity.UserNotFoundError
+	}
+
+	var chatOutput entity.ChatOutput
+	chatOutput.FillFromChat(&chat, userID, profiles, lastMessage, int(summary.UnreadCount))
+	return &chatOutput, nil
+}
+
+// getChatSummaryOutput fetches chat's summary for specified user and prepares it for sending
+func (chatApp *ChatApp) getChatSummaryOutput(chatID int, userID int) (*entity.ChatOutput, error) {
+	summary, err := chatApp.grpcClient.GetChatSummary(context.Background(),
+		&grpcChat.ChatMember{ChatID: int64(chatID), UserID: int64(userID)})
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), entity.ChatNotFoundError.Error()):
+			return nil, entity.ChatNotFoundError
+		case strings.Contains(err.Error(), entity.UserNotInChatError.Error()):
+			return nil, entity.UserNotInChatError
+		default:
+			return nil, err
 		}
-	default:
-		return entity.UserNotInChatError
 	}
 
-	messages, err := chatApp.chatRepo.GetMessages(chatID)
+	return chatApp.getChatOutput(summary, userID)
+}
+
+func (chatApp *ChatApp) SendChat(chatID int, userID int) error {
+	chatOutput, err := chatApp.getChatSummaryOutput(chatID, userID)
 	if err != nil {
 		return err
 	}
 
-	var chatOutput entity.ChatOutput
-	chatOutput.FillFromChat(chat, target, messages)
-
-	chatOutputMsg := entity.OneChatOutput{Type: entity.OneChatTypeKey, Chat: chatOutput}
+	chatOutputMsg := entity.OneChatOutput{Type: entity.OneChatTypeKey, Chat: *chatOutput}
 
 	result, err := json.Marshal(chatOutputMsg)
 	if err != nil {
@@ -147,44 +417,29 @@ func (chatApp *ChatApp) SendChat(chatID int, userID int) error {
 	return err
 }
 
-func (chatApp *ChatApp) SendAllChats(userID int) error { // O(n) now, will be log(n) when i'll add actual database
-	chats, err := chatApp.chatRepo.GetAllChats(userID)
-	if err != nil {
-		if err != entity.ChatsNotFoundError {
-			return err
-		}
-		chats = make([]*entity.Chat, 0)
+func (chatApp *ChatApp) GetChats(userID int) ([]entity.ChatOutput, error) {
+	summaries, err := chatApp.grpcClient.GetChatSummaries(context.Background(), &grpcChat.UserID{Uid: int64(userID)})
+	if err != nil && !strings.Contains(err.Error(), entity.ChatsNotFoundError.Error()) {
+		return nil, err
 	}
 
-	chatOutputs := make([]entity.ChatOutput, 0, len(chats))
-	for _, chat := range chats {
-		messages, err := chatApp.chatRepo.GetMessages(chat.ChatID)
+	chatOutputs := make([]entity.ChatOutput, 0, len(summaries.GetSummaries()))
+	for _, summary := range summaries.GetSummaries() {
+		chatOutput, err := chatApp.getChatOutput(summary, userID)
 		if err != nil {
-			if err != entity.MessagesNotFoundError {
-				return err
-			}
-			messages = make([]*entity.Message, 0)
+			return nil, err
 		}
+		chatOutputs = append(chatOutputs, *chatOutput)
+	}
 
-		var target *entity.User
-		switch {
-		case chat.FirstUserID == userID:
-			target, err = chatApp.userApp.GetUser(chat.SecondUserID)
-			if err != nil {
-				return entity.UserNotFoundError
-			}
-		case chat.SecondUserID == userID:
-			target, err = chatApp.userApp.GetUser(chat.FirstUserID)
-			if err != nil {
-				return entity.UserNotFoundError
-			}
-		default:
-			return entity.UserNotInChatError
-		}
+	return chatOutputs, nil
+}
 
-		var chatOutput entity.ChatOutput
-		chatOutput.FillFromChat(chat, target, messages)
-		chatOutputs = append(chatOutputs, chatOutput)
+// SendAllChats sends snapshot of user's chats to one of their clients, without saving it to user's outbox
+func (chatApp *ChatApp) SendAllChats(userID int, client *websocket.Conn) error {
+	chatOutputs, err := chatApp.GetChats(userID)
+	if err != nil {
+		return err
 	}
 
 	chatsOutputMsg := entity.AllChatsOutput{Type: entity.AllChatsTypeKey, Chats: chatOutputs}
@@ -194,25 +449,951 @@ func (chatApp *ChatApp) SendAllChats(userID int) error { // O(n) now, will be lo
 		return entity.JsonMarshallError
 	}
 
-	err = chatApp.websocketApp.SendMessage(userID, result)
+	err = chatApp.websocketApp.SendMessageToClient(userID, client, result)
 
 	return err
 }
 
+func (chatApp *ChatApp) GetMessages(userID int, chatID int, beforeMessageID int, limit int) ([]*entity.Message, error) {
+	chat, err := chatApp.getChat(chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if chat.GetMember(userID) == nil {
+		return nil, entity.UserNotInChatError
+	}
+
+	grpcMessages, err := chatApp.grpcClient.GetMessagesPage(context.Background(), &grpcChat.MessagesPage{
+		ChatID:          int64(chatID),
+		BeforeMessageID: int64(beforeMessageID),
+		Limit:           int64(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := ConvertGrpcMessages(grpcMessages)
+	err = chatApp.fillAttachmentPreviews(userID, messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+func (chatApp *ChatApp) SearchMessages(userID int, query string, beforeMessageID int, limit int) ([]entity.MessageSearchResult, error) {
+	if len(entity.SplitSearchWords(query)) == 0 {
+		return nil, entity.EmptySearchQueryError
+	}
+
+	grpcResults, err := chatApp.grpcClient.SearchMessages(context.Background(), &grpcChat.SearchQuery{
+		UserID:          int64(userID),
+		Query:           query,
+		BeforeMessageID: int64(beforeMessageID),
+		Limit:           int64(limit),
+		ContextSize:     searchContextSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]entity.MessageSearchResult, 0, len(grpcResults.Results))
+	for _, grpcResult := range grpcResults.Results {
+		result := entity.MessageSearchResult{}
+		ConvertFromGrpcMessage(&result.Message, grpcResult.Message)
+		result.Highlights = entity.FindHighlights(result.Message.Text, query)
+		result.Before = make([]entity.Message, 0, len(grpcResult.Before))
+		for _, grpcMessage := range grpcResult.Before {
+			message := entity.Message{}
+			ConvertFromGrpcMessage(&message, grpcMessage)
+			result.Before = append(result.Before, message)
+		}
+		result.After = make([]entity.Message, 0, len(grpcResult.After))
+		for _, grpcMessage := range grpcResult.After {
+			message := entity.Message{}
+			ConvertFromGrpcMessage(&message, grpcMessage)
+			result.After = append(result.After, message)
+		}
+		results = append(results, result)
+	}
+
+	messages := make([]*entity.Message, 0, len(results)*(2*searchContextSize+1))
+	for i := range results {
+		messages = append(messages, &results[i].Message)
+		for j := range results[i].Before {
+			messages = append(messages, &results[i].Before[j])
+		}
+		for j := range results[i].After {
+			messages = append(messages, &results[i].After[j])
+		}
+	}
+	err = chatApp.fillAttachmentPreviews(userID, messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
 func (chatApp *ChatApp) ReadChat(chatID int, userID int) error {
-	chat, err := chatApp.chatRepo.GetChat(chatID)
+	lastMessageID, err := chatApp.getLastMessageID(chatID)
 	if err != nil {
 		return err
 	}
 
-	switch {
-	case chat.FirstUserID == userID:
-		chat.FirstUserRead = true
-	case chat.SecondUserID == userID:
-		chat.SecondUserRead = true
-	default:
+	return chatApp.ReadMessages(userID, chatID, lastMessageID)
+}
+
+// getChatMessage fetches message, checking that it belongs to specified chat
+func (chatApp *ChatApp) getChatMessage(chatID int, messageID int) (*entity.Message, error) {
+	message, err := chatApp.getMessage(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if message.ChatID != chatID {
+		return nil, entity.MessageNotFoundError
+	}
+
+	return message, nil
+}
+
+// updateReceipts moves member's delivered and read pointers forward and tells all of chat's members about it
+// Pointers never go back, so receipts that arrive late or out of order are ignored
+func (chatApp *ChatApp) updateReceipts(userID int, chatID int, deliveredMessageID int, readMessageID int) error {
+	chat, err := chatApp.getChat(chatID)
+	if err != nil {
+		return err
+	}
+
+	member := chat.GetMember(userID)
+	if member == nil {
 		return entity.UserNotInChatError
 	}
 
-	return chatApp.chatRepo.SaveChat(chat)
+	if readMessageID > deliveredMessageID { // Message can not be read before it is delivered
+		deliveredMessageID = readMessageID
+	}
+	if deliveredMessageID <= member.LastDeliveredMessageID && readMessageID <= member.LastReadMessageID {
+		return nil
+	}
+
+	member, err = chatApp.moveReceipts(chatID, userID, deliveredMessageID, readMessageID)
+	if err != nil {
+		return err
+	}
+
+	receipt := entity.ReceiptOutput{
+		Type:                   entity.ReceiptTypeKey,
+		ChatID:                 chatID,
+		UserID:                 userID,
+		LastDeliveredMessageID: member.LastDeliveredMessageID,
+		LastReadMessageID:      member.LastReadMessageID,
+	}
+	result, err := json.Marshal(receipt)
+	if err != nil {
+		return entity.JsonMarshallError
+	}
+
+	for _, chatMember := range chat.Members { // Member's other clients need receipt too, to update unread counts
+		err = chatApp.websocketApp.SendMessage(chatMember.UserID, result)
+		if err != nil && err != entity.ClientNotSetError {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (chatApp *ChatApp) ReadMessages(userID int, chatID int, messageID int) error {
+	if messageID != 0 { // 0 is passed for empty chats
+		_, err := chatApp.getChatMessage(chatID, messageID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return chatApp.updateReceipts(userID, chatID, 0, messageID)
+}
+
+func (chatApp *ChatApp) DeliverMessages(userID int, chatID int, messageID int) error {
+	_, err := chatApp.getChatMessage(chatID, messageID)
+	if err != nil {
+		return err
+	}
+
+	return chatApp.updateReceipts(userID, chatID, messageID, 0)
+}
+
+func (chatApp *ChatApp) PostMessage(authorID int, targetID int, text string, attachments []entity.MessageAttachmentInput) (int, error) {
+	if text == "" && len(attachments) == 0 {
+		return -1, entity.EmptyMessageError
+	}
+
+	messageAttachments, err := chatApp.checkAttachments(authorID, attachments)
+	if err != nil {
+		return -1, err
+	}
+
+	err = chatApp.checkNotBlocked(authorID, targetID)
+	if err != nil {
+		return -1, err
+	}
+
+	chatID, err := chatApp.GetChatIDByUsers(authorID, targetID)
+	chatExisted := true
+	if err != nil {
+		if err != entity.ChatNotFoundError {
+			return -1, err
+		}
+
+		chatID, err = chatApp.CreateChat(authorID, targetID)
+		chatExisted = false
+		if err == entity.ChatAlreadyExistsError { // Other message created chat after the lookup
+			chatID, err = chatApp.GetChatIDByUsers(authorID, targetID)
+			chatExisted = true
+		}
+		if err != nil {
+			return -1, err
+		}
+	}
+
+	message := entity.Message{
+		MessageID:      0,
+		ChatID:         chatID,
+		AuthorID:       authorID,
+		Text:           text,
+		TimeOfCreation: time.Now().String(),
+		CreationDate:   time.Now(),
+		Attachments:    messageAttachments,
+	}
+
+	messageID, err := chatApp.AddMessage(&message)
+	if err != nil {
+		return -1, err
+	}
+
+	for _, userID := range []int{authorID, targetID} {
+		if chatExisted { // If chat existed, we only send added message
+			err = chatApp.SendMessage(chatID, messageID, userID)
+		} else {
+			err = chatApp.SendChat(chatID, userID)
+		}
+
+		if err != nil && err != entity.ClientNotSetError {
+			return messageID, err
+		}
+	}
+
+	return messageID, nil
+}
+
+func (chatApp *ChatApp) PostChatMessage(authorID int, chatID int, text string, attachments []entity.MessageAttachmentInput) (int, error) {
+	if text == "" && len(attachments) == 0 {
+		return -1, entity.EmptyMessageError
+	}
+
+	messageAttachments, err := chatApp.checkAttachments(authorID, attachments)
+	if err != nil {
+		return -1, err
+	}
+
+	chat, err := chatApp.getChat(chatID)
+	if err != nil {
+		return -1, err
+	}
+
+	if chat.Type == string(entity.DirectChatTypeKey) {
+		for _, member := range chat.Members {
+			if member.UserID == authorID {
+				continue
+			}
+
+			err = chatApp.checkNotBlocked(authorID, member.UserID)
+			if err != nil {
+				return -1, err
+			}
+		}
+	}
+
+	message := entity.Message{
+		MessageID:      0,
+		ChatID:         chatID,
+		AuthorID:       authorID,
+		Text:           text,
+		TimeOfCreation: time.Now().String(),
+		CreationDate:   time.Now(),
+		Attachments:    messageAttachments,
+	}
+
+	messageID, err := chatApp.AddMessage(&message)
+	if err != nil {
+		return -1, err
+	}
+
+	for _, member := range chat.Members {
+		err = chatApp.SendMessage(chatID, messageID, member.UserID)
+		if err != nil && err != entity.ClientNotSetError {
+			return messageID, err
+		}
+	}
+
+	return messageID, nil
+}
+
+// checkAttachments checks that attached pins and boards exist and can be seen by message author
+func (chatApp *ChatApp) checkAttachments(authorID int, attachments []entity.MessageAttachmentInput) ([]*entity.MessageAttachment, error) {
+	if len(attachments) > maxMessageAttachments {
+		return nil, entity.TooManyAttachmentsError
+	}
+
+	messageAttachments := make([]*entity.MessageAttachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		var err error
+		switch attachment.Type {
+		case string(entity.PinAttachmentTypeKey):
+			_, err = chatApp.pinApp.GetPin(attachment.ID, authorID)
+		case string(entity.BoardAttachmentTypeKey):
+			_, err = chatApp.boardApp.GetBoard(attachment.ID, authorID)
+		default:
+			return nil, entity.IncorrectAttachmentError
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		messageAttachments = append(messageAttachments, &entity.MessageAttachment{Type: attachment.Type, ID: attachment.ID})
+	}
+
+	return messageAttachments, nil
+}
+
+// canSeeOwnersContent checks if viewer may see pins and boards of owner, who could block viewer or make their account private
+func (chatApp *ChatApp) canSeeOwnersContent(viewerID int, ownerID int) (bool, error) {
+	if viewerID == ownerID {
+		return true, nil
+	}
+
+	err := chatApp.checkNotBlocked(viewerID, ownerID)
+	switch err {
+	case nil:
+	case entity.UserBlockedError:
+		return false, nil
+	default:
+		return false, err
+	}
+
+	err = chatApp.followApp.CheckProfileAccess(viewerID, ownerID)
+	switch err {
+	case nil:
+		return true, nil
+	case entity.PrivateAccountError, entity.UserNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// getAttachmentPreview returns preview of attached pin or board as it is seen by viewer,
+// nil if it is not available anymore or viewer can't see it (e.g. they were removed from secret board or blocked by owner)
+func (chatApp *ChatApp) getAttachmentPreview(viewerID int, attachment *entity.MessageAttachment) (*entity.AttachmentPreview, error) {
+	preview := new(entity.AttachmentPreview)
+	ownerID := 0
+	switch attachment.Type {
+	case string(entity.PinAttachmentTypeKey):
+		pin, err := chatApp.pinApp.GetPin(attachment.ID, viewerID)
+		if err != nil {
+			if err == entity.PinNotFoundError { // Secret pins viewer can't see are not found either
+				return nil, nil
+			}
+			return nil, err
+		}
+		preview.FillFromPin(pin)
+		ownerID = pin.UserID
+	case string(entity.BoardAttachmentTypeKey):
+		board, err := chatApp.boardApp.GetBoard(attachment.ID, viewerID)
+		if err != nil {
+			if err == entity.BoardNotFoundError {
+				return nil, nil
+			}
+			return nil, err
+		}
+		preview.FillFromBoard(board)
+		ownerID = board.UserID
+	default:
+		return nil, nil
+	}
+
+	canSee, err := chatApp.canSeeOwnersContent(viewerID, ownerID)
+	if err != nil || !canSee {
+		return nil, err
+	}
+
+	return preview, nil
+}
+
+// fillAttachmentPreviews resolves attachments of passed messages into previews of pins and boards for viewer
+// Attachments whose pins or boards were deleted or can't be seen by viewer are marked as unavailable
+func (chatApp *ChatApp) fillAttachmentPreviews(viewerID int, messages []*entity.Message) error {
+	previews := make(map[entity.MessageAttachmentInput]*entity.AttachmentPreview) // Same pin is often shared many times in one chat
+	for _, message := range messages {
+		for _, attachment := range message.Attachments {
+			attachmentKey := entity.MessageAttachmentInput{Type: attachment.Type, ID: attachment.ID}
+			preview, found := previews[attachmentKey]
+			if !found {
+				var err error
+				preview, err = chatApp.getAttachmentPreview(viewerID, attachment)
+				if err != nil {
+					return err
+				}
+				previews[attachmentKey] = preview
+			}
+
+			attachment.Preview = preview
+			attachment.IsAvailable = preview != nil
+		}
+	}
+
+	return nil
+}
+
+// getMessageAsAuthor fetches message, checking that user is its author and that it can still be changed
+func (chatApp *ChatApp) getMessageAsAuthor(userID int, messageID int) (*entity.Message, error) {
+	message, err := chatApp.getMessage(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if message.AuthorID != userID {
+		return nil, entity.MessageAuthorError
+	}
+
+	if message.IsDeleted {
+		return nil, entity.MessageDeletedError
+	}
+
+	if time.Since(message.CreationDate) > messageChangeWindow {
+		return nil, entity.MessageChangeExpiredError
+	}
+
+	return message, nil
+}
+
+// saveMessage saves message's text, edit date and deletion flag
+func (chatApp *ChatApp) saveMessage(message *entity.Message) error {
+	grpcMessage := grpcChat.Message{}
+	ConvertToGrpcMessage(&grpcMessage, message)
+	_, err := chatApp.grpcClient.SaveMessage(context.Background(), &grpcMessage)
+	if err != nil {
+		if strings.Contains(err.Error(), entity.MessageNotFoundError.Error()) {
+			return entity.MessageNotFoundError
+		}
+		return err
+	}
+
+	return nil
+}
+
+// sendMessageChange sends edited message or tombstone of deleted one to all members of its chat
+func (chatApp *ChatApp) sendMessageChange(message *entity.Message) error {
+	chat, err := chatApp.getChat(message.ChatID)
+	if err != nil {
+		return err
+	}
+
+	messageOutput := entity.OneMessageOutput{Type: entity.MessageEditedTypeKey}
+	if message.IsDeleted {
+		messageOutput.Type = entity.MessageDeletedTypeKey
+	}
+
+	for _, member := range chat.Members { // Every member gets previews of attachments as they see them
+		err = chatApp.fillAttachmentPreviews(member.UserID, []*entity.Message{message})
+		if err != nil {
+			return err
+		}
+
+		messageOutput.Message = *message
+		result, err := json.Marshal(messageOutput)
+		if err != nil {
+			return entity.JsonMarshallError
+		}
+
+		err = chatApp.websocketApp.SendMessage(member.UserID, result)
+		if err != nil && err != entity.ClientNotSetError {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (chatApp *ChatApp) EditMessage(userID int, messageID int, text string) error {
+	message, err := chatApp.getMessageAsAuthor(userID, messageID)
+	if err != nil {
+		return err
+	}
+
+	if text == "" && len(message.Attachments) == 0 {
+		return entity.EmptyMessageError
+	}
+
+	editDate := time.Now()
+	message.Text = text
+	message.EditDate = &editDate
+	err = chatApp.saveMessage(message)
+	if err != nil {
+		return err
+	}
+
+	return chatApp.sendMessageChange(message)
+}
+
+func (chatApp *ChatApp) DeleteMessage(userID int, messageID int) error {
+	message, err := chatApp.getMessageAsAuthor(userID, messageID)
+	if err != nil {
+		return err
+	}
+
+	message.Text = ""
+	message.EditDate = nil
+	message.IsDeleted = true
+	message.Attachments = nil
+	err = chatApp.saveMessage(message)
+	if err != nil {
+		return err
+	}
+
+	return chatApp.sendMessageChange(message)
+}
+
+func (chatApp *ChatApp) SendTyping(userID int, chatID int) error {
+	chat, err := chatApp.getChat(chatID)
+	if err != nil {
+		return err
+	}
+
+	if chat.GetMember(userID) == nil {
+		return entity.UserNotInChatError
+	}
+
+	result, err := json.Marshal(entity.TypingOutput{Type: entity.TypingTypeKey, ChatID: chatID, UserID: userID})
+	if err != nil {
+		return entity.JsonMarshallError
+	}
+
+	for _, member := range chat.Members {
+		if member.UserID == userID {
+			continue
+		}
+
+		err = chatApp.websocketApp.PublishMessage(member.UserID, result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkPresenceAccess checks that requester follows user or has direct chat with them, and that neither blocked the other
+func (chatApp *ChatApp) checkPresenceAccess(requesterID int, userID int) error {
+	if requesterID == userID {
+		return nil
+	}
+
+	blockStatus, err := chatApp.followApp.GetBlockStatus(requesterID, userID)
+	if err != nil {
+		return err
+	}
+	if blockStatus.IsBlocked || blockStatus.IsBlockedBy {
+		return entity.PresenceForbiddenError
+	}
+
+	isFollowed, err := chatApp.followApp.CheckIfFollowed(requesterID, userID)
+	if err != nil {
+		return err
+	}
+	if isFollowed {
+		return nil
+	}
+
+	_, err = chatApp.GetChatIDByUsers(requesterID, userID)
+	if err != nil {
+		if err == entity.ChatNotFoundError {
+			return entity.PresenceForbiddenError
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (chatApp *ChatApp) GetPresence(requesterID int, userID int) (*entity.Presence, error) {
+	err := chatApp.checkPresenceAccess(requesterID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := chatApp.userApp.GetPrivacySettings(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if settings.HidePresence && requesterID != userID {
+		return &entity.Presence{UserID: userID, IsHidden: true}, nil
+	}
+
+	return chatApp.websocketApp.GetPresence(userID)
+}
+
+// sendChatUpdate sends changed chat to all of its members
+func (chatApp *ChatApp) sendChatUpdate(chat *entity.Chat) error {
+	for _, member := range chat.Members {
+		chatOutput, err := chatApp.getChatSummaryOutput(chat.ChatID, member.UserID)
+		if err != nil {
+			return err
+		}
+
+		result, err := json.Marshal(entity.OneChatOutput{Type: entity.ChatUpdateTypeKey, Chat: *chatOutput})
+		if err != nil {
+			return entity.JsonMarshallError
+		}
+
+		err = chatApp.websocketApp.SendMessage(member.UserID, result)
+		if err != nil && err != entity.ClientNotSetError {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendChatRemoved tells user they are not in chat anymore
+func (chatApp *ChatApp) sendChatRemoved(chatID int, userID int) error {
+	result, err := json.Marshal(entity.ChatRemovedOutput{Type: entity.ChatRemovedTypeKey, ChatID: chatID})
+	if err != nil {
+		return entity.JsonMarshallError
+	}
+
+	err = chatApp.websocketApp.SendMessage(userID, result)
+	if err != nil && err != entity.ClientNotSetError {
+		return err
+	}
+	return nil
+}
+
+func (chatApp *ChatApp) UpdateGroupChat(userID int, chatID int, title string) error {
+	if title == "" {
+		return entity.EmptyChatTitleError
+	}
+
+	chat, err := chatApp.getGroupChatAsManager(chatID, userID)
+	if err != nil {
+		return err
+	}
+
+	chat.Title = title
+	err = chatApp.saveChat(chat)
+	if err != nil {
+		return err
+	}
+
+	return chatApp.sendChatUpdate(chat)
+}
+
+func (chatApp *ChatApp) UpdateChatAvatar(userID int, chatID int, file io.Reader, extension string) error {
+	chat, err := chatApp.getGroupChatAsManager(chatID, userID)
+	if err != nil {
+		return err
+	}
+
+	filenamePrefix, err := entity.GenerateRandomString(40) // generating random filename
+	if err != nil {
+		return entity.FilenameGenerationError
+	}
+
+	newAvatarPath := "chat_avatars/" + filenamePrefix + extension
+	err = chatApp.s3App.UploadFile(file, newAvatarPath)
+	if err != nil {
+		return entity.FileUploadError
+	}
+
+	oldAvatarPath := chat.AvatarLink
+	chat.AvatarLink = newAvatarPath
+	err = chatApp.saveChat(chat)
+	if err != nil {
+		chatApp.s3App.DeleteFile(newAvatarPath)
+		return err
+	}
+
+	if oldAvatarPath != string(entity.ChatAvatarDefaultPath) {
+		chatApp.s3App.DeleteFile(oldAvatarPath)
+	}
+
+	return chatApp.sendChatUpdate(chat)
+}
+
+func (chatApp *ChatApp) AddChatMember(userID int, chatID int, newMemberID int) error {
+	chat, err := chatApp.getGroupChatAsManager(chatID, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = chatApp.userApp.GetUser(newMemberID)
+	if err != nil {
+		return entity.UserNotFoundError
+	}
+
+	err = chatApp.checkNotBlocked(userID, newMemberID)
+	if err != nil {
+		return err
+	}
+
+	newMember := entity.ChatMember{UserID: newMemberID, Role: string(entity.MemberChatRoleKey)}
+	grpcMember := grpcChat.ChatMember{}
+	ConvertToGrpcChatMember(&grpcMember, chatID, &newMember)
+	_, err = chatApp.grpcClient.AddChatMember(context.Background(), &grpcMember)
+	if err != nil {
+		if strings.Contains(err.Error(), entity.UserAlreadyInChatError.Error()) {
+			return entity.UserAlreadyInChatError
+		}
+		return err
+	}
+
+	chat.Members = append(chat.Members, &newMember)
+	return chatApp.sendChatUpdate(chat) // New member gets chat as well
+}
+
+// removeMember removes member from chat's members and notifies everyone who was in chat
+func (chatApp *ChatApp) removeMember(chat *entity.Chat, memberID int) error {
+	_, err := chatApp.grpcClient.RemoveChatMember(context.Background(),
+		&grpcChat.ChatMember{ChatID: int64(chat.ChatID), UserID: int64(memberID)})
+	if err != nil {
+		if strings.Contains(err.Error(), entity.UserNotInChatError.Error()) {
+			return entity.UserNotInChatError
+		}
+		return err
+	}
+
+	for i, member := range chat.Members {
+		if member.UserID == memberID {
+			chat.Members = append(chat.Members[:i], chat.Members[i+1:]...)
+			break
+		}
+	}
+
+	err = chatApp.sendChatRemoved(chat.ChatID, memberID)
+	if err != nil {
+		return err
+	}
+
+	return chatApp.sendChatUpdate(chat)
+}
+
+func (chatApp *ChatApp) RemoveChatMember(userID int, chatID int, memberID int) error {
+	if userID == memberID {
+		return chatApp.LeaveChat(userID, chatID)
+	}
+
+	chat, err := chatApp.getGroupChatAsManager(chatID, userID)
+	if err != nil {
+		return err
+	}
+
+	member := chat.GetMember(memberID)
+	if member == nil {
+		return entity.UserNotInChatError
+	}
+
+	if member.Role != string(entity.MemberChatRoleKey) && chat.GetMember(userID).Role != string(entity.OwnerChatRoleKey) {
+		return entity.ChatPermissionError // Only owner can remove admins
+	}
+
+	return chatApp.removeMember(chat, memberID)
+}
+
+func (chatApp *ChatApp) LeaveChat(userID int, chatID int) error {
+	chat, err := chatApp.getChat(chatID)
+	if err != nil {
+		return err
+	}
+
+	if chat.Type != string(entity.GroupChatTypeKey) {
+		return entity.NotGroupChatError
+	}
+
+	member := chat.GetMember(userID)
+	if member == nil {
+		return entity.UserNotInChatError
+	}
+
+	if len(chat.Members) == 1 { // Nobody would be left in chat
+		_, err = chatApp.grpcClient.DeleteChat(context.Background(), &grpcChat.ChatID{ChatID: int64(chatID)})
+		if err != nil {
+			return err
+		}
+
+		if chat.AvatarLink != string(entity.ChatAvatarDefaultPath) {
+			chatApp.s3App.DeleteFile(chat.AvatarLink)
+		}
+		return chatApp.sendChatRemoved(chatID, userID)
+	}
+
+	if member.Role == string(entity.OwnerChatRoleKey) { // Ownership goes to some admin, or to some member if there are no admins
+		var newOwner *entity.ChatMember
+		for _, candidate := range chat.Members {
+			if candidate.UserID == userID {
+				continue
+			}
+			if newOwner == nil || (candidate.Role == string(entity.AdminChatRoleKey) && newOwner.Role != string(entity.AdminChatRoleKey)) {
+				newOwner = candidate
+			}
+		}
+
+		newOwner.Role = string(entity.OwnerChatRoleKey)
+		err = chatApp.saveChatMember(chatID, newOwner)
+		if err != nil {
+			return err
+		}
+	}
+
+	return chatApp.removeMember(chat, userID)
+}
+
+func (chatApp *ChatApp) SetChatMemberRole(userID int, chatID int, memberID int, role string) error {
+	if role != string(entity.OwnerChatRoleKey) && role != string(entity.AdminChatRoleKey) && role != string(entity.MemberChatRoleKey) {
+		return entity.IncorrectChatRoleError
+	}
+
+	chat, err := chatApp.getGroupChatAsManager(chatID, userID)
+	if err != nil {
+		return err
+	}
+
+	owner := chat.GetMember(userID)
+	if owner.Role != string(entity.OwnerChatRoleKey) {
+		return entity.ChatPermissionError
+	}
+
+	member := chat.GetMember(memberID)
+	if member == nil {
+		return entity.UserNotInChatError
+	}
+
+	if member == owner {
+		return entity.ChatPermissionError // Owner can only pass ownership to someone else
+	}
+
+	if role == string(entity.OwnerChatRoleKey) { // There is only one owner, so previous one becomes admin
+		owner.Role = string(entity.AdminChatRoleKey)
+		err = chatApp.saveChatMember(chatID, owner)
+		if err != nil {
+			return err
+		}
+	}
+
+	member.Role = role
+	err = chatApp.saveChatMember(chatID, member)
+	if err != nil {
+		return err
+	}
+
+	return chatApp.sendChatUpdate(chat)
+}
+
+func ConvertToGrpcChat(grpcChatInfo *grpcChat.Chat, chat *entity.Chat) {
+	grpcChatInfo.ChatID = int64(chat.ChatID)
+	grpcChatInfo.Type = chat.Type
+	grpcChatInfo.Title = chat.Title
+	grpcChatInfo.AvatarLink = chat.AvatarLink
+	grpcChatInfo.Members = make([]*grpcChat.ChatMember, 0, len(chat.Members))
+	for _, member := range chat.Members {
+		grpcMember := grpcChat.ChatMember{}
+		ConvertToGrpcChatMember(&grpcMember, chat.ChatID, member)
+		grpcChatInfo.Members = append(grpcChatInfo.Members, &grpcMember)
+	}
+}
+
+func ConvertFromGrpcChat(chat *entity.Chat, grpcChatInfo *grpcChat.Chat) {
+	chat.ChatID = int(grpcChatInfo.ChatID)
+	chat.Type = grpcChatInfo.Type
+	chat.Title = grpcChatInfo.Title
+	chat.AvatarLink = grpcChatInfo.AvatarLink
+	chat.Members = make([]*entity.ChatMember, 0, len(grpcChatInfo.Members))
+	for _, grpcMember := range grpcChatInfo.Members {
+		chat.Members = append(chat.Members, &entity.ChatMember{
+			UserID:                 int(grpcMember.UserID),
+			Role:                   grpcMember.Role,
+			LastReadMessageID:      int(grpcMember.LastReadMessageID),
+			LastDeliveredMessageID: int(grpcMember.LastDeliveredMessageID),
+		})
+	}
+}
+
+func ConvertToGrpcChatMember(grpcMember *grpcChat.ChatMember, chatID int, member *entity.ChatMember) {
+	grpcMember.ChatID = int64(chatID)
+	grpcMember.UserID = int64(member.UserID)
+	grpcMember.Role = member.Role
+	grpcMember.LastReadMessageID = int64(member.LastReadMessageID)
+	grpcMember.LastDeliveredMessageID = int64(member.LastDeliveredMessageID)
+}
+
+func ConvertGrpcChats(grpcChats *grpcChat.ChatsList) []*entity.Chat {
+	chats := make([]*entity.Chat, 0, len(grpcChats.GetChats()))
+	for _, grpcChatInfo := range grpcChats.GetChats() {
+		chat := entity.Chat{}
+		ConvertFromGrpcChat(&chat, grpcChatInfo)
+		chats = append(chats, &chat)
+	}
+	return chats
+}
+
+func ConvertToGrpcMessage(grpcMessage *grpcChat.Message, message *entity.Message) {
+	grpcMessage.MessageID = int64(message.MessageID)
+	grpcMessage.ChatID = int64(message.ChatID)
+	grpcMessage.AuthorID = int64(message.AuthorID)
+	grpcMessage.Text = message.Text
+	grpcMessage.TimeOfCreation = message.TimeOfCreation
+	grpcMessage.CreationDate = timestamppb.New(message.CreationDate)
+	grpcMessage.EditDate = nil
+	if message.EditDate != nil {
+		grpcMessage.EditDate = timestamppb.New(*message.EditDate)
+	}
+	grpcMessage.IsDeleted = message.IsDeleted
+	grpcMessage.Attachments = make([]*grpcChat.Attachment, 0, len(message.Attachments))
+	for _, attachment := range message.Attachments {
+		grpcMessage.Attachments = append(grpcMessage.Attachments,
+			&grpcChat.Attachment{Type: attachment.Type, ID: int64(attachment.ID)})
+	}
+}
+
+func ConvertFromGrpcMessage(message *entity.Message, grpcMessage *grpcChat.Message) {
+	message.MessageID = int(grpcMessage.MessageID)
+	message.ChatID = int(grpcMessage.ChatID)
+	message.AuthorID = int(grpcMessage.AuthorID)
+	message.Text = grpcMessage.Text
+	message.TimeOfCreation = grpcMessage.TimeOfCreation
+	message.CreationDate = grpcMessage.CreationDate.AsTime()
+	message.EditDate = nil
+	if grpcMessage.EditDate != nil {
+		editDate := grpcMessage.EditDate.AsTime()
+		message.EditDate = &editDate
+	}
+	message.IsDeleted = grpcMessage.IsDeleted
+	message.Attachments = nil
+	for _, grpcAttachment := range grpcMessage.Attachments {
+		message.Attachments = append(message.Attachments,
+			&entity.MessageAttachment{Type: grpcAttachment.Type, ID: int(grpcAttachment.ID)})
+	}
+}
+
+func ConvertGrpcMessages(grpcMessages *grpcChat.MessagesList) []*entity.Message {
+	messages := make([]*entity.Message, 0, len(grpcMessages.GetMessages()))
+	for _, grpcMessage := range grpcMessages.GetMessages() {
+		message := entity.Message{}
+		ConvertFromGrpcMessage(&message, grpcMessage)
+		messages = append(messages, &message)
+	}
+	return messages
 }
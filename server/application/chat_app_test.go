@@ -0,0 +1,494 @@
+package application
+
+import (
+	"pinterest/application/mock_application"
+	"pinterest/domain/entity"
+	"pinterest/services/chat/mock_chat"
+	grpcChat "pinterest/services/chat/proto"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type chatTestMocks struct {
+	grpcClient   *mock_chat.MockChatsClient
+	userApp      *mock_application.MockUserAppInterface
+	followApp    *mock_application.MockFollowAppInterface
+	pinApp       *mock_application.MockPinAppInterface
+	boardApp     *mock_application.MockBoardAppInterface
+	websocketApp *mock_application.MockWebsocketAppInterface
+}
+
+// newTestChatApp creates ChatApp with mocked dependencies, S3 is not used by tested methods
+func newTestChatApp(mockCtrl *gomock.Controller) (*ChatApp, chatTestMocks) {
+	mocks := chatTestMocks{
+		grpcClient:   mock_chat.NewMockChatsClient(mockCtrl),
+		userApp:      mock_application.NewMockUserAppInterface(mockCtrl),
+		followApp:    mock_application.NewMockFollowAppInterface(mockCtrl),
+		pinApp:       mock_application.NewMockPinAppInterface(mockCtrl),
+		boardApp:     mock_application.NewMockBoardAppInterface(mockCtrl),
+		websocketApp: mock_application.NewMockWebsocketAppInterface(mockCtrl),
+	}
+	return NewChatApp(mocks.grpcClient, mocks.userApp, mocks.followApp, mocks.pinApp, mocks.boardApp, nil, mocks.websocketApp), mocks
+}
+
+// testGroupChat returns group chat with owner 1, admins 2 and 4 and member 3
+// New chat is made on every call, as chat app changes members of chats it gets
+func testGroupChat() *grpcChat.Chat {
+	return &grpcChat.Chat{
+		ChatID: 1,
+		Type:   string(entity.GroupChatTypeKey),
+		Title:  "Test chat",
+		Members: []*grpcChat.ChatMember{
+			{ChatID: 1, UserID: 1, Role: string(entity.OwnerChatRoleKey)},
+			{ChatID: 1, UserID: 2, Role: string(entity.AdminChatRoleKey)},
+			{ChatID: 1, UserID: 3, Role: string(entity.MemberChatRoleKey), LastReadMessageID: 5, LastDeliveredMessageID: 8},
+			{ChatID: 1, UserID: 4, Role: string(entity.AdminChatRoleKey)},
+		},
+	}
+}
+
+// expectChatUpdates lets chat app send updated chat to its members
+func expectChatUpdates(mocks chatTestMocks) {
+	mocks.grpcClient.EXPECT().GetChatSummary(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, member *grpcChat.ChatMember, _ ...interface{}) (*grpcChat.ChatSummary, error) {
+			return &grpcChat.ChatSummary{Chat: testGroupChat()}, nil
+		}).AnyTimes()
+	mocks.userApp.EXPECT().GetUser(gomock.Any()).Return(&entity.User{}, nil).AnyTimes()
+	mocks.websocketApp.EXPECT().SendMessage(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+}
+
+var chatRolesTest = []struct {
+	name           string
+	action         func(chatApp *ChatApp) error
+	savedMembers   []*grpcChat.ChatMember // Members whose roles are expected to be saved, in order
+	removedMembers []*grpcChat.ChatMember
+	expectedErr    error
+}{
+	{
+		name: "Testing owner makes member an admin",
+		action: func(chatApp *ChatApp) error {
+			return chatApp.SetChatMemberRole(1, 1, 3, string(entity.AdminChatRoleKey))
+		},
+		savedMembers: []*grpcChat.ChatMember{{ChatID: 1, UserID: 3, Role: string(entity.AdminChatRoleKey), LastReadMessageID: 5, LastDeliveredMessageID: 8}},
+	},
+	{
+		name: "Testing owner passes ownership",
+		action: func(chatApp *ChatApp) error {
+			return chatApp.SetChatMemberRole(1, 1, 2, string(entity.OwnerChatRoleKey))
+		},
+		savedMembers: []*grpcChat.ChatMember{
+			{ChatID: 1, UserID: 1, Role: string(entity.AdminChatRoleKey)},
+			{ChatID: 1, UserID: 2, Role: string(entity.OwnerChatRoleKey)},
+		},
+	},
+	{
+		name: "Testing admin changes member's role",
+		action: func(chatApp *ChatApp) error {
+			return chatApp.SetChatMemberRole(2, 1, 3, string(entity.AdminChatRoleKey))
+		},
+		expectedErr: entity.ChatPermissionError,
+	},
+	{
+		name: "Testing owner changes own role",
+		action: func(chatApp *ChatApp) error {
+			return chatApp.SetChatMemberRole(1, 1, 1, string(entity.MemberChatRoleKey))
+		},
+		expectedErr: entity.ChatPermissionError,
+	},
+	{
+		name:        "Testing set unknown role",
+		action:      func(chatApp *ChatApp) error { return chatApp.SetChatMemberRole(1, 1, 3, "king") },
+		expectedErr: entity.IncorrectChatRoleError,
+	},
+	{
+		name: "Testing set role of user who is not in chat",
+		action: func(chatApp *ChatApp) error {
+			return chatApp.SetChatMemberRole(1, 1, 9, string(entity.AdminChatRoleKey))
+		},
+		expectedErr: entity.UserNotInChatError,
+	},
+	{
+		name:           "Testing admin removes member",
+		action:         func(chatApp *ChatApp) error { return chatApp.RemoveChatMember(2, 1, 3) },
+		removedMembers: []*grpcChat.ChatMember{{ChatID: 1, UserID: 3}},
+	},
+	{
+		name:           "Testing owner removes admin",
+		action:         func(chatApp *ChatApp) error { return chatApp.RemoveChatMember(1, 1, 2) },
+		removedMembers: []*grpcChat.ChatMember{{ChatID: 1, UserID: 2}},
+	},
+	{
+		name:        "Testing admin removes another admin",
+		action:      func(chatApp *ChatApp) error { return chatApp.RemoveChatMember(2, 1, 4) },
+		expectedErr: entity.ChatPermissionError,
+	},
+	{
+		name:        "Testing member removes member",
+		action:      func(chatApp *ChatApp) error { return chatApp.RemoveChatMember(3, 1, 4) },
+		expectedErr: entity.ChatPermissionError,
+	},
+	{
+		name:        "Testing member adds user to chat",
+		action:      func(chatApp *ChatApp) error { return chatApp.AddChatMember(3, 1, 5) },
+		expectedErr: entity.ChatPermissionError,
+	},
+	{
+		name:           "Testing owner leaves chat",
+		action:         func(chatApp *ChatApp) error { return chatApp.LeaveChat(1, 1) },
+		savedMembers:   []*grpcChat.ChatMember{{ChatID: 1, UserID: 2, Role: string(entity.OwnerChatRoleKey)}}, // Admins are preferred
+		removedMembers: []*grpcChat.ChatMember{{ChatID: 1, UserID: 1}},
+	},
+}
+
+func TestChatMemberRoles(t *testing.T) {
+	for _, tt := range chatRolesTest {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			chatApp, mocks := newTestChatApp(mockCtrl)
+			mocks.grpcClient.EXPECT().GetChat(gomock.Any(), &grpcChat.ChatID{ChatID: 1}).Return(testGroupChat(), nil).MaxTimes(1)
+			expectChatUpdates(mocks)
+
+			savedCalls := make([]*gomock.Call, 0, len(tt.savedMembers))
+			for _, member := range tt.savedMembers {
+				savedCalls = append(savedCalls,
+					mocks.grpcClient.EXPECT().SaveChatMember(gomock.Any(), member).Return(&grpcChat.Error{}, nil))
+			}
+			gomock.InOrder(savedCalls...)
+			for _, member := range tt.removedMembers {
+				mocks.grpcClient.EXPECT().RemoveChatMember(gomock.Any(), member).Return(&grpcChat.Error{}, nil).Times(1)
+			}
+
+			err := tt.action(chatApp)
+			require.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+var chatReceiptsTest = []struct {
+	name            string
+	action          func(chatApp *ChatApp) error
+	message         *grpcChat.Message    // Message fetched by chat app, nil if it should not be fetched
+	movedReceipts   *grpcChat.ChatMember // Receipts chat app should save, nil if they should not be moved
+	savedReceipts   *grpcChat.ChatMember // Receipts saved by chat service, which can be further than moved ones
+	moveErr         error
+	expectedReceipt []byte // Receipt each member should get, nil if nothing should be sent
+	expectedErr     error
+}{
+	{
+		name:            "Testing member reads message",
+		action:          func(chatApp *ChatApp) error { return chatApp.ReadMessages(3, 1, 10) },
+		message:         &grpcChat.Message{MessageID: 10, ChatID: 1, AuthorID: 1},
+		movedReceipts:   &grpcChat.ChatMember{ChatID: 1, UserID: 3, LastDeliveredMessageID: 10, LastReadMessageID: 10},
+		savedReceipts:   &grpcChat.ChatMember{ChatID: 1, UserID: 3, LastDeliveredMessageID: 10, LastReadMessageID: 10},
+		expectedReceipt: []byte(`{"type":"receipt","chatID":1,"userID":3,"lastDeliveredMessageID":10,"lastReadMessageID":10}`),
+	},
+	{
+		name:    "Testing member gets message they have received already",
+		action:  func(chatApp *ChatApp) error { return chatApp.DeliverMessages(3, 1, 7) },
+		message: &grpcChat.Message{MessageID: 7, ChatID: 1, AuthorID: 1},
+	},
+	{
+		name:        "Testing member reads message from another chat",
+		action:      func(chatApp *ChatApp) error { return chatApp.ReadMessages(3, 1, 10) },
+		message:     &grpcChat.Message{MessageID: 10, ChatID: 2, AuthorID: 1},
+		expectedErr: entity.MessageNotFoundError,
+	},
+	{
+		name:            "Testing member gets message after their other client read newer one",
+		action:          func(chatApp *ChatApp) error { return chatApp.DeliverMessages(3, 1, 9) },
+		message:         &grpcChat.Message{MessageID: 9, ChatID: 1, AuthorID: 1},
+		movedReceipts:   &grpcChat.ChatMember{ChatID: 1, UserID: 3, LastDeliveredMessageID: 9},
+		savedReceipts:   &grpcChat.ChatMember{ChatID: 1, UserID: 3, LastDeliveredMessageID: 12, LastReadMessageID: 6},
+		expectedReceipt: []byte(`{"type":"receipt","chatID":1,"userID":3,"lastDeliveredMessageID":12,"lastReadMessageID":6}`),
+	},
+	{
+		name: "Testing receipts of message's author can not be moved",
+		action: func(chatApp *ChatApp) error {
+			_, err := chatApp.AddMessage(&entity.Message{ChatID: 1, AuthorID: 3, Text: "Hi"})
+			return err
+		},
+		movedReceipts: &grpcChat.ChatMember{ChatID: 1, UserID: 3, LastDeliveredMessageID: 11, LastReadMessageID: 11},
+		moveErr:       entity.UserNotInChatError,
+		expectedErr:   entity.UserNotInChatError,
+	},
+}
+
+func TestChatReceipts(t *testing.T) {
+	for _, tt := range chatReceiptsTest {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			chatApp, mocks := newTestChatApp(mockCtrl)
+			mocks.grpcClient.EXPECT().GetChat(gomock.Any(), &grpcChat.ChatID{ChatID: 1}).Return(testGroupChat(), nil).MaxTimes(1)
+			mocks.grpcClient.EXPECT().AddMessage(gomock.Any(), gomock.Any()).Return(&grpcChat.MessageID{MessageID: 11}, nil).AnyTimes()
+
+			if tt.message != nil {
+				mocks.grpcClient.EXPECT().GetMessage(gomock.Any(), &grpcChat.MessageID{MessageID: tt.message.MessageID}).
+					Return(tt.message, nil).Times(1)
+			}
+			if tt.movedReceipts != nil {
+				mocks.grpcClient.EXPECT().MoveChatMemberReceipts(gomock.Any(), tt.movedReceipts).
+					Return(tt.savedReceipts, tt.moveErr).Times(1)
+			}
+			if tt.expectedReceipt != nil {
+				for _, member := range testGroupChat().Members {
+					mocks.websocketApp.EXPECT().SendMessage(int(member.UserID), tt.expectedReceipt).Return(nil).Times(1)
+				}
+			}
+
+			err := tt.action(chatApp)
+			require.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestPostMessageToConcurrentlyCreatedChat(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	chatApp, mocks := newTestChatApp(mockCtrl)
+	directChat := &grpcChat.Chat{
+		ChatID: 7,
+		Type:   string(entity.DirectChatTypeKey),
+		Members: []*grpcChat.ChatMember{
+			{ChatID: 7, UserID: 1, Role: string(entity.MemberChatRoleKey)},
+			{ChatID: 7, UserID: 2, Role: string(entity.MemberChatRoleKey)},
+		},
+	}
+	chatUsers := &grpcChat.ChatUsers{FirstUserID: 1, SecondUserID: 2}
+
+	mocks.followApp.EXPECT().CheckIfBlocked(1, 2).Return(false, nil).AnyTimes()
+	mocks.userApp.EXPECT().GetUser(gomock.Any()).Return(&entity.User{}, nil).AnyTimes()
+	gomock.InOrder(
+		mocks.grpcClient.EXPECT().GetChatIDByUsers(gomock.Any(), chatUsers).Return(nil, entity.ChatNotFoundError),
+		mocks.grpcClient.EXPECT().CreateChat(gomock.Any(), chatUsers).Return(nil, entity.ChatAlreadyExistsError), // Other message was first
+		mocks.grpcClient.EXPECT().GetChatIDByUsers(gomock.Any(), chatUsers).Return(&grpcChat.ChatID{ChatID: 7}, nil),
+	)
+	mocks.grpcClient.EXPECT().GetChat(gomock.Any(), &grpcChat.ChatID{ChatID: 7}).Return(directChat, nil).AnyTimes()
+	mocks.grpcClient.EXPECT().AddMessage(gomock.Any(), gomock.Any()).Return(&grpcChat.MessageID{MessageID: 11}, nil).Times(1)
+	mocks.grpcClient.EXPECT().MoveChatMemberReceipts(gomock.Any(), gomock.Any()).Return(directChat.Members[0], nil).Times(1)
+	mocks.grpcClient.EXPECT().GetMessage(gomock.Any(), &grpcChat.MessageID{MessageID: 11}).
+		Return(&grpcChat.Message{MessageID: 11, ChatID: 7, AuthorID: 1, Text: "Hi"}, nil).Times(2)
+	for _, userID := range []int{1, 2} { // Chat already reached both users with the other message, so only this message is sent
+		mocks.websocketApp.EXPECT().SendMessage(userID, gomock.Any()).Return(nil).Times(1)
+	}
+
+	messageID, err := chatApp.PostMessage(1, 2, "Hi", nil)
+	require.NoError(t, err, "Message should go to chat created by concurrent message")
+	require.Equal(t, 11, messageID)
+}
+
+// Previews are resolved for viewer 2, attached pins and boards belong to user 1
+var testAttachedPin = &entity.Pin{PinID: 5, UserID: 1, Title: "Pin", ImageLink: "pin.jpg", ImageHeight: 1, ImageWidth: 1, ImageAvgColor: "FFFFFF"}
+var testAttachedBoard = &entity.Board{BoardID: 6, UserID: 1, Title: "Board", ImageLink: "board.jpg", ImageHeight: 1, ImageWidth: 1, ImageAvgColor: "000000"}
+
+var attachmentPreviewsTest = []struct {
+	name            string
+	attachment      entity.MessageAttachment
+	expect          func(mocks chatTestMocks)
+	expectedPreview *entity.AttachmentPreview
+	expectedErr     error
+}{
+	{
+		name:       "Testing public pin",
+		attachment: entity.MessageAttachment{Type: string(entity.PinAttachmentTypeKey), ID: 5},
+		expect: func(mocks chatTestMocks) {
+			mocks.pinApp.EXPECT().GetPin(5, 2).Return(testAttachedPin, nil).Times(1)
+			mocks.followApp.EXPECT().CheckIfBlocked(2, 1).Return(false, nil).Times(1)
+			mocks.followApp.EXPECT().CheckProfileAccess(2, 1).Return(nil).Times(1)
+		},
+		expectedPreview: &entity.AttachmentPreview{Title: "Pin", ImageLink: "pin.jpg", ImageHeight: 1, ImageWidth: 1, ImageAvgColor: "FFFFFF"},
+	},
+	{
+		name:       "Testing secret pin viewer can't see",
+		attachment: entity.MessageAttachment{Type: string(entity.PinAttachmentTypeKey), ID: 5},
+		expect: func(mocks chatTestMocks) {
+			mocks.pinApp.EXPECT().GetPin(5, 2).Return(nil, entity.PinNotFoundError).Times(1) // Pins service hides it from viewer
+		},
+	},
+	{
+		name:       "Testing deleted pin",
+		attachment: entity.MessageAttachment{Type: string(entity.PinAttachmentTypeKey), ID: 5},
+		expect: func(mocks chatTestMocks) {
+			mocks.pinApp.EXPECT().GetPin(5, 2).Return(nil, entity.PinNotFoundError).Times(1)
+		},
+	},
+	{
+		name:       "Testing pin of author who blocked viewer",
+		attachment: entity.MessageAttachment{Type: string(entity.PinAttachmentTypeKey), ID: 5},
+		expect: func(mocks chatTestMocks) {
+			mocks.pinApp.EXPECT().GetPin(5, 2).Return(testAttachedPin, nil).Times(1)
+			mocks.followApp.EXPECT().CheckIfBlocked(2, 1).Return(true, nil).Times(1)
+		},
+	},
+	{
+		name:       "Testing pin of private account viewer doesn't follow",
+		attachment: entity.MessageAttachment{Type: string(entity.PinAttachmentTypeKey), ID: 5},
+		expect: func(mocks chatTestMocks) {
+			mocks.pinApp.EXPECT().GetPin(5, 2).Return(testAttachedPin, nil).Times(1)
+			mocks.followApp.EXPECT().CheckIfBlocked(2, 1).Return(false, nil).Times(1)
+			mocks.followApp.EXPECT().CheckProfileAccess(2, 1).Return(entity.PrivateAccountError).Times(1)
+		},
+	},
+	{
+		name:       "Testing pin that could not be fetched",
+		attachment: entity.MessageAttachment{Type: string(entity.PinAttachmentTypeKey), ID: 5},
+		expect: func(mocks chatTestMocks) {
+			mocks.pinApp.EXPECT().GetPin(5, 2).Return(nil, entity.PinScanError).Times(1)
+		},
+		expectedErr: entity.PinScanError,
+	},
+	{
+		name:       "Testing public board",
+		attachment: entity.MessageAttachment{Type: string(entity.BoardAttachmentTypeKey), ID: 6},
+		expect: func(mocks chatTestMocks) {
+			mocks.boardApp.EXPECT().GetBoard(6, 2).Return(testAttachedBoard, nil).Times(1)
+			mocks.followApp.EXPECT().CheckIfBlocked(2, 1).Return(false, nil).Times(1)
+			mocks.followApp.EXPECT().CheckProfileAccess(2, 1).Return(nil).Times(1)
+		},
+		expectedPreview: &entity.AttachmentPreview{Title: "Board", ImageLink: "board.jpg", ImageHeight: 1, ImageWidth: 1, ImageAvgColor: "000000"},
+	},
+	{
+		name:       "Testing secret board viewer is not invited to",
+		attachment: entity.MessageAttachment{Type: string(entity.BoardAttachmentTypeKey), ID: 6},
+		expect: func(mocks chatTestMocks) {
+			mocks.boardApp.EXPECT().GetBoard(6, 2).Return(nil, entity.BoardNotFoundError).Times(1) // Pins service hides it from viewer
+		},
+	},
+	{
+		name:       "Testing deleted board",
+		attachment: entity.MessageAttachment{Type: string(entity.BoardAttachmentTypeKey), ID: 6},
+		expect: func(mocks chatTestMocks) {
+			mocks.boardApp.EXPECT().GetBoard(6, 2).Return(nil, entity.BoardNotFoundError).Times(1)
+		},
+	},
+	{
+		name:       "Testing board of owner viewer blocked",
+		attachment: entity.MessageAttachment{Type: string(entity.BoardAttachmentTypeKey), ID: 6},
+		expect: func(mocks chatTestMocks) {
+			mocks.boardApp.EXPECT().GetBoard(6, 2).Return(testAttachedBoard, nil).Times(1)
+			mocks.followApp.EXPECT().CheckIfBlocked(2, 1).Return(true, nil).Times(1)
+		},
+	},
+	{
+		name:       "Testing attachment of unknown type",
+		attachment: entity.MessageAttachment{Type: "video", ID: 5},
+		expect:     func(mocks chatTestMocks) {},
+	},
+}
+
+func TestAttachmentPreviews(t *testing.T) {
+	for _, tt := range attachmentPreviewsTest {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			chatApp, mocks := newTestChatApp(mockCtrl)
+			tt.expect(mocks)
+
+			attachment := tt.attachment
+			err := chatApp.fillAttachmentPreviews(2, []*entity.Message{{Attachments: []*entity.MessageAttachment{&attachment}}})
+			require.Equal(t, tt.expectedErr, err)
+			if err != nil {
+				return
+			}
+
+			require.Equal(t, tt.expectedPreview, attachment.Preview)
+			require.Equal(t, tt.expectedPreview != nil, attachment.IsAvailable, "Hidden attachments should be marked as unavailable")
+		})
+	}
+}
+
+var checkAttachmentsTest = []struct {
+	name        string
+	attachment  entity.MessageAttachmentInput
+	expect      func(mocks chatTestMocks)
+	expectedErr error
+}{
+	{
+		name:       "Testing sharing secret pin author can't see",
+		attachment: entity.MessageAttachmentInput{Type: string(entity.PinAttachmentTypeKey), ID: 5},
+		expect: func(mocks chatTestMocks) {
+			mocks.pinApp.EXPECT().GetPin(5, 2).Return(nil, entity.PinNotFoundError).Times(1)
+		},
+		expectedErr: entity.PinNotFoundError,
+	},
+	{
+		name:       "Testing sharing deleted board",
+		attachment: entity.MessageAttachmentInput{Type: string(entity.BoardAttachmentTypeKey), ID: 6},
+		expect: func(mocks chatTestMocks) {
+			mocks.boardApp.EXPECT().GetBoard(6, 2).Return(nil, entity.BoardNotFoundError).Times(1)
+		},
+		expectedErr: entity.BoardNotFoundError,
+	},
+	{
+		name:        "Testing sharing attachment of unknown type",
+		attachment:  entity.MessageAttachmentInput{Type: "video", ID: 5},
+		expect:      func(mocks chatTestMocks) {},
+		expectedErr: entity.IncorrectAttachmentError,
+	},
+}
+
+func TestCheckAttachments(t *testing.T) {
+	for _, tt := range checkAttachmentsTest {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			chatApp, mocks := newTestChatApp(mockCtrl)
+			tt.expect(mocks)
+
+			_, err := chatApp.checkAttachments(2, []entity.MessageAttachmentInput{tt.attachment})
+			require.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestSearchMessages(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	chatApp, mocks := newTestChatApp(mockCtrl)
+
+	_, err := chatApp.SearchMessages(2, " ?! ", 0, 10)
+	require.Equal(t, entity.EmptySearchQueryError, err, "Query without words should not be searched")
+
+	mocks.grpcClient.EXPECT().SearchMessages(gomock.Any(), &grpcChat.SearchQuery{
+		UserID:          2,
+		Query:           "Meet at",
+		BeforeMessageID: 20,
+		Limit:           10,
+		ContextSize:     searchContextSize,
+	}).Return(&grpcChat.SearchResultsList{Results: []*grpcChat.SearchResult{
+		{
+			Message: &grpcChat.Message{MessageID: 12, ChatID: 1, AuthorID: 1, Text: "Meeting at the attic",
+				Attachments: []*grpcChat.Attachment{{Type: string(entity.PinAttachmentTypeKey), ID: 5}}},
+			Before: []*grpcChat.Message{{MessageID: 11, ChatID: 1, AuthorID: 2, Text: "When?"}},
+			After: []*grpcChat.Message{{MessageID: 13, ChatID: 1, AuthorID: 2, Text: "Ok",
+				Attachments: []*grpcChat.Attachment{{Type: string(entity.PinAttachmentTypeKey), ID: 5}}}},
+		},
+	}}, nil).Times(1)
+	mocks.pinApp.EXPECT().GetPin(5, 2).Return(nil, entity.PinNotFoundError).Times(1) // Preview is resolved once for whole page
+
+	results, err := chatApp.SearchMessages(2, "Meet at", 20, 10)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, 12, results[0].Message.MessageID)
+	require.Equal(t, []entity.TextHighlight{{Start: 0, Length: 4}, {Start: 8, Length: 2}}, results[0].Highlights,
+		"Short query word should be highlighted only where it is a whole word")
+	require.Len(t, results[0].Before, 1)
+	require.Equal(t, 11, results[0].Before[0].MessageID)
+	require.Len(t, results[0].After, 1)
+	require.Equal(t, 13, results[0].After[0].MessageID)
+	require.False(t, results[0].Message.Attachments[0].IsAvailable, "Attachments of found messages should get previews")
+	require.False(t, results[0].After[0].Attachments[0].IsAvailable, "Attachments of context messages should get previews")
+}
@@ -9,41 +9,102 @@ import (
 
 type CommentApp struct {
 	grpcClient grpcComments.CommentsClient
+	pinApp     PinAppInterface
+	followApp  FollowAppInterface
 }
 
-func NewCommentApp(grpcClient grpcComments.CommentsClient) *CommentApp {
-	return &CommentApp{grpcClient: grpcClient}
+func NewCommentApp(grpcClient grpcComments.CommentsClient, pinApp PinAppInterface, followApp FollowAppInterface) *CommentApp {
+	return &CommentApp{
+		grpcClient: grpcClient,
+		pinApp:     pinApp,
+		followApp:  followApp,
+	}
 }
 
 type CommentAppInterface interface {
-	AddComment(comment *entity.Comment) error        // Add comment to pin
-	GetComments(pinID int) ([]entity.Comment, error) // Get pin's comments
-	DeleteComment(comment *entity.Comment) error     // Delete pin's comment
-	EditComment(comment *entity.Comment) error       // Edit pin's comment
+	AddComment(comment *entity.Comment) (int, error)                                        // Add comment or reply to pin (unless pin's author blocked commenter)
+	GetComment(commentID int) (*entity.Comment, error)                                      // Get comment by its ID
+	GetComments(pinID int, page *entity.CommentsPageInput) (*entity.CommentsOutput, error)  // Get page of pin's comments with first replies nested in them
+	GetReplies(threadID int, page *entity.RepliesPageInput) (*entity.CommentsOutput, error) // Get page of thread's replies, oldest first
+	DeleteComment(userID int, commentID int) error                                          // Delete comment (its author and pin's author can do it)
+	EditComment(userID int, commentID int, text string) error                               // Edit comment's text (only its author can do it)
 }
 
-func (commentApp *CommentApp) AddComment(comment *entity.Comment) error {
+// AddComment adds comment to pin, unless pin's author blocked commenter or commenter can't see pin
+// If comment.ParentID is set, comment is added as a reply to the thread of that comment
+// It returns comment's assigned ID and nil on success, -1 and error on failure
+func (commentApp *CommentApp) AddComment(comment *entity.Comment) (int, error) {
+	pin, err := commentApp.pinApp.GetPin(comment.PinID, comment.UserID)
+	if err != nil {
+		return -1, err
+	}
+
+	blockStatus, err := commentApp.followApp.GetBlockStatus(comment.UserID, pin.UserID)
+	if err != nil {
+		return -1, err
+	}
+	if blockStatus.IsBlockedBy {
+		return -1, entity.UserBlockedError
+	}
+
 	grpcComment := grpcComments.Comment{
 		PinComment: comment.PinComment,
 		PinID:      int64(comment.PinID),
 		UserID:     int64(comment.UserID),
+		ParentID:   int64(comment.ParentID),
 	}
-	FillGrpcComment(&grpcComment, comment)
-	_, err := commentApp.grpcClient.AddComment(context.Background(), &grpcComment)
+	grpcCommentID, err := commentApp.grpcClient.AddComment(context.Background(), &grpcComment)
 	if err != nil {
-		if strings.Contains(err.Error(), entity.AddCommentError.Error()) {
-			return entity.AddCommentError
+		switch {
+		case strings.Contains(err.Error(), entity.AddCommentError.Error()):
+			return -1, entity.AddCommentError
+		case strings.Contains(err.Error(), entity.ParentCommentNotFoundError.Error()):
+			return -1, entity.ParentCommentNotFoundError
+		default:
+			return -1, err
 		}
-		return err
 	}
 
-	return nil
+	return int(grpcCommentID.CommentID), nil
 }
 
-func (commentApp *CommentApp) GetComments(pinID int) ([]entity.Comment, error) {
-	comments, err := commentApp.grpcClient.GetComments(context.Background(), &grpcComments.PinID{PinID: int64(pinID)})
+// GetComment returns comment with passed ID
+// It returns that comment and nil on success, nil and error on failure
+func (commentApp *CommentApp) GetComment(commentID int) (*entity.Comment, error) {
+	grpcComment, err := commentApp.grpcClient.GetComment(context.Background(), &grpcComments.CommentID{CommentID: int64(commentID)})
 	if err != nil {
 		switch {
+		case strings.Contains(err.Error(), entity.CommentNotFoundError.Error()):
+			return nil, entity.CommentNotFoundError
+		case strings.Contains(err.Error(), entity.CommentScanError.Error()):
+			return nil, entity.CommentScanError
+		default:
+			return nil, err
+		}
+	}
+
+	comment := entity.Comment{}
+	FillGrpcComment(grpcComment, &comment)
+	return &comment, nil
+}
+
+// GetComments returns page of pin's threads in requested order
+// First entity.RepliesPreviewSize replies are nested in the first comments of their threads, the rest are returned by GetReplies
+// Output's NextCursor is set if page is full, as there may be more threads
+// It returns page and nil on success, nil and error on failure
+func (commentApp *CommentApp) GetComments(pinID int, page *entity.CommentsPageInput) (*entity.CommentsOutput, error) {
+	comments, err := commentApp.grpcClient.GetComments(context.Background(), &grpcComments.CommentsPage{
+		PinID:             int64(pinID),
+		Sort:              page.Sort,
+		AfterCommentID:    int64(page.After.CommentID),
+		AfterRepliesCount: int64(page.After.RepliesCount),
+		Limit:             int64(page.Limit),
+		RepliesLimit:      entity.RepliesPreviewSize,
+	})
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), entity.CommentsSortError.Error()):
+			return nil, entity.CommentsSortError
 		case strings.Contains(err.Error(), entity.CommentsNotFoundError.Error()):
 			return nil, entity.CommentsNotFoundError
 		case strings.Contains(err.Error(), entity.GetCommentsError.Error()):
@@ -54,16 +115,105 @@ func (commentApp *CommentApp) GetComments(pinID int) ([]entity.Comment, error) {
 			return nil, err
 		}
 	}
-	resComments := ConvertGrpcComments(comments)
+	output := entity.CommentsOutput{
+		Comments:   NestCommentReplies(ConvertGrpcComments(comments)),
+		TotalCount: int(comments.TotalCount),
+	}
+	if len(output.Comments) == page.Limit {
+		lastThread := output.Comments[len(output.Comments)-1]
+		output.NextCursor = entity.CommentsCursor{
+			CommentID:    lastThread.CommentID,
+			RepliesCount: lastThread.RepliesCount,
+		}.String()
+	}
+
+	return &output, nil
+}
+
+// GetReplies returns page of thread's replies, oldest first, threadID is ID of thread's first comment
+// Output's NextCursor is set if page is full, as there may be more replies
+// It returns page and nil on success, nil and error on failure
+func (commentApp *CommentApp) GetReplies(threadID int, page *entity.RepliesPageInput) (*entity.CommentsOutput, error) {
+	replies, err := commentApp.grpcClient.GetReplies(context.Background(), &grpcComments.RepliesPage{
+		ThreadID:       int64(threadID),
+		AfterCommentID: int64(page.After.CommentID),
+		Limit:          int64(page.Limit),
+	})
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), entity.CommentNotFoundError.Error()):
+			return nil, entity.CommentNotFoundError
+		case strings.Contains(err.Error(), entity.GetCommentsError.Error()):
+			return nil, entity.GetCommentsError
+		case strings.Contains(err.Error(), entity.CommentScanError.Error()):
+			return nil, entity.CommentScanError
+		default:
+			return nil, err
+		}
+	}
+	output := entity.CommentsOutput{
+		Comments:   ConvertGrpcComments(replies),
+		TotalCount: int(replies.TotalCount),
+	}
+	if len(output.Comments) == page.Limit {
+		lastReply := output.Comments[len(output.Comments)-1]
+		output.NextCursor = entity.CommentsCursor{CommentID: lastReply.CommentID}.String()
+	}
 
-	return resComments, nil
+	return &output, nil
 }
 
-func (commentApp *CommentApp) DeleteComment(comment *entity.Comment) error {
+// DeleteComment deletes comment if user is its author or author of commented pin
+// It returns nil on success and error on failure
+func (commentApp *CommentApp) DeleteComment(userID int, commentID int) error {
+	comment, err := commentApp.GetComment(commentID)
+	if err != nil {
+		return err
+	}
+
+	if comment.UserID != userID {
+		pin, err := commentApp.pinApp.GetPin(comment.PinID, userID)
+		if err != nil {
+			return err
+		}
+
+		if pin.UserID != userID {
+			return entity.CheckCommentOwnerError
+		}
+	}
+
+	_, err = commentApp.grpcClient.DeleteComment(context.Background(), &grpcComments.CommentID{CommentID: int64(commentID)})
+	if err != nil {
+		if strings.Contains(err.Error(), entity.CommentNotFoundError.Error()) {
+			return entity.CommentNotFoundError
+		}
+		return err
+	}
 	return nil
 }
 
-func (commentApp *CommentApp) EditComment(comment *entity.Comment) error {
+// EditComment replaces text of user's comment
+// It returns nil on success and error on failure
+func (commentApp *CommentApp) EditComment(userID int, commentID int, text string) error {
+	comment, err := commentApp.GetComment(commentID)
+	if err != nil {
+		return err
+	}
+
+	if comment.UserID != userID {
+		return entity.CheckCommentOwnerError
+	}
+
+	_, err = commentApp.grpcClient.EditComment(context.Background(), &grpcComments.Comment{
+		CommentID:  int64(commentID),
+		PinComment: text,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), entity.CommentNotFoundError.Error()) {
+			return entity.CommentNotFoundError
+		}
+		return err
+	}
 	return nil
 }
 
@@ -77,8 +227,47 @@ func ConvertGrpcComments(grpcComments *grpcComments.CommentsList) []entity.Comme
 	return comments
 }
 
+// NestCommentReplies moves replies into Replies of their threads' first comments, keeping the order
+// Replies whose thread's first comment is not in the list are left at the top level
+func NestCommentReplies(comments []entity.Comment) []entity.Comment {
+	rootIndexes := make(map[int]int)
+	for i, comment := range comments {
+		if comment.ParentID == 0 {
+			rootIndexes[comment.CommentID] = i
+		}
+	}
+
+	replies := make(map[int][]entity.Comment)
+	for _, comment := range comments {
+		if _, found := rootIndexes[comment.ParentID]; found {
+			replies[comment.ParentID] = append(replies[comment.ParentID], comment)
+		}
+	}
+
+	threads := make([]entity.Comment, 0, len(comments))
+	for _, comment := range comments {
+		if _, found := rootIndexes[comment.ParentID]; found {
+			continue
+		}
+		comment.Replies = replies[comment.CommentID]
+		threads = append(threads, comment)
+	}
+	return threads
+}
+
 func FillGrpcComment(grpcComment *grpcComments.Comment, comment *entity.Comment) {
 	comment.PinID = int(grpcComment.PinID)
 	comment.UserID = int(grpcComment.UserID)
 	comment.PinComment = grpcComment.PinComment
+	comment.CommentID = int(grpcComment.CommentID)
+	comment.CreationDate = grpcComment.CreationDate.AsTime()
+	comment.ParentID = int(grpcComment.ParentID)
+	comment.RepliesCount = int(grpcComment.RepliesCount)
+	comment.Username = grpcComment.Username
+	comment.AvatarLink = grpcComment.AvatarLink
+	comment.EditDate = nil
+	if grpcComment.EditDate != nil {
+		editDate := grpcComment.EditDate.AsTime()
+		comment.EditDate = &editDate
+	}
 }
@@ -6,6 +6,7 @@ import (
 	"os"
 	"pinterest/domain/entity"
 	grpcAuth "pinterest/services/auth/proto"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -29,8 +30,10 @@ type CookieAppInterface interface {
 	GenerateCookie() (*http.Cookie, error)
 	AddCookieInfo(cookieInfo *entity.CookieInfo) error
 	SearchByValue(sessionValue string) (*grpcAuth.CookieInfo, bool)
-	SearchByUserID(userID int) (*grpcAuth.CookieInfo, bool)
+	GetSessions(userID int) ([]*grpcAuth.CookieInfo, error) // Get all of user's active sessions
 	RemoveCookie(*grpcAuth.CookieInfo) error
+	RemoveSession(userID int, sessionID int) error                // Remove user's session by its ID
+	RemoveAllSessions(userID int, exceptCookieValue string) error // Remove all user's sessions except one with passed cookie value
 }
 
 func (cookieApp *CookieApp) GenerateCookie() (*http.Cookie, error) {
@@ -60,8 +63,8 @@ func (cookieApp *CookieApp) GenerateCookie() (*http.Cookie, error) {
 	}, nil
 }
 
-func (cookieApp *CookieApp) SearchByUserID(userID int) (*grpcAuth.CookieInfo, bool) {
-	resCookieInfo, err := cookieApp.grpcClient.SearchByUserID(context.Background(), &grpcAuth.UserID{Uid: int64(userID)})
+func (cookieApp *CookieApp) SearchByValue(cookieValue string) (*grpcAuth.CookieInfo, bool) {
+	resCookieInfo, err := cookieApp.grpcClient.SearchByValue(context.Background(), &grpcAuth.CookieValue{CookieValue: cookieValue})
 	if err != nil {
 		return nil, false
 	}
@@ -69,32 +72,41 @@ func (cookieApp *CookieApp) SearchByUserID(userID int) (*grpcAuth.CookieInfo, bo
 	return resCookieInfo, true
 }
 
-func (cookieApp *CookieApp) SearchByValue(cookieValue string) (*grpcAuth.CookieInfo, bool) {
-	resCookieInfo, err := cookieApp.grpcClient.SearchByValue(context.Background(), &grpcAuth.CookieValue{CookieValue: cookieValue})
+func (cookieApp *CookieApp) GetSessions(userID int) ([]*grpcAuth.CookieInfo, error) {
+	sessions, err := cookieApp.grpcClient.GetSessions(context.Background(), &grpcAuth.UserID{Uid: int64(userID)})
 	if err != nil {
-		return nil, false
+		return nil, err
 	}
 
-	return resCookieInfo, true
+	return sessions.Cookies, nil
 }
 
 func (cookieApp *CookieApp) AddCookieInfo(cookieInfo *entity.CookieInfo) error {
-	oldCookieInfo, found := cookieApp.SearchByUserID(cookieInfo.UserID)
+	_, found := cookieApp.SearchByValue(cookieInfo.Cookie.Value)
 	if found {
-		cookieApp.RemoveCookie(oldCookieInfo)
+		return entity.DuplicatingCookieValueError
 	}
 
-	_, found = cookieApp.SearchByValue(cookieInfo.Cookie.Value)
-	if found {
-		return entity.DuplicatingCookieValueError
+	if cookieInfo.Session == nil {
+		cookieInfo.Session = &entity.Session{Device: "Unknown device"}
 	}
+	cookieInfo.Session.Created = time.Now()
+	cookieInfo.Session.LastSeen = cookieInfo.Session.Created
 
 	grpcCookie := grpcAuth.Cookie{}
 	FillGRPCCookie(&grpcCookie, cookieInfo.Cookie)
-	grpcCookieInfo := grpcAuth.CookieInfo{UserID: int64(cookieInfo.UserID), Cookie: &grpcCookie}
+	grpcSession := grpcAuth.Session{}
+	FillGRPCSession(&grpcSession, cookieInfo.Session)
+	grpcCookieInfo := grpcAuth.CookieInfo{UserID: int64(cookieInfo.UserID), Cookie: &grpcCookie, Session: &grpcSession}
 	_, err := cookieApp.grpcClient.AddCookieInfo(context.Background(), &grpcCookieInfo)
+	if err != nil {
+		if strings.Contains(err.Error(), entity.CookieFoundError.Error()) {
+			return entity.DuplicatingCookieValueError
+		}
+		return err
+	}
 
-	return err // Will actually almost always be nil
+	return nil
 }
 
 func (cookieApp *CookieApp) RemoveCookie(cookieInfo *grpcAuth.CookieInfo) error {
@@ -103,7 +115,51 @@ func (cookieApp *CookieApp) RemoveCookie(cookieInfo *grpcAuth.CookieInfo) error
 	return err
 }
 
+func (cookieApp *CookieApp) RemoveSession(userID int, sessionID int) error {
+	_, err := cookieApp.grpcClient.RemoveSession(context.Background(),
+		&grpcAuth.SessionID{UserID: int64(userID), SessionID: int64(sessionID)})
+	if err != nil {
+		if strings.Contains(err.Error(), entity.SessionNotFoundError.Error()) {
+			return entity.SessionNotFoundError
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (cookieApp *CookieApp) RemoveAllSessions(userID int, exceptCookieValue string) error {
+	_, err := cookieApp.grpcClient.RemoveAllSessions(context.Background(),
+		&grpcAuth.SessionsRemoval{UserID: int64(userID), ExceptCookieValue: exceptCookieValue})
+
+	return err
+}
+
 func FillGRPCCookie(grpcCookie *grpcAuth.Cookie, cookie *http.Cookie) {
 	grpcCookie.Value = cookie.Value
 	grpcCookie.Expires = timestamppb.New(cookie.Expires)
 }
+
+func FillGRPCSession(grpcSession *grpcAuth.Session, session *entity.Session) {
+	grpcSession.SessionID = int64(session.SessionID)
+	grpcSession.Device = session.Device
+	grpcSession.IP = session.IP
+	grpcSession.UserAgent = session.UserAgent
+	grpcSession.Created = timestamppb.New(session.Created)
+	grpcSession.LastSeen = timestamppb.New(session.LastSeen)
+}
+
+func ConvertFromGRPCSession(grpcSession *grpcAuth.Session) *entity.Session {
+	if grpcSession == nil {
+		return nil
+	}
+
+	return &entity.Session{
+		SessionID: int(grpcSession.SessionID),
+		Device:    grpcSession.Device,
+		IP:        grpcSession.IP,
+		UserAgent: grpcSession.UserAgent,
+		Created:   grpcSession.Created.AsTime(),
+		LastSeen:  grpcSession.LastSeen.AsTime(),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"pinterest/domain/entity"
 	grpcUser "pinterest/services/user/proto"
+	"sort"
 	"strings"
 )
 
@@ -20,33 +21,45 @@ func NewFollowApp(grpcClient grpcUser.UserClient, pinApp PinAppInterface) *Follo
 }
 
 type FollowAppInterface interface {
-	Follow(followerID int, followedID int) error                  // Make first user follow second
-	Unfollow(followerID int, followedID int) error                // Make first user unfollow second
-	CheckIfFollowed(followerID int, followedID int) (bool, error) // Check if first user follows second. Err != nil if those users are the same
-	GetAllFollowers(followedID int) ([]entity.User, error)        // Get everyone who follows specified user
-	GetAllFollowed(followerID int) ([]entity.User, error)         // Get everyone who is followed by specified user
-	GetPinsOfFollowedUsers(userID int) ([]entity.Pin, error)      // Get all pins belonging to users that user follows
+	Follow(followerID int, followedID int) (bool, error)                     // Make first user follow second, or request to follow if second user's account is private (true is returned then)
+	Unfollow(followerID int, followedID int) error                           // Make first user unfollow second
+	CheckIfFollowed(followerID int, followedID int) (bool, error)            // Check if first user follows second. Err != nil if those users are the same
+	GetAllFollowers(followedID int) ([]entity.User, error)                   // Get everyone who follows specified user
+	GetAllFollowed(followerID int) ([]entity.User, error)                    // Get everyone who is followed by specified user
+	GetPinsOfFollowedUsers(userID int) ([]entity.Pin, error)                 // Get all pins belonging to users that user follows or lying on boards user follows
+	Block(blockerID int, blockedID int) error                                // Make first user block second, removing follow relations between them
+	Unblock(blockerID int, blockedID int) error                              // Make first user unblock second
+	GetBlockStatus(userID int, otherUserID int) (*entity.BlockStatus, error) // Check if users blocked each other
+	CheckIfBlocked(firstUserID int, secondUserID int) (bool, error)          // Check if any of users blocked the other
+	GetFollowRequests(userID int) ([]entity.User, error)                     // Get everyone who requested to follow specified user
+	AcceptFollowRequest(userID int, followerID int) error                    // Make follower follow user who accepted their request
+	RejectFollowRequest(userID int, followerID int) error                    // Remove follower's request to follow user
+	CheckProfileAccess(viewerID int, ownerID int) error                      // Check if viewer can see owner's pins, boards and followers (viewer's ID is 0 for anonymous users)
 }
 
-func (followApp *FollowApp) Follow(followerID int, followedID int) error {
+func (followApp *FollowApp) Follow(followerID int, followedID int) (bool, error) {
 	if followerID == followedID {
-		return entity.SelfFollowError
+		return false, entity.SelfFollowError
 	}
 
-	_, err := followApp.grpcClient.Follow(context.Background(), &grpcUser.Follows{FollowedID: int64(followedID), FollowerID: int64(followerID)})
+	result, err := followApp.grpcClient.Follow(context.Background(), &grpcUser.Follows{FollowedID: int64(followedID), FollowerID: int64(followerID)})
 	if err != nil {
 		switch {
 		case strings.Contains(err.Error(), entity.FollowAlreadyExistsError.Error()):
-			return entity.FollowAlreadyExistsError
+			return false, entity.FollowAlreadyExistsError
+		case strings.Contains(err.Error(), entity.FollowRequestAlreadyExistsError.Error()):
+			return false, entity.FollowRequestAlreadyExistsError
+		case strings.Contains(err.Error(), entity.UserBlockedError.Error()):
+			return false, entity.UserBlockedError
 		case strings.Contains(err.Error(), entity.UserNotFoundError.Error()):
-			return entity.UserNotFoundError
+			return false, entity.UserNotFoundError
 		case strings.Contains(err.Error(), entity.FollowCountUpdateError.Error()):
-			return entity.FollowCountUpdateError
+			return false, entity.FollowCountUpdateError
 		}
-		return err
+		return false, err
 	}
 
-	return nil
+	return result.IsRequested, nil
 }
 
 func (followApp *FollowApp) Unfollow(followerID int, followedID int) error {
@@ -125,9 +138,12 @@ func (followApp *FollowApp) GetAllFollowed(followerID int) ([]entity.User, error
 	return followed, nil
 }
 
+// GetPinsOfFollowedUsers outputs pins of users which user follows together with pins from boards user follows
+// Newest pins come first, every pin is returned once even if it was found both ways
+// It returns slice of pins, nil on success, nil, error on failure
 func (followApp *FollowApp) GetPinsOfFollowedUsers(userID int) ([]entity.Pin, error) {
 	followedUsers, err := followApp.GetAllFollowed(userID)
-	if err != nil {
+	if err != nil && err != entity.UsersNotFoundError { // User may follow only boards
 		return nil, err
 	}
 
@@ -136,5 +152,163 @@ func (followApp *FollowApp) GetPinsOfFollowedUsers(userID int) ([]entity.Pin, er
 		userIDs = append(userIDs, user.UserID)
 	}
 
-	return followApp.pinApp.GetPinsOfUsers(userIDs)
+	pins, err := followApp.pinApp.GetPinsOfUsers(userIDs)
+	if err != nil && err != entity.PinsNotFoundError {
+		return nil, err
+	}
+
+	boardPins, err := followApp.pinApp.GetPinsOfFollowedBoards(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	foundPins := make(map[int]bool, len(pins))
+	for _, pin := range pins {
+		foundPins[pin.PinID] = true
+	}
+	for _, pin := range boardPins {
+		if !foundPins[pin.PinID] {
+			foundPins[pin.PinID] = true
+			pins = append(pins, pin)
+		}
+	}
+
+	sort.Slice(pins, func(i, j int) bool {
+		return pins[i].PinID > pins[j].PinID // So that newest pins will come up first
+	})
+	return pins, nil
+}
+
+func (followApp *FollowApp) Block(blockerID int, blockedID int) error {
+	if blockerID == blockedID {
+		return entity.SelfBlockError
+	}
+
+	_, err := followApp.grpcClient.Block(context.Background(), &grpcUser.Blocks{BlockerID: int64(blockerID), BlockedID: int64(blockedID)})
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), entity.BlockAlreadyExistsError.Error()):
+			return entity.BlockAlreadyExistsError
+		case strings.Contains(err.Error(), entity.UserNotFoundError.Error()):
+			return entity.UserNotFoundError
+		case strings.Contains(err.Error(), entity.FollowCountUpdateError.Error()):
+			return entity.FollowCountUpdateError
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (followApp *FollowApp) Unblock(blockerID int, blockedID int) error {
+	if blockerID == blockedID {
+		return entity.SelfBlockError
+	}
+
+	_, err := followApp.grpcClient.Unblock(context.Background(), &grpcUser.Blocks{BlockerID: int64(blockerID), BlockedID: int64(blockedID)})
+	if err != nil {
+		if strings.Contains(err.Error(), entity.BlockNotFoundError.Error()) {
+			return entity.BlockNotFoundError
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (followApp *FollowApp) GetBlockStatus(userID int, otherUserID int) (*entity.BlockStatus, error) {
+	if userID == otherUserID {
+		return &entity.BlockStatus{}, nil
+	}
+
+	blockStatus, err := followApp.grpcClient.GetBlockStatus(context.Background(), &grpcUser.Blocks{BlockerID: int64(userID), BlockedID: int64(otherUserID)})
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.BlockStatus{IsBlocked: blockStatus.IsBlocked, IsBlockedBy: blockStatus.IsBlockedBy}, nil
+}
+
+func (followApp *FollowApp) CheckIfBlocked(firstUserID int, secondUserID int) (bool, error) {
+	blockStatus, err := followApp.GetBlockStatus(firstUserID, secondUserID)
+	if err != nil {
+		return false, err
+	}
+
+	return blockStatus.IsBlocked || blockStatus.IsBlockedBy, nil
+}
+
+func (followApp *FollowApp) GetFollowRequests(userID int) ([]entity.User, error) {
+	requestersList, err := followApp.grpcClient.GetFollowRequests(context.Background(), &grpcUser.UserID{Uid: int64(userID)})
+	if err != nil {
+		if strings.Contains(err.Error(), entity.UserScanError.Error()) {
+			return nil, entity.UserScanError
+		}
+		return nil, err
+	}
+
+	requesters := ReturnUsersList(requestersList.Users)
+	return requesters, nil
+}
+
+func (followApp *FollowApp) AcceptFollowRequest(userID int, followerID int) error {
+	_, err := followApp.grpcClient.AcceptFollowRequest(context.Background(), &grpcUser.Follows{FollowedID: int64(userID), FollowerID: int64(followerID)})
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), entity.FollowRequestNotFoundError.Error()):
+			return entity.FollowRequestNotFoundError
+		case strings.Contains(err.Error(), entity.FollowAlreadyExistsError.Error()):
+			return entity.FollowAlreadyExistsError
+		case strings.Contains(err.Error(), entity.UserBlockedError.Error()):
+			return entity.UserBlockedError
+		case strings.Contains(err.Error(), entity.FollowCountUpdateError.Error()):
+			return entity.FollowCountUpdateError
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (followApp *FollowApp) RejectFollowRequest(userID int, followerID int) error {
+	_, err := followApp.grpcClient.RejectFollowRequest(context.Background(), &grpcUser.Follows{FollowedID: int64(userID), FollowerID: int64(followerID)})
+	if err != nil {
+		if strings.Contains(err.Error(), entity.FollowRequestNotFoundError.Error()) {
+			return entity.FollowRequestNotFoundError
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (followApp *FollowApp) CheckProfileAccess(viewerID int, ownerID int) error {
+	if viewerID == ownerID {
+		return nil
+	}
+
+	settings, err := followApp.grpcClient.GetPrivacySettings(context.Background(), &grpcUser.UserID{Uid: int64(ownerID)})
+	if err != nil {
+		if strings.Contains(err.Error(), entity.UserNotFoundError.Error()) {
+			return entity.UserNotFoundError
+		}
+		return err
+	}
+	if !settings.IsPrivate {
+		return nil
+	}
+
+	if viewerID == 0 { // Anonymous users can't follow anyone
+		return entity.PrivateAccountError
+	}
+
+	isFollowed, err := followApp.CheckIfFollowed(viewerID, ownerID)
+	if err != nil {
+		return err
+	}
+	if !isFollowed {
+		return entity.PrivateAccountError
+	}
+
+	return nil
 }
@@ -79,33 +79,90 @@ func (mr *MockAuthAppInterfaceMockRecorder) CheckCookie(cookie interface{}) *gom
 }
 
 // CheckUserCredentials mocks base method.
-func (m *MockAuthAppInterface) CheckUserCredentials(username, password string) (*entity.CookieInfo, error) {
+func (m *MockAuthAppInterface) CheckUserCredentials(username, password string, session *entity.Session) (*entity.CookieInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckUserCredentials", username, password)
+	ret := m.ctrl.Call(m, "CheckUserCredentials", username, password, session)
 	ret0, _ := ret[0].(*entity.CookieInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CheckUserCredentials indicates an expected call of CheckUserCredentials.
-func (mr *MockAuthAppInterfaceMockRecorder) CheckUserCredentials(username, password interface{}) *gomock.Call {
+func (mr *MockAuthAppInterfaceMockRecorder) CheckUserCredentials(username, password, session interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUserCredentials", reflect.TypeOf((*MockAuthAppInterface)(nil).CheckUserCredentials), username, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUserCredentials", reflect.TypeOf((*MockAuthAppInterface)(nil).CheckUserCredentials), username, password, session)
 }
 
 // CheckVkCode mocks base method.
-func (m *MockAuthAppInterface) CheckVkCode(code, redirectURI string) (*entity.CookieInfo, error) {
+func (m *MockAuthAppInterface) CheckVkCode(code, redirectURI string, session *entity.Session) (*entity.CookieInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckVkCode", code, redirectURI)
+	ret := m.ctrl.Call(m, "CheckVkCode", code, redirectURI, session)
 	ret0, _ := ret[0].(*entity.CookieInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CheckVkCode indicates an expected call of CheckVkCode.
-func (mr *MockAuthAppInterfaceMockRecorder) CheckVkCode(code, redirectURI interface{}) *gomock.Call {
+func (mr *MockAuthAppInterfaceMockRecorder) CheckVkCode(code, redirectURI, session interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckVkCode", reflect.TypeOf((*MockAuthAppInterface)(nil).CheckVkCode), code, redirectURI)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckVkCode", reflect.TypeOf((*MockAuthAppInterface)(nil).CheckVkCode), code, redirectURI, session)
+}
+
+// GetSessions mocks base method.
+func (m *MockAuthAppInterface) GetSessions(cookieInfo *entity.CookieInfo) ([]*entity.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessions", cookieInfo)
+	ret0, _ := ret[0].([]*entity.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessions indicates an expected call of GetSessions.
+func (mr *MockAuthAppInterfaceMockRecorder) GetSessions(cookieInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*MockAuthAppInterface)(nil).GetSessions), cookieInfo)
+}
+
+// LogoutOtherSessions mocks base method.
+func (m *MockAuthAppInterface) LogoutOtherSessions(cookieInfo *entity.CookieInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogoutOtherSessions", cookieInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogoutOtherSessions indicates an expected call of LogoutOtherSessions.
+func (mr *MockAuthAppInterfaceMockRecorder) LogoutOtherSessions(cookieInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogoutOtherSessions", reflect.TypeOf((*MockAuthAppInterface)(nil).LogoutOtherSessions), cookieInfo)
+}
+
+// LogoutSession mocks base method.
+func (m *MockAuthAppInterface) LogoutSession(cookieInfo *entity.CookieInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogoutSession", cookieInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogoutSession indicates an expected call of LogoutSession.
+func (mr *MockAuthAppInterfaceMockRecorder) LogoutSession(cookieInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogoutSession", reflect.TypeOf((*MockAuthAppInterface)(nil).LogoutSession), cookieInfo)
+}
+
+// LogoutSessionByID mocks base method.
+func (m *MockAuthAppInterface) LogoutSessionByID(userID, sessionID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogoutSessionByID", userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogoutSessionByID indicates an expected call of LogoutSessionByID.
+func (mr *MockAuthAppInterfaceMockRecorder) LogoutSessionByID(userID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogoutSessionByID", reflect.TypeOf((*MockAuthAppInterface)(nil).LogoutSessionByID), userID, sessionID)
 }
 
 // LogoutUser mocks base method.
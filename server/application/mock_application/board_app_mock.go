@@ -34,18 +34,46 @@ func (m *MockBoardAppInterface) EXPECT() *MockBoardAppInterfaceMockRecorder {
 	return m.recorder
 }
 
+// AcceptInvitation mocks base method.
+func (m *MockBoardAppInterface) AcceptInvitation(userID, boardID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", userID, boardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockBoardAppInterfaceMockRecorder) AcceptInvitation(userID, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockBoardAppInterface)(nil).AcceptInvitation), userID, boardID)
+}
+
 // CheckBoard mocks base method.
-func (m *MockBoardAppInterface) CheckBoard(userID, boardID int) error {
+func (m *MockBoardAppInterface) CheckBoard(userID, boardID int, role string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckBoard", userID, boardID)
+	ret := m.ctrl.Call(m, "CheckBoard", userID, boardID, role)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CheckBoard indicates an expected call of CheckBoard.
-func (mr *MockBoardAppInterfaceMockRecorder) CheckBoard(userID, boardID interface{}) *gomock.Call {
+func (mr *MockBoardAppInterfaceMockRecorder) CheckBoard(userID, boardID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBoard", reflect.TypeOf((*MockBoardAppInterface)(nil).CheckBoard), userID, boardID, role)
+}
+
+// CheckBoardVisibility mocks base method.
+func (m *MockBoardAppInterface) CheckBoardVisibility(userID int, board *entity.Board) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckBoardVisibility", userID, board)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckBoardVisibility indicates an expected call of CheckBoardVisibility.
+func (mr *MockBoardAppInterfaceMockRecorder) CheckBoardVisibility(userID, board interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBoard", reflect.TypeOf((*MockBoardAppInterface)(nil).CheckBoard), userID, boardID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBoardVisibility", reflect.TypeOf((*MockBoardAppInterface)(nil).CheckBoardVisibility), userID, board)
 }
 
 // CreateBoard mocks base method.
@@ -63,6 +91,21 @@ func (mr *MockBoardAppInterfaceMockRecorder) CreateBoard(board interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBoard", reflect.TypeOf((*MockBoardAppInterface)(nil).CreateBoard), board)
 }
 
+// CreateSection mocks base method.
+func (m *MockBoardAppInterface) CreateSection(userID, boardID int, title string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSection", userID, boardID, title)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSection indicates an expected call of CreateSection.
+func (mr *MockBoardAppInterfaceMockRecorder) CreateSection(userID, boardID, title interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSection", reflect.TypeOf((*MockBoardAppInterface)(nil).CreateSection), userID, boardID, title)
+}
+
 // DeleteBoard mocks base method.
 func (m *MockBoardAppInterface) DeleteBoard(userID, boardID int) error {
 	m.ctrl.T.Helper()
@@ -77,34 +120,92 @@ func (mr *MockBoardAppInterfaceMockRecorder) DeleteBoard(userID, boardID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBoard", reflect.TypeOf((*MockBoardAppInterface)(nil).DeleteBoard), userID, boardID)
 }
 
+// DeleteSection mocks base method.
+func (m *MockBoardAppInterface) DeleteSection(userID, boardID, sectionID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSection", userID, boardID, sectionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSection indicates an expected call of DeleteSection.
+func (mr *MockBoardAppInterfaceMockRecorder) DeleteSection(userID, boardID, sectionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSection", reflect.TypeOf((*MockBoardAppInterface)(nil).DeleteSection), userID, boardID, sectionID)
+}
+
+// FollowBoard mocks base method.
+func (m *MockBoardAppInterface) FollowBoard(userID int, board *entity.Board) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowBoard", userID, board)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FollowBoard indicates an expected call of FollowBoard.
+func (mr *MockBoardAppInterfaceMockRecorder) FollowBoard(userID, board interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowBoard", reflect.TypeOf((*MockBoardAppInterface)(nil).FollowBoard), userID, board)
+}
+
 // GetBoard mocks base method.
-func (m *MockBoardAppInterface) GetBoard(boardID int) (*entity.Board, error) {
+func (m *MockBoardAppInterface) GetBoard(boardID, requesterID int) (*entity.Board, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoard", boardID)
+	ret := m.ctrl.Call(m, "GetBoard", boardID, requesterID)
 	ret0, _ := ret[0].(*entity.Board)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoard indicates an expected call of GetBoard.
-func (mr *MockBoardAppInterfaceMockRecorder) GetBoard(boardID interface{}) *gomock.Call {
+func (mr *MockBoardAppInterfaceMockRecorder) GetBoard(boardID, requesterID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoard", reflect.TypeOf((*MockBoardAppInterface)(nil).GetBoard), boardID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoard", reflect.TypeOf((*MockBoardAppInterface)(nil).GetBoard), boardID, requesterID)
+}
+
+// GetBoardFollowers mocks base method.
+func (m *MockBoardAppInterface) GetBoardFollowers(boardID int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardFollowers", boardID)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardFollowers indicates an expected call of GetBoardFollowers.
+func (mr *MockBoardAppInterfaceMockRecorder) GetBoardFollowers(boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardFollowers", reflect.TypeOf((*MockBoardAppInterface)(nil).GetBoardFollowers), boardID)
 }
 
 // GetBoards mocks base method.
-func (m *MockBoardAppInterface) GetBoards(userID int) ([]entity.Board, error) {
+func (m *MockBoardAppInterface) GetBoards(userID, requesterID int) ([]entity.Board, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBoards", userID)
+	ret := m.ctrl.Call(m, "GetBoards", userID, requesterID)
 	ret0, _ := ret[0].([]entity.Board)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBoards indicates an expected call of GetBoards.
-func (mr *MockBoardAppInterfaceMockRecorder) GetBoards(userID interface{}) *gomock.Call {
+func (mr *MockBoardAppInterfaceMockRecorder) GetBoards(userID, requesterID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoards", reflect.TypeOf((*MockBoardAppInterface)(nil).GetBoards), userID, requesterID)
+}
+
+// GetCollaborators mocks base method.
+func (m *MockBoardAppInterface) GetCollaborators(boardID int) ([]entity.BoardCollaborator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollaborators", boardID)
+	ret0, _ := ret[0].([]entity.BoardCollaborator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollaborators indicates an expected call of GetCollaborators.
+func (mr *MockBoardAppInterfaceMockRecorder) GetCollaborators(boardID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoards", reflect.TypeOf((*MockBoardAppInterface)(nil).GetBoards), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollaborators", reflect.TypeOf((*MockBoardAppInterface)(nil).GetCollaborators), boardID)
 }
 
 // GetInitUserBoard mocks base method.
@@ -122,6 +223,149 @@ func (mr *MockBoardAppInterfaceMockRecorder) GetInitUserBoard(userID interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInitUserBoard", reflect.TypeOf((*MockBoardAppInterface)(nil).GetInitUserBoard), userID)
 }
 
+// GetInvitations mocks base method.
+func (m *MockBoardAppInterface) GetInvitations(userID int) ([]entity.BoardInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvitations", userID)
+	ret0, _ := ret[0].([]entity.BoardInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvitations indicates an expected call of GetInvitations.
+func (mr *MockBoardAppInterfaceMockRecorder) GetInvitations(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvitations", reflect.TypeOf((*MockBoardAppInterface)(nil).GetInvitations), userID)
+}
+
+// GetSections mocks base method.
+func (m *MockBoardAppInterface) GetSections(boardID int) ([]entity.BoardSection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSections", boardID)
+	ret0, _ := ret[0].([]entity.BoardSection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSections indicates an expected call of GetSections.
+func (mr *MockBoardAppInterfaceMockRecorder) GetSections(boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSections", reflect.TypeOf((*MockBoardAppInterface)(nil).GetSections), boardID)
+}
+
+// InviteCollaborator mocks base method.
+func (m *MockBoardAppInterface) InviteCollaborator(ownerID, boardID, userID int, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteCollaborator", ownerID, boardID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InviteCollaborator indicates an expected call of InviteCollaborator.
+func (mr *MockBoardAppInterfaceMockRecorder) InviteCollaborator(ownerID, boardID, userID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteCollaborator", reflect.TypeOf((*MockBoardAppInterface)(nil).InviteCollaborator), ownerID, boardID, userID, role)
+}
+
+// MovePin mocks base method.
+func (m *MockBoardAppInterface) MovePin(userID, boardID, pinID, sectionID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovePin", userID, boardID, pinID, sectionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MovePin indicates an expected call of MovePin.
+func (mr *MockBoardAppInterfaceMockRecorder) MovePin(userID, boardID, pinID, sectionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovePin", reflect.TypeOf((*MockBoardAppInterface)(nil).MovePin), userID, boardID, pinID, sectionID)
+}
+
+// RefreshBoardAvatar mocks base method.
+func (m *MockBoardAppInterface) RefreshBoardAvatar(userID, boardID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshBoardAvatar", userID, boardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshBoardAvatar indicates an expected call of RefreshBoardAvatar.
+func (mr *MockBoardAppInterfaceMockRecorder) RefreshBoardAvatar(userID, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshBoardAvatar", reflect.TypeOf((*MockBoardAppInterface)(nil).RefreshBoardAvatar), userID, boardID)
+}
+
+// RemoveCollaborator mocks base method.
+func (m *MockBoardAppInterface) RemoveCollaborator(userID, boardID, collaboratorID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCollaborator", userID, boardID, collaboratorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCollaborator indicates an expected call of RemoveCollaborator.
+func (mr *MockBoardAppInterfaceMockRecorder) RemoveCollaborator(userID, boardID, collaboratorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCollaborator", reflect.TypeOf((*MockBoardAppInterface)(nil).RemoveCollaborator), userID, boardID, collaboratorID)
+}
+
+// RenameSection mocks base method.
+func (m *MockBoardAppInterface) RenameSection(userID, boardID, sectionID int, title string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameSection", userID, boardID, sectionID, title)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameSection indicates an expected call of RenameSection.
+func (mr *MockBoardAppInterfaceMockRecorder) RenameSection(userID, boardID, sectionID, title interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameSection", reflect.TypeOf((*MockBoardAppInterface)(nil).RenameSection), userID, boardID, sectionID, title)
+}
+
+// ReorderBoard mocks base method.
+func (m *MockBoardAppInterface) ReorderBoard(userID, boardID int, order *entity.BoardOrderInput) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderBoard", userID, boardID, order)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderBoard indicates an expected call of ReorderBoard.
+func (mr *MockBoardAppInterfaceMockRecorder) ReorderBoard(userID, boardID, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderBoard", reflect.TypeOf((*MockBoardAppInterface)(nil).ReorderBoard), userID, boardID, order)
+}
+
+// UnfollowBoard mocks base method.
+func (m *MockBoardAppInterface) UnfollowBoard(userID, boardID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfollowBoard", userID, boardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnfollowBoard indicates an expected call of UnfollowBoard.
+func (mr *MockBoardAppInterfaceMockRecorder) UnfollowBoard(userID, boardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfollowBoard", reflect.TypeOf((*MockBoardAppInterface)(nil).UnfollowBoard), userID, boardID)
+}
+
+// UpdateBoard mocks base method.
+func (m *MockBoardAppInterface) UpdateBoard(board *entity.Board) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBoard", board)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBoard indicates an expected call of UpdateBoard.
+func (mr *MockBoardAppInterfaceMockRecorder) UpdateBoard(board interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBoard", reflect.TypeOf((*MockBoardAppInterface)(nil).UpdateBoard), board)
+}
+
 // UploadBoardAvatar mocks base method.
 func (m *MockBoardAppInterface) UploadBoardAvatar(boardID int, imageLink string, imageHeight, imageWidth int, imageAvgColor string) error {
 	m.ctrl.T.Helper()
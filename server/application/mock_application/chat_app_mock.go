@@ -0,0 +1,397 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: application/chat_app.go
+
+// Package mock_application is a generated GoMock package.
+package mock_application
+
+import (
+	io "io"
+	entity "pinterest/domain/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	websocket "github.com/gorilla/websocket"
+)
+
+// MockChatAppInterface is a mock of ChatAppInterface interface.
+type MockChatAppInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockChatAppInterfaceMockRecorder
+}
+
+// MockChatAppInterfaceMockRecorder is the mock recorder for MockChatAppInterface.
+type MockChatAppInterfaceMockRecorder struct {
+	mock *MockChatAppInterface
+}
+
+// NewMockChatAppInterface creates a new mock instance.
+func NewMockChatAppInterface(ctrl *gomock.Controller) *MockChatAppInterface {
+	mock := &MockChatAppInterface{ctrl: ctrl}
+	mock.recorder = &MockChatAppInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChatAppInterface) EXPECT() *MockChatAppInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AddChatMember mocks base method.
+func (m *MockChatAppInterface) AddChatMember(userID, chatID, newMemberID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddChatMember", userID, chatID, newMemberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddChatMember indicates an expected call of AddChatMember.
+func (mr *MockChatAppInterfaceMockRecorder) AddChatMember(userID, chatID, newMemberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChatMember", reflect.TypeOf((*MockChatAppInterface)(nil).AddChatMember), userID, chatID, newMemberID)
+}
+
+// AddMessage mocks base method.
+func (m *MockChatAppInterface) AddMessage(message *entity.Message) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMessage", message)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMessage indicates an expected call of AddMessage.
+func (mr *MockChatAppInterfaceMockRecorder) AddMessage(message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMessage", reflect.TypeOf((*MockChatAppInterface)(nil).AddMessage), message)
+}
+
+// CreateChat mocks base method.
+func (m *MockChatAppInterface) CreateChat(firstUserID, secondUserID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChat", firstUserID, secondUserID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChat indicates an expected call of CreateChat.
+func (mr *MockChatAppInterfaceMockRecorder) CreateChat(firstUserID, secondUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChat", reflect.TypeOf((*MockChatAppInterface)(nil).CreateChat), firstUserID, secondUserID)
+}
+
+// CreateGroupChat mocks base method.
+func (m *MockChatAppInterface) CreateGroupChat(ownerID int, title string, memberIDs []int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupChat", ownerID, title, memberIDs)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroupChat indicates an expected call of CreateGroupChat.
+func (mr *MockChatAppInterfaceMockRecorder) CreateGroupChat(ownerID, title, memberIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupChat", reflect.TypeOf((*MockChatAppInterface)(nil).CreateGroupChat), ownerID, title, memberIDs)
+}
+
+// DeleteMessage mocks base method.
+func (m *MockChatAppInterface) DeleteMessage(userID, messageID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMessage", userID, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMessage indicates an expected call of DeleteMessage.
+func (mr *MockChatAppInterfaceMockRecorder) DeleteMessage(userID, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockChatAppInterface)(nil).DeleteMessage), userID, messageID)
+}
+
+// DeliverMessages mocks base method.
+func (m *MockChatAppInterface) DeliverMessages(userID, chatID, messageID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverMessages", userID, chatID, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeliverMessages indicates an expected call of DeliverMessages.
+func (mr *MockChatAppInterfaceMockRecorder) DeliverMessages(userID, chatID, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverMessages", reflect.TypeOf((*MockChatAppInterface)(nil).DeliverMessages), userID, chatID, messageID)
+}
+
+// EditMessage mocks base method.
+func (m *MockChatAppInterface) EditMessage(userID, messageID int, text string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditMessage", userID, messageID, text)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditMessage indicates an expected call of EditMessage.
+func (mr *MockChatAppInterfaceMockRecorder) EditMessage(userID, messageID, text interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditMessage", reflect.TypeOf((*MockChatAppInterface)(nil).EditMessage), userID, messageID, text)
+}
+
+// GetChatIDByUsers mocks base method.
+func (m *MockChatAppInterface) GetChatIDByUsers(firstUserID, secondUserID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatIDByUsers", firstUserID, secondUserID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatIDByUsers indicates an expected call of GetChatIDByUsers.
+func (mr *MockChatAppInterfaceMockRecorder) GetChatIDByUsers(firstUserID, secondUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatIDByUsers", reflect.TypeOf((*MockChatAppInterface)(nil).GetChatIDByUsers), firstUserID, secondUserID)
+}
+
+// GetChats mocks base method.
+func (m *MockChatAppInterface) GetChats(userID int) ([]entity.ChatOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChats", userID)
+	ret0, _ := ret[0].([]entity.ChatOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChats indicates an expected call of GetChats.
+func (mr *MockChatAppInterfaceMockRecorder) GetChats(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChats", reflect.TypeOf((*MockChatAppInterface)(nil).GetChats), userID)
+}
+
+// GetMessages mocks base method.
+func (m *MockChatAppInterface) GetMessages(userID, chatID, beforeMessageID, limit int) ([]*entity.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessages", userID, chatID, beforeMessageID, limit)
+	ret0, _ := ret[0].([]*entity.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessages indicates an expected call of GetMessages.
+func (mr *MockChatAppInterfaceMockRecorder) GetMessages(userID, chatID, beforeMessageID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessages", reflect.TypeOf((*MockChatAppInterface)(nil).GetMessages), userID, chatID, beforeMessageID, limit)
+}
+
+// GetPresence mocks base method.
+func (m *MockChatAppInterface) GetPresence(requesterID, userID int) (*entity.Presence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPresence", requesterID, userID)
+	ret0, _ := ret[0].(*entity.Presence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPresence indicates an expected call of GetPresence.
+func (mr *MockChatAppInterfaceMockRecorder) GetPresence(requesterID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresence", reflect.TypeOf((*MockChatAppInterface)(nil).GetPresence), requesterID, userID)
+}
+
+// LeaveChat mocks base method.
+func (m *MockChatAppInterface) LeaveChat(userID, chatID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveChat", userID, chatID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LeaveChat indicates an expected call of LeaveChat.
+func (mr *MockChatAppInterfaceMockRecorder) LeaveChat(userID, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveChat", reflect.TypeOf((*MockChatAppInterface)(nil).LeaveChat), userID, chatID)
+}
+
+// PostChatMessage mocks base method.
+func (m *MockChatAppInterface) PostChatMessage(authorID, chatID int, text string, attachments []entity.MessageAttachmentInput) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostChatMessage", authorID, chatID, text, attachments)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostChatMessage indicates an expected call of PostChatMessage.
+func (mr *MockChatAppInterfaceMockRecorder) PostChatMessage(authorID, chatID, text, attachments interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostChatMessage", reflect.TypeOf((*MockChatAppInterface)(nil).PostChatMessage), authorID, chatID, text, attachments)
+}
+
+// PostMessage mocks base method.
+func (m *MockChatAppInterface) PostMessage(authorID, targetID int, text string, attachments []entity.MessageAttachmentInput) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostMessage", authorID, targetID, text, attachments)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostMessage indicates an expected call of PostMessage.
+func (mr *MockChatAppInterfaceMockRecorder) PostMessage(authorID, targetID, text, attachments interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostMessage", reflect.TypeOf((*MockChatAppInterface)(nil).PostMessage), authorID, targetID, text, attachments)
+}
+
+// ReadChat mocks base method.
+func (m *MockChatAppInterface) ReadChat(chatID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadChat", chatID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReadChat indicates an expected call of ReadChat.
+func (mr *MockChatAppInterfaceMockRecorder) ReadChat(chatID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadChat", reflect.TypeOf((*MockChatAppInterface)(nil).ReadChat), chatID, userID)
+}
+
+// ReadMessages mocks base method.
+func (m *MockChatAppInterface) ReadMessages(userID, chatID, messageID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadMessages", userID, chatID, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReadMessages indicates an expected call of ReadMessages.
+func (mr *MockChatAppInterfaceMockRecorder) ReadMessages(userID, chatID, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMessages", reflect.TypeOf((*MockChatAppInterface)(nil).ReadMessages), userID, chatID, messageID)
+}
+
+// RemoveChatMember mocks base method.
+func (m *MockChatAppInterface) RemoveChatMember(userID, chatID, memberID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveChatMember", userID, chatID, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveChatMember indicates an expected call of RemoveChatMember.
+func (mr *MockChatAppInterfaceMockRecorder) RemoveChatMember(userID, chatID, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveChatMember", reflect.TypeOf((*MockChatAppInterface)(nil).RemoveChatMember), userID, chatID, memberID)
+}
+
+// SearchMessages mocks base method.
+func (m *MockChatAppInterface) SearchMessages(userID int, query string, beforeMessageID, limit int) ([]entity.MessageSearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMessages", userID, query, beforeMessageID, limit)
+	ret0, _ := ret[0].([]entity.MessageSearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMessages indicates an expected call of SearchMessages.
+func (mr *MockChatAppInterfaceMockRecorder) SearchMessages(userID, query, beforeMessageID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockChatAppInterface)(nil).SearchMessages), userID, query, beforeMessageID, limit)
+}
+
+// SendAllChats mocks base method.
+func (m *MockChatAppInterface) SendAllChats(userID int, client *websocket.Conn) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendAllChats", userID, client)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendAllChats indicates an expected call of SendAllChats.
+func (mr *MockChatAppInterfaceMockRecorder) SendAllChats(userID, client interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAllChats", reflect.TypeOf((*MockChatAppInterface)(nil).SendAllChats), userID, client)
+}
+
+// SendChat mocks base method.
+func (m *MockChatAppInterface) SendChat(chatID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendChat", chatID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendChat indicates an expected call of SendChat.
+func (mr *MockChatAppInterfaceMockRecorder) SendChat(chatID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendChat", reflect.TypeOf((*MockChatAppInterface)(nil).SendChat), chatID, userID)
+}
+
+// SendMessage mocks base method.
+func (m *MockChatAppInterface) SendMessage(chatID, messageID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMessage", chatID, messageID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMessage indicates an expected call of SendMessage.
+func (mr *MockChatAppInterfaceMockRecorder) SendMessage(chatID, messageID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockChatAppInterface)(nil).SendMessage), chatID, messageID, userID)
+}
+
+// SendTyping mocks base method.
+func (m *MockChatAppInterface) SendTyping(userID, chatID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTyping", userID, chatID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendTyping indicates an expected call of SendTyping.
+func (mr *MockChatAppInterfaceMockRecorder) SendTyping(userID, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTyping", reflect.TypeOf((*MockChatAppInterface)(nil).SendTyping), userID, chatID)
+}
+
+// SetChatMemberRole mocks base method.
+func (m *MockChatAppInterface) SetChatMemberRole(userID, chatID, memberID int, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChatMemberRole", userID, chatID, memberID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetChatMemberRole indicates an expected call of SetChatMemberRole.
+func (mr *MockChatAppInterfaceMockRecorder) SetChatMemberRole(userID, chatID, memberID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChatMemberRole", reflect.TypeOf((*MockChatAppInterface)(nil).SetChatMemberRole), userID, chatID, memberID, role)
+}
+
+// UpdateChatAvatar mocks base method.
+func (m *MockChatAppInterface) UpdateChatAvatar(userID, chatID int, file io.Reader, extension string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChatAvatar", userID, chatID, file, extension)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChatAvatar indicates an expected call of UpdateChatAvatar.
+func (mr *MockChatAppInterfaceMockRecorder) UpdateChatAvatar(userID, chatID, file, extension interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChatAvatar", reflect.TypeOf((*MockChatAppInterface)(nil).UpdateChatAvatar), userID, chatID, file, extension)
+}
+
+// UpdateGroupChat mocks base method.
+func (m *MockChatAppInterface) UpdateGroupChat(userID, chatID int, title string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGroupChat", userID, chatID, title)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGroupChat indicates an expected call of UpdateGroupChat.
+func (mr *MockChatAppInterfaceMockRecorder) UpdateGroupChat(userID, chatID, title interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroupChat", reflect.TypeOf((*MockChatAppInterface)(nil).UpdateGroupChat), userID, chatID, title)
+}
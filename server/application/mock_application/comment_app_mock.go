@@ -35,58 +35,89 @@ func (m *MockCommentAppInterface) EXPECT() *MockCommentAppInterfaceMockRecorder
 }
 
 // AddComment mocks base method.
-func (m *MockCommentAppInterface) AddComment(arg0 *entity.Comment) error {
+func (m *MockCommentAppInterface) AddComment(comment *entity.Comment) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddComment", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "AddComment", comment)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // AddComment indicates an expected call of AddComment.
-func (mr *MockCommentAppInterfaceMockRecorder) AddComment(arg0 interface{}) *gomock.Call {
+func (mr *MockCommentAppInterfaceMockRecorder) AddComment(comment interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddComment", reflect.TypeOf((*MockCommentAppInterface)(nil).AddComment), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddComment", reflect.TypeOf((*MockCommentAppInterface)(nil).AddComment), comment)
 }
 
 // DeleteComment mocks base method.
-func (m *MockCommentAppInterface) DeleteComment(arg0 *entity.Comment) error {
+func (m *MockCommentAppInterface) DeleteComment(userID, commentID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteComment", arg0)
+	ret := m.ctrl.Call(m, "DeleteComment", userID, commentID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteComment indicates an expected call of DeleteComment.
-func (mr *MockCommentAppInterfaceMockRecorder) DeleteComment(arg0 interface{}) *gomock.Call {
+func (mr *MockCommentAppInterfaceMockRecorder) DeleteComment(userID, commentID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockCommentAppInterface)(nil).DeleteComment), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockCommentAppInterface)(nil).DeleteComment), userID, commentID)
 }
 
 // EditComment mocks base method.
-func (m *MockCommentAppInterface) EditComment(arg0 *entity.Comment) error {
+func (m *MockCommentAppInterface) EditComment(userID, commentID int, text string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EditComment", arg0)
+	ret := m.ctrl.Call(m, "EditComment", userID, commentID, text)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // EditComment indicates an expected call of EditComment.
-func (mr *MockCommentAppInterfaceMockRecorder) EditComment(arg0 interface{}) *gomock.Call {
+func (mr *MockCommentAppInterfaceMockRecorder) EditComment(userID, commentID, text interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditComment", reflect.TypeOf((*MockCommentAppInterface)(nil).EditComment), userID, commentID, text)
+}
+
+// GetComment mocks base method.
+func (m *MockCommentAppInterface) GetComment(commentID int) (*entity.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComment", commentID)
+	ret0, _ := ret[0].(*entity.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComment indicates an expected call of GetComment.
+func (mr *MockCommentAppInterfaceMockRecorder) GetComment(commentID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditComment", reflect.TypeOf((*MockCommentAppInterface)(nil).EditComment), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComment", reflect.TypeOf((*MockCommentAppInterface)(nil).GetComment), commentID)
 }
 
 // GetComments mocks base method.
-func (m *MockCommentAppInterface) GetComments(arg0 int) ([]entity.Comment, error) {
+func (m *MockCommentAppInterface) GetComments(pinID int, page *entity.CommentsPageInput) (*entity.CommentsOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetComments", arg0)
-	ret0, _ := ret[0].([]entity.Comment)
+	ret := m.ctrl.Call(m, "GetComments", pinID, page)
+	ret0, _ := ret[0].(*entity.CommentsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetComments indicates an expected call of GetComments.
-func (mr *MockCommentAppInterfaceMockRecorder) GetComments(arg0 interface{}) *gomock.Call {
+func (mr *MockCommentAppInterfaceMockRecorder) GetComments(pinID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComments", reflect.TypeOf((*MockCommentAppInterface)(nil).GetComments), pinID, page)
+}
+
+// GetReplies mocks base method.
+func (m *MockCommentAppInterface) GetReplies(threadID int, page *entity.RepliesPageInput) (*entity.CommentsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplies", threadID, page)
+	ret0, _ := ret[0].(*entity.CommentsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplies indicates an expected call of GetReplies.
+func (mr *MockCommentAppInterfaceMockRecorder) GetReplies(threadID, page interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComments", reflect.TypeOf((*MockCommentAppInterface)(nil).GetComments), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplies", reflect.TypeOf((*MockCommentAppInterface)(nil).GetReplies), threadID, page)
 }
@@ -65,6 +65,35 @@ func (mr *MockCookieAppInterfaceMockRecorder) GenerateCookie() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCookie", reflect.TypeOf((*MockCookieAppInterface)(nil).GenerateCookie))
 }
 
+// GetSessions mocks base method.
+func (m *MockCookieAppInterface) GetSessions(userID int) ([]*__.CookieInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessions", userID)
+	ret0, _ := ret[0].([]*__.CookieInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessions indicates an expected call of GetSessions.
+func (mr *MockCookieAppInterfaceMockRecorder) GetSessions(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*MockCookieAppInterface)(nil).GetSessions), userID)
+}
+
+// RemoveAllSessions mocks base method.
+func (m *MockCookieAppInterface) RemoveAllSessions(userID int, exceptCookieValue string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAllSessions", userID, exceptCookieValue)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAllSessions indicates an expected call of RemoveAllSessions.
+func (mr *MockCookieAppInterfaceMockRecorder) RemoveAllSessions(userID, exceptCookieValue interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAllSessions", reflect.TypeOf((*MockCookieAppInterface)(nil).RemoveAllSessions), userID, exceptCookieValue)
+}
+
 // RemoveCookie mocks base method.
 func (m *MockCookieAppInterface) RemoveCookie(arg0 *__.CookieInfo) error {
 	m.ctrl.T.Helper()
@@ -79,19 +108,18 @@ func (mr *MockCookieAppInterfaceMockRecorder) RemoveCookie(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCookie", reflect.TypeOf((*MockCookieAppInterface)(nil).RemoveCookie), arg0)
 }
 
-// SearchByUserID mocks base method.
-func (m *MockCookieAppInterface) SearchByUserID(userID int) (*__.CookieInfo, bool) {
+// RemoveSession mocks base method.
+func (m *MockCookieAppInterface) RemoveSession(userID, sessionID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchByUserID", userID)
-	ret0, _ := ret[0].(*__.CookieInfo)
-	ret1, _ := ret[1].(bool)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RemoveSession", userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// SearchByUserID indicates an expected call of SearchByUserID.
-func (mr *MockCookieAppInterfaceMockRecorder) SearchByUserID(userID interface{}) *gomock.Call {
+// RemoveSession indicates an expected call of RemoveSession.
+func (mr *MockCookieAppInterfaceMockRecorder) RemoveSession(userID, sessionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByUserID", reflect.TypeOf((*MockCookieAppInterface)(nil).SearchByUserID), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSession", reflect.TypeOf((*MockCookieAppInterface)(nil).RemoveSession), userID, sessionID)
 }
 
 // SearchByValue mocks base method.
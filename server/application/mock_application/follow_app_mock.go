@@ -34,6 +34,49 @@ func (m *MockFollowAppInterface) EXPECT() *MockFollowAppInterfaceMockRecorder {
 	return m.recorder
 }
 
+// AcceptFollowRequest mocks base method.
+func (m *MockFollowAppInterface) AcceptFollowRequest(userID, followerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptFollowRequest", userID, followerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptFollowRequest indicates an expected call of AcceptFollowRequest.
+func (mr *MockFollowAppInterfaceMockRecorder) AcceptFollowRequest(userID, followerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptFollowRequest", reflect.TypeOf((*MockFollowAppInterface)(nil).AcceptFollowRequest), userID, followerID)
+}
+
+// Block mocks base method.
+func (m *MockFollowAppInterface) Block(blockerID, blockedID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Block", blockerID, blockedID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Block indicates an expected call of Block.
+func (mr *MockFollowAppInterfaceMockRecorder) Block(blockerID, blockedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Block", reflect.TypeOf((*MockFollowAppInterface)(nil).Block), blockerID, blockedID)
+}
+
+// CheckIfBlocked mocks base method.
+func (m *MockFollowAppInterface) CheckIfBlocked(firstUserID, secondUserID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIfBlocked", firstUserID, secondUserID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIfBlocked indicates an expected call of CheckIfBlocked.
+func (mr *MockFollowAppInterfaceMockRecorder) CheckIfBlocked(firstUserID, secondUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfBlocked", reflect.TypeOf((*MockFollowAppInterface)(nil).CheckIfBlocked), firstUserID, secondUserID)
+}
+
 // CheckIfFollowed mocks base method.
 func (m *MockFollowAppInterface) CheckIfFollowed(followerID, followedID int) (bool, error) {
 	m.ctrl.T.Helper()
@@ -49,14 +92,29 @@ func (mr *MockFollowAppInterfaceMockRecorder) CheckIfFollowed(followerID, follow
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfFollowed", reflect.TypeOf((*MockFollowAppInterface)(nil).CheckIfFollowed), followerID, followedID)
 }
 
-// Follow mocks base method.
-func (m *MockFollowAppInterface) Follow(followerID, followedID int) error {
+// CheckProfileAccess mocks base method.
+func (m *MockFollowAppInterface) CheckProfileAccess(viewerID, ownerID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Follow", followerID, followedID)
+	ret := m.ctrl.Call(m, "CheckProfileAccess", viewerID, ownerID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// CheckProfileAccess indicates an expected call of CheckProfileAccess.
+func (mr *MockFollowAppInterfaceMockRecorder) CheckProfileAccess(viewerID, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckProfileAccess", reflect.TypeOf((*MockFollowAppInterface)(nil).CheckProfileAccess), viewerID, ownerID)
+}
+
+// Follow mocks base method.
+func (m *MockFollowAppInterface) Follow(followerID, followedID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", followerID, followedID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // Follow indicates an expected call of Follow.
 func (mr *MockFollowAppInterfaceMockRecorder) Follow(followerID, followedID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
@@ -93,6 +151,36 @@ func (mr *MockFollowAppInterfaceMockRecorder) GetAllFollowers(followedID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFollowers", reflect.TypeOf((*MockFollowAppInterface)(nil).GetAllFollowers), followedID)
 }
 
+// GetBlockStatus mocks base method.
+func (m *MockFollowAppInterface) GetBlockStatus(userID, otherUserID int) (*entity.BlockStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockStatus", userID, otherUserID)
+	ret0, _ := ret[0].(*entity.BlockStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockStatus indicates an expected call of GetBlockStatus.
+func (mr *MockFollowAppInterfaceMockRecorder) GetBlockStatus(userID, otherUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockStatus", reflect.TypeOf((*MockFollowAppInterface)(nil).GetBlockStatus), userID, otherUserID)
+}
+
+// GetFollowRequests mocks base method.
+func (m *MockFollowAppInterface) GetFollowRequests(userID int) ([]entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowRequests", userID)
+	ret0, _ := ret[0].([]entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowRequests indicates an expected call of GetFollowRequests.
+func (mr *MockFollowAppInterfaceMockRecorder) GetFollowRequests(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowRequests", reflect.TypeOf((*MockFollowAppInterface)(nil).GetFollowRequests), userID)
+}
+
 // GetPinsOfFollowedUsers mocks base method.
 func (m *MockFollowAppInterface) GetPinsOfFollowedUsers(userID int) ([]entity.Pin, error) {
 	m.ctrl.T.Helper()
@@ -108,6 +196,34 @@ func (mr *MockFollowAppInterfaceMockRecorder) GetPinsOfFollowedUsers(userID inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPinsOfFollowedUsers", reflect.TypeOf((*MockFollowAppInterface)(nil).GetPinsOfFollowedUsers), userID)
 }
 
+// RejectFollowRequest mocks base method.
+func (m *MockFollowAppInterface) RejectFollowRequest(userID, followerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectFollowRequest", userID, followerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectFollowRequest indicates an expected call of RejectFollowRequest.
+func (mr *MockFollowAppInterfaceMockRecorder) RejectFollowRequest(userID, followerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectFollowRequest", reflect.TypeOf((*MockFollowAppInterface)(nil).RejectFollowRequest), userID, followerID)
+}
+
+// Unblock mocks base method.
+func (m *MockFollowAppInterface) Unblock(blockerID, blockedID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unblock", blockerID, blockedID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unblock indicates an expected call of Unblock.
+func (mr *MockFollowAppInterfaceMockRecorder) Unblock(blockerID, blockedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unblock", reflect.TypeOf((*MockFollowAppInterface)(nil).Unblock), blockerID, blockedID)
+}
+
 // Unfollow mocks base method.
 func (m *MockFollowAppInterface) Unfollow(followerID, followedID int) error {
 	m.ctrl.T.Helper()
@@ -10,6 +10,7 @@ import (
 	template "text/template"
 
 	gomock "github.com/golang/mock/gomock"
+	websocket "github.com/gorilla/websocket"
 )
 
 // MockNotificationAppInterface is a mock of NotificationAppInterface interface.
@@ -108,17 +109,17 @@ func (mr *MockNotificationAppInterfaceMockRecorder) RemoveNotification(userID, n
 }
 
 // SendAllNotifications mocks base method.
-func (m *MockNotificationAppInterface) SendAllNotifications(userID int) error {
+func (m *MockNotificationAppInterface) SendAllNotifications(userID int, client *websocket.Conn) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendAllNotifications", userID)
+	ret := m.ctrl.Call(m, "SendAllNotifications", userID, client)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SendAllNotifications indicates an expected call of SendAllNotifications.
-func (mr *MockNotificationAppInterfaceMockRecorder) SendAllNotifications(userID interface{}) *gomock.Call {
+func (mr *MockNotificationAppInterfaceMockRecorder) SendAllNotifications(userID, client interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAllNotifications", reflect.TypeOf((*MockNotificationAppInterface)(nil).SendAllNotifications), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAllNotifications", reflect.TypeOf((*MockNotificationAppInterface)(nil).SendAllNotifications), userID, client)
 }
 
 // SendNotification mocks base method.
@@ -36,17 +36,31 @@ func (m *MockPinAppInterface) EXPECT() *MockPinAppInterfaceMockRecorder {
 }
 
 // AddPin mocks base method.
-func (m *MockPinAppInterface) AddPin(boardID, pinID int) error {
+func (m *MockPinAppInterface) AddPin(userID, boardID, pinID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddPin", boardID, pinID)
+	ret := m.ctrl.Call(m, "AddPin", userID, boardID, pinID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AddPin indicates an expected call of AddPin.
-func (mr *MockPinAppInterfaceMockRecorder) AddPin(boardID, pinID interface{}) *gomock.Call {
+func (mr *MockPinAppInterfaceMockRecorder) AddPin(userID, boardID, pinID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPin", reflect.TypeOf((*MockPinAppInterface)(nil).AddPin), boardID, pinID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPin", reflect.TypeOf((*MockPinAppInterface)(nil).AddPin), userID, boardID, pinID)
+}
+
+// CheckPinVisibility mocks base method.
+func (m *MockPinAppInterface) CheckPinVisibility(userID int, pin *entity.Pin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckPinVisibility", userID, pin)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckPinVisibility indicates an expected call of CheckPinVisibility.
+func (mr *MockPinAppInterfaceMockRecorder) CheckPinVisibility(userID, pin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPinVisibility", reflect.TypeOf((*MockPinAppInterface)(nil).CheckPinVisibility), userID, pin)
 }
 
 // CreatePin mocks base method.
@@ -80,17 +94,17 @@ func (mr *MockPinAppInterfaceMockRecorder) CreateReport(report interface{}) *gom
 }
 
 // DeletePin mocks base method.
-func (m *MockPinAppInterface) DeletePin(pinID int) error {
+func (m *MockPinAppInterface) DeletePin(userID, pinID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePin", pinID)
+	ret := m.ctrl.Call(m, "DeletePin", userID, pinID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeletePin indicates an expected call of DeletePin.
-func (mr *MockPinAppInterfaceMockRecorder) DeletePin(pinID interface{}) *gomock.Call {
+func (mr *MockPinAppInterfaceMockRecorder) DeletePin(userID, pinID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePin", reflect.TypeOf((*MockPinAppInterface)(nil).DeletePin), pinID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePin", reflect.TypeOf((*MockPinAppInterface)(nil).DeletePin), userID, pinID)
 }
 
 // GetLastPinID mocks base method.
@@ -109,33 +123,48 @@ func (mr *MockPinAppInterfaceMockRecorder) GetLastPinID(userID interface{}) *gom
 }
 
 // GetPin mocks base method.
-func (m *MockPinAppInterface) GetPin(pinID int) (*entity.Pin, error) {
+func (m *MockPinAppInterface) GetPin(pinID, requesterID int) (*entity.Pin, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPin", pinID)
+	ret := m.ctrl.Call(m, "GetPin", pinID, requesterID)
 	ret0, _ := ret[0].(*entity.Pin)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPin indicates an expected call of GetPin.
-func (mr *MockPinAppInterfaceMockRecorder) GetPin(pinID interface{}) *gomock.Call {
+func (mr *MockPinAppInterfaceMockRecorder) GetPin(pinID, requesterID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPin", reflect.TypeOf((*MockPinAppInterface)(nil).GetPin), pinID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPin", reflect.TypeOf((*MockPinAppInterface)(nil).GetPin), pinID, requesterID)
 }
 
 // GetPins mocks base method.
-func (m *MockPinAppInterface) GetPins(boardID int) ([]entity.Pin, error) {
+func (m *MockPinAppInterface) GetPins(boardID, requesterID int) ([]entity.Pin, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPins", boardID)
+	ret := m.ctrl.Call(m, "GetPins", boardID, requesterID)
 	ret0, _ := ret[0].([]entity.Pin)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPins indicates an expected call of GetPins.
-func (mr *MockPinAppInterfaceMockRecorder) GetPins(boardID interface{}) *gomock.Call {
+func (mr *MockPinAppInterfaceMockRecorder) GetPins(boardID, requesterID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPins", reflect.TypeOf((*MockPinAppInterface)(nil).GetPins), boardID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPins", reflect.TypeOf((*MockPinAppInterface)(nil).GetPins), boardID, requesterID)
+}
+
+// GetPinsOfFollowedBoards mocks base method.
+func (m *MockPinAppInterface) GetPinsOfFollowedBoards(userID int) ([]entity.Pin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPinsOfFollowedBoards", userID)
+	ret0, _ := ret[0].([]entity.Pin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPinsOfFollowedBoards indicates an expected call of GetPinsOfFollowedBoards.
+func (mr *MockPinAppInterfaceMockRecorder) GetPinsOfFollowedBoards(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPinsOfFollowedBoards", reflect.TypeOf((*MockPinAppInterface)(nil).GetPinsOfFollowedBoards), userID)
 }
 
 // GetPinsOfUsers mocks base method.
@@ -169,17 +198,17 @@ func (mr *MockPinAppInterfaceMockRecorder) GetPinsWithOffset(offset, amount inte
 }
 
 // RemovePin mocks base method.
-func (m *MockPinAppInterface) RemovePin(boardID, pinID int) error {
+func (m *MockPinAppInterface) RemovePin(userID, boardID, pinID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemovePin", boardID, pinID)
+	ret := m.ctrl.Call(m, "RemovePin", userID, boardID, pinID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RemovePin indicates an expected call of RemovePin.
-func (mr *MockPinAppInterfaceMockRecorder) RemovePin(boardID, pinID interface{}) *gomock.Call {
+func (mr *MockPinAppInterfaceMockRecorder) RemovePin(userID, boardID, pinID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePin", reflect.TypeOf((*MockPinAppInterface)(nil).RemovePin), boardID, pinID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePin", reflect.TypeOf((*MockPinAppInterface)(nil).RemovePin), userID, boardID, pinID)
 }
 
 // SavePicture mocks base method.
@@ -211,30 +240,44 @@ func (mr *MockPinAppInterfaceMockRecorder) SavePin(userID, pinID interface{}) *g
 }
 
 // SearchPins mocks base method.
-func (m *MockPinAppInterface) SearchPins(keywords, date string) ([]entity.Pin, error) {
+func (m *MockPinAppInterface) SearchPins(keywords, interval string, requesterID int) ([]entity.Pin, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchPins", keywords, date)
+	ret := m.ctrl.Call(m, "SearchPins", keywords, interval, requesterID)
 	ret0, _ := ret[0].([]entity.Pin)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchPins indicates an expected call of SearchPins.
-func (mr *MockPinAppInterfaceMockRecorder) SearchPins(keywords, date interface{}) *gomock.Call {
+func (mr *MockPinAppInterfaceMockRecorder) SearchPins(keywords, interval, requesterID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPins", reflect.TypeOf((*MockPinAppInterface)(nil).SearchPins), keywords, interval, requesterID)
+}
+
+// UpdatePin mocks base method.
+func (m *MockPinAppInterface) UpdatePin(pin *entity.Pin, file io.Reader, extension string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePin", pin, file, extension)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePin indicates an expected call of UpdatePin.
+func (mr *MockPinAppInterfaceMockRecorder) UpdatePin(pin, file, extension interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPins", reflect.TypeOf((*MockPinAppInterface)(nil).SearchPins), keywords, date)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePin", reflect.TypeOf((*MockPinAppInterface)(nil).UpdatePin), pin, file, extension)
 }
 
 // UploadPicture mocks base method.
-func (m *MockPinAppInterface) UploadPicture(pinID int, file io.Reader, extension string) error {
+func (m *MockPinAppInterface) UploadPicture(userID, pinID int, file io.Reader, extension string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadPicture", pinID, file, extension)
+	ret := m.ctrl.Call(m, "UploadPicture", userID, pinID, file, extension)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UploadPicture indicates an expected call of UploadPicture.
-func (mr *MockPinAppInterfaceMockRecorder) UploadPicture(pinID, file, extension interface{}) *gomock.Call {
+func (mr *MockPinAppInterfaceMockRecorder) UploadPicture(userID, pinID, file, extension interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadPicture", reflect.TypeOf((*MockPinAppInterface)(nil).UploadPicture), pinID, file, extension)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadPicture", reflect.TypeOf((*MockPinAppInterface)(nil).UploadPicture), userID, pinID, file, extension)
 }
@@ -93,6 +93,21 @@ func (mr *MockUserAppInterfaceMockRecorder) DeleteUser(userID interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserAppInterface)(nil).DeleteUser), userID)
 }
 
+// GetPrivacySettings mocks base method.
+func (m *MockUserAppInterface) GetPrivacySettings(userID int) (*entity.PrivacySettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrivacySettings", userID)
+	ret0, _ := ret[0].(*entity.PrivacySettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrivacySettings indicates an expected call of GetPrivacySettings.
+func (mr *MockUserAppInterfaceMockRecorder) GetPrivacySettings(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivacySettings", reflect.TypeOf((*MockUserAppInterface)(nil).GetPrivacySettings), userID)
+}
+
 // GetUser mocks base method.
 func (m *MockUserAppInterface) GetUser(userID int) (*entity.User, error) {
 	m.ctrl.T.Helper()
@@ -138,6 +153,20 @@ func (mr *MockUserAppInterfaceMockRecorder) GetUsers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockUserAppInterface)(nil).GetUsers))
 }
 
+// SavePrivacySettings mocks base method.
+func (m *MockUserAppInterface) SavePrivacySettings(settings *entity.PrivacySettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePrivacySettings", settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePrivacySettings indicates an expected call of SavePrivacySettings.
+func (mr *MockUserAppInterfaceMockRecorder) SavePrivacySettings(settings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePrivacySettings", reflect.TypeOf((*MockUserAppInterface)(nil).SavePrivacySettings), settings)
+}
+
 // SaveUser mocks base method.
 func (m *MockUserAppInterface) SaveUser(user *entity.User) error {
 	m.ctrl.T.Helper()
@@ -153,18 +182,18 @@ func (mr *MockUserAppInterfaceMockRecorder) SaveUser(user interface{}) *gomock.C
 }
 
 // SearchUsers mocks base method.
-func (m *MockUserAppInterface) SearchUsers(keywords string) ([]entity.User, error) {
+func (m *MockUserAppInterface) SearchUsers(keywords string, requesterID int) ([]entity.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchUsers", keywords)
+	ret := m.ctrl.Call(m, "SearchUsers", keywords, requesterID)
 	ret0, _ := ret[0].([]entity.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchUsers indicates an expected call of SearchUsers.
-func (mr *MockUserAppInterfaceMockRecorder) SearchUsers(keywords interface{}) *gomock.Call {
+func (mr *MockUserAppInterfaceMockRecorder) SearchUsers(keywords, requesterID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockUserAppInterface)(nil).SearchUsers), keywords)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockUserAppInterface)(nil).SearchUsers), keywords, requesterID)
 }
 
 // UpdateAvatar mocks base method.
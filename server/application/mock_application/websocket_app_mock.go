@@ -5,6 +5,8 @@
 package mock_application
 
 import (
+	http "net/http"
+	entity "pinterest/domain/entity"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -34,32 +36,60 @@ func (m *MockWebsocketAppInterface) EXPECT() *MockWebsocketAppInterfaceMockRecor
 	return m.recorder
 }
 
-// ChangeClient mocks base method.
-func (m *MockWebsocketAppInterface) ChangeClient(userID int, client *websocket.Conn) error {
+// AddClient mocks base method.
+func (m *MockWebsocketAppInterface) AddClient(userID int, sessionCookie *http.Cookie, csrfToken string, client *websocket.Conn) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChangeClient", userID, client)
+	ret := m.ctrl.Call(m, "AddClient", userID, sessionCookie, csrfToken, client)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// ChangeClient indicates an expected call of ChangeClient.
-func (mr *MockWebsocketAppInterfaceMockRecorder) ChangeClient(userID, client interface{}) *gomock.Call {
+// AddClient indicates an expected call of AddClient.
+func (mr *MockWebsocketAppInterfaceMockRecorder) AddClient(userID, sessionCookie, csrfToken, client interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeClient", reflect.TypeOf((*MockWebsocketAppInterface)(nil).ChangeClient), userID, client)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddClient", reflect.TypeOf((*MockWebsocketAppInterface)(nil).AddClient), userID, sessionCookie, csrfToken, client)
 }
 
-// ChangeToken mocks base method.
-func (m *MockWebsocketAppInterface) ChangeToken(userID int, csrfToken string) error {
+// AddToken mocks base method.
+func (m *MockWebsocketAppInterface) AddToken(userID int, csrfToken string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChangeToken", userID, csrfToken)
+	ret := m.ctrl.Call(m, "AddToken", userID, csrfToken)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// ChangeToken indicates an expected call of ChangeToken.
-func (mr *MockWebsocketAppInterfaceMockRecorder) ChangeToken(userID, csrfToken interface{}) *gomock.Call {
+// AddToken indicates an expected call of AddToken.
+func (mr *MockWebsocketAppInterfaceMockRecorder) AddToken(userID, csrfToken interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeToken", reflect.TypeOf((*MockWebsocketAppInterface)(nil).ChangeToken), userID, csrfToken)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToken", reflect.TypeOf((*MockWebsocketAppInterface)(nil).AddToken), userID, csrfToken)
+}
+
+// CheckClientSession mocks base method.
+func (m *MockWebsocketAppInterface) CheckClientSession(userID int, client *websocket.Conn) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckClientSession", userID, client)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckClientSession indicates an expected call of CheckClientSession.
+func (mr *MockWebsocketAppInterfaceMockRecorder) CheckClientSession(userID, client interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckClientSession", reflect.TypeOf((*MockWebsocketAppInterface)(nil).CheckClientSession), userID, client)
+}
+
+// CheckSessions mocks base method.
+func (m *MockWebsocketAppInterface) CheckSessions(userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSessions", userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckSessions indicates an expected call of CheckSessions.
+func (mr *MockWebsocketAppInterfaceMockRecorder) CheckSessions(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSessions", reflect.TypeOf((*MockWebsocketAppInterface)(nil).CheckSessions), userID)
 }
 
 // CheckToken mocks base method.
@@ -76,19 +106,105 @@ func (mr *MockWebsocketAppInterfaceMockRecorder) CheckToken(userID, csrfToken in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckToken", reflect.TypeOf((*MockWebsocketAppInterface)(nil).CheckToken), userID, csrfToken)
 }
 
-// GetClient mocks base method.
-func (m *MockWebsocketAppInterface) GetClient(userID int) (*websocket.Conn, error) {
+// EndSessions mocks base method.
+func (m *MockWebsocketAppInterface) EndSessions(userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndSessions", userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndSessions indicates an expected call of EndSessions.
+func (mr *MockWebsocketAppInterfaceMockRecorder) EndSessions(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndSessions", reflect.TypeOf((*MockWebsocketAppInterface)(nil).EndSessions), userID)
+}
+
+// GetClients mocks base method.
+func (m *MockWebsocketAppInterface) GetClients(userID int) ([]*websocket.Conn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClients", userID)
+	ret0, _ := ret[0].([]*websocket.Conn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClients indicates an expected call of GetClients.
+func (mr *MockWebsocketAppInterfaceMockRecorder) GetClients(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClients", reflect.TypeOf((*MockWebsocketAppInterface)(nil).GetClients), userID)
+}
+
+// GetLastSequence mocks base method.
+func (m *MockWebsocketAppInterface) GetLastSequence(userID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastSequence", userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastSequence indicates an expected call of GetLastSequence.
+func (mr *MockWebsocketAppInterfaceMockRecorder) GetLastSequence(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastSequence", reflect.TypeOf((*MockWebsocketAppInterface)(nil).GetLastSequence), userID)
+}
+
+// GetPresence mocks base method.
+func (m *MockWebsocketAppInterface) GetPresence(userID int) (*entity.Presence, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetClient", userID)
-	ret0, _ := ret[0].(*websocket.Conn)
+	ret := m.ctrl.Call(m, "GetPresence", userID)
+	ret0, _ := ret[0].(*entity.Presence)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetClient indicates an expected call of GetClient.
-func (mr *MockWebsocketAppInterfaceMockRecorder) GetClient(userID interface{}) *gomock.Call {
+// GetPresence indicates an expected call of GetPresence.
+func (mr *MockWebsocketAppInterfaceMockRecorder) GetPresence(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresence", reflect.TypeOf((*MockWebsocketAppInterface)(nil).GetPresence), userID)
+}
+
+// PublishMessage mocks base method.
+func (m *MockWebsocketAppInterface) PublishMessage(userID int, message []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishMessage", userID, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishMessage indicates an expected call of PublishMessage.
+func (mr *MockWebsocketAppInterfaceMockRecorder) PublishMessage(userID, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishMessage", reflect.TypeOf((*MockWebsocketAppInterface)(nil).PublishMessage), userID, message)
+}
+
+// RemoveClient mocks base method.
+func (m *MockWebsocketAppInterface) RemoveClient(userID int, client *websocket.Conn) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveClient", userID, client)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveClient indicates an expected call of RemoveClient.
+func (mr *MockWebsocketAppInterfaceMockRecorder) RemoveClient(userID, client interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveClient", reflect.TypeOf((*MockWebsocketAppInterface)(nil).RemoveClient), userID, client)
+}
+
+// ReplayMessages mocks base method.
+func (m *MockWebsocketAppInterface) ReplayMessages(userID int, client *websocket.Conn, lastSequence int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayMessages", userID, client, lastSequence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplayMessages indicates an expected call of ReplayMessages.
+func (mr *MockWebsocketAppInterfaceMockRecorder) ReplayMessages(userID, client, lastSequence interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClient", reflect.TypeOf((*MockWebsocketAppInterface)(nil).GetClient), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayMessages", reflect.TypeOf((*MockWebsocketAppInterface)(nil).ReplayMessages), userID, client, lastSequence)
 }
 
 // SendMessage mocks base method.
@@ -105,6 +221,20 @@ func (mr *MockWebsocketAppInterfaceMockRecorder) SendMessage(userID, message int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockWebsocketAppInterface)(nil).SendMessage), userID, message)
 }
 
+// SendMessageToClient mocks base method.
+func (m *MockWebsocketAppInterface) SendMessageToClient(userID int, client *websocket.Conn, message []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMessageToClient", userID, client, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMessageToClient indicates an expected call of SendMessageToClient.
+func (mr *MockWebsocketAppInterfaceMockRecorder) SendMessageToClient(userID, client, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessageToClient", reflect.TypeOf((*MockWebsocketAppInterface)(nil).SendMessageToClient), userID, client, message)
+}
+
 // SendMessages mocks base method.
 func (m *MockWebsocketAppInterface) SendMessages(userID int, messages [][]byte) error {
 	m.ctrl.T.Helper()
@@ -13,6 +13,8 @@ import (
 	"strings"
 
 	"text/template"
+
+	"github.com/gorilla/websocket"
 )
 
 type NotificationApp struct {
@@ -35,7 +37,7 @@ type NotificationAppInterface interface {
 	RemoveNotification(userID int, notificationID int) error                      // Remove notification from list of user's notifications
 	EditNotification(notification *entity.Notification) error                     // Change fields of notification with same user and notification ID
 	GetNotification(userID int, notificationID int) (*entity.Notification, error) // Get notification from db using user's and notification's IDs
-	SendAllNotifications(userID int) error                                        // Send all of the notifications that this user has
+	SendAllNotifications(userID int, client *websocket.Conn) error                // Send all of the notifications that this user has to one of their clients
 	SendNotification(userID int, notificationID int) error                        // Send specified  notification to specified user
 	SendNotificationsToUsers(usersAndNotifications []entity.UserNotificationInfo) // Send notifications to users
 	SendNotificationEmail(userID int, notificationID int,
@@ -86,7 +88,9 @@ func (notificationApp *NotificationApp) GetNotification(userID int, notification
 	return notification, nil
 }
 
-func (notificationApp *NotificationApp) SendAllNotifications(userID int) error {
+// SendAllNotifications sends snapshot of user's notifications to one of their clients
+// Snapshots are not saved to user's outbox, as they are only needed by client which is (re)syncing
+func (notificationApp *NotificationApp) SendAllNotifications(userID int, client *websocket.Conn) error {
 	notifications, err := notificationApp.notificationRepo.GetAllNotifications(userID)
 	if err != nil {
 		switch err {
@@ -111,7 +115,7 @@ func (notificationApp *NotificationApp) SendAllNotifications(userID int) error {
 		return entity.JsonMarshallError
 	}
 
-	err = notificationApp.websocketApp.SendMessage(userID, message)
+	err = notificationApp.websocketApp.SendMessageToClient(userID, client, message)
 
 	return err
 }
@@ -35,22 +35,26 @@ func NewPinApp(grpcClient grpcPins.PinsClient, boardApp BoardAppInterface) *PinA
 
 type PinAppInterface interface {
 	CreatePin(pin *entity.Pin, file io.Reader, extension string) (int, error)
-	SavePin(userID int, pinID int) error                               // Add pin to user's initial board
-	AddPin(boardID int, pinID int) error                               // Add pin to specified board
-	GetPin(pinID int) (*entity.Pin, error)                             // Get pin by pinID
-	GetPins(boardID int) ([]entity.Pin, error)                         // Get pins by boardID
-	GetLastPinID(userID int) (int, error)                              // Get user's last pin's ID
-	SavePicture(pin *entity.Pin) error                                 // Update pin's picture properties
-	RemovePin(boardID int, pinID int) error                            // Delete pin from board
-	DeletePin(pinID int) error                                         // Delete pin entirely
-	UploadPicture(pinID int, file io.Reader, extension string) error   // Upload pin's image
-	GetPinsWithOffset(offset int, amount int) ([]entity.Pin, error)    // Get specified amount of pins
-	SearchPins(keywords string, interval string) ([]entity.Pin, error) // Search pins by keywords during interval
-	GetPinsOfUsers(userIDs []int) ([]entity.Pin, error)                // Get all pins belonging to users
+	SavePin(userID int, pinID int) error                                                // Add pin to user's initial board
+	AddPin(userID int, boardID int, pinID int) error                                    // Add pin that user can see to specified board
+	GetPin(pinID int, requesterID int) (*entity.Pin, error)                             // Get pin by pinID, pin which is only on secret boards is not found unless requester can see it
+	CheckPinVisibility(userID int, pin *entity.Pin) error                               // Check whether user can see pin
+	GetPins(boardID int, requesterID int) ([]entity.Pin, error)                         // Get pins by boardID, secret board has none unless requester can see it
+	GetLastPinID(userID int) (int, error)                                               // Get user's last pin's ID
+	SavePicture(pin *entity.Pin) error                                                  // Update pin's picture properties
+	UpdatePin(pin *entity.Pin, file io.Reader, extension string) error                  // Update pin's title, description and, if file is passed, image
+	RemovePin(userID int, boardID int, pinID int) error                                 // Delete pin from board
+	DeletePin(userID int, pinID int) error                                              // Delete pin entirely
+	UploadPicture(userID int, pinID int, file io.Reader, extension string) error        // Upload image of user's pin
+	GetPinsWithOffset(offset int, amount int) ([]entity.Pin, error)                     // Get specified amount of pins
+	SearchPins(keywords string, interval string, requesterID int) ([]entity.Pin, error) // Search pins by keywords during interval, skipping pins of users who blocked requester or were blocked by them
+	GetPinsOfUsers(userIDs []int) ([]entity.Pin, error)                                 // Get all pins belonging to users
+	GetPinsOfFollowedBoards(userID int) ([]entity.Pin, error)                           // Get all pins from boards user follows
 	CreateReport(report *entity.Report) (int, error)
 }
 
 // CreatePin creates passed pin and adds it to native user's board
+// pin.IsSecret is set if chosen board is secret
 // It returns pin's assigned ID and nil on success, any number and error on failure
 func (pinApp *PinApp) CreatePin(pin *entity.Pin, file io.Reader, extension string) (int, error) {
 	if pin.BoardID == 0 { // If board was not specified, add pin to default board
@@ -77,13 +81,13 @@ func (pinApp *PinApp) CreatePin(pin *entity.Pin, file io.Reader, extension strin
 		}
 	}
 
-	err = pinApp.UploadPicture(int(pinID.PinID), file, extension)
+	err = pinApp.UploadPicture(pin.UserID, int(pinID.PinID), file, extension)
 	if err != nil {
 		pinApp.grpcClient.DeletePin(context.Background(), pinID)
 		return -1, err
 	}
 
-	err = pinApp.AddPin(pin.BoardID, int(pinID.PinID))
+	err = pinApp.AddPin(pin.UserID, pin.BoardID, int(pinID.PinID))
 	if err != nil {
 		pinApp.grpcClient.DeletePin(context.Background(), pinID)
 		pinApp.grpcClient.DeleteFile(context.Background(), &grpcPins.FilePath{ImagePath: pin.ImageLink})
@@ -93,6 +97,12 @@ func (pinApp *PinApp) CreatePin(pin *entity.Pin, file io.Reader, extension strin
 		return -1, err
 	}
 
+	createdPin, err := pinApp.GetPin(int(pinID.PinID), pin.UserID)
+	if err != nil {
+		return -1, err
+	}
+	pin.IsSecret = createdPin.IsSecret
+
 	return int(pinID.PinID), nil
 }
 
@@ -104,7 +114,7 @@ func (pinApp *PinApp) SavePin(userID int, pinID int) error {
 		return err
 	}
 
-	err = pinApp.AddPin(initBoardID, pinID)
+	err = pinApp.AddPin(userID, initBoardID, pinID)
 	if err != nil {
 		return err
 	}
@@ -112,10 +122,20 @@ func (pinApp *PinApp) SavePin(userID int, pinID int) error {
 	return nil
 }
 
-// AddPin adds pin to chosen board
+// AddPin adds pin to chosen board, making it board's avatar unless board's cover pin was chosen
+// Pins which user can't see are not added, so that secret pins can't get on public boards
 // It returns nil on success, error on failure
-func (pinApp *PinApp) AddPin(boardID int, pinID int) error {
-	pin, err := pinApp.GetPin(pinID)
+func (pinApp *PinApp) AddPin(userID int, boardID int, pinID int) error {
+	board, err := pinApp.boardApp.GetBoard(boardID, userID)
+	if err != nil {
+		return err
+	}
+
+	if board.IsArchived {
+		return entity.BoardArchivedError
+	}
+
+	pin, err := pinApp.GetPin(pinID, userID)
 	if err != nil {
 		return err
 	}
@@ -130,6 +150,10 @@ func (pinApp *PinApp) AddPin(boardID int, pinID int) error {
 		return err
 	}
 
+	if board.CoverPinID != 0 {
+		return nil
+	}
+
 	avatarInfo := new(grpcPins.FileInfo)
 	avatarInfo.BoardID = int64(boardID)
 	avatarInfo.ImageLink = pin.ImageLink
@@ -146,10 +170,12 @@ func (pinApp *PinApp) AddPin(boardID int, pinID int) error {
 	return nil
 }
 
-// GetPin returns pin with passed pinID
+// GetPin returns pin with passed pinID, pins which are only on secret boards are found
+// only by their author and those who can see one of these boards
 // It returns that pin and nil on success, nil and error on failure
-func (pinApp *PinApp) GetPin(pinID int) (*entity.Pin, error) {
-	grpcPin, err := pinApp.grpcClient.GetPin(context.Background(), &grpcPins.PinID{PinID: int64(pinID)})
+func (pinApp *PinApp) GetPin(pinID int, requesterID int) (*entity.Pin, error) {
+	grpcPin, err := pinApp.grpcClient.GetPin(context.Background(),
+		&grpcPins.PinRequest{PinID: int64(pinID), RequesterID: int64(requesterID)})
 	if err != nil {
 		switch {
 		case strings.Contains(err.Error(), entity.PinScanError.Error()):
@@ -166,10 +192,23 @@ func (pinApp *PinApp) GetPin(pinID int) (*entity.Pin, error) {
 	return &pin, nil
 }
 
-// GetPins returns all the pins with passed boardID
+// CheckPinVisibility checks if user can see pin, pins which are only on secret boards are seen
+// only by their author and those who can see one of these boards
+// It returns nil if user can see pin, PinNotFoundError otherwise, so that secret pins can't be found out
+func (pinApp *PinApp) CheckPinVisibility(userID int, pin *entity.Pin) error {
+	if !pin.IsSecret || pin.UserID == userID {
+		return nil
+	}
+
+	_, err := pinApp.GetPin(pin.PinID, userID) // Boards of pin are checked by pins service in one query
+	return err
+}
+
+// GetPins returns all the pins with passed boardID, secret board has none unless requester is its owner or collaborator
 // It returns slice of pins and nil on success, nil and error on failure
-func (pinApp *PinApp) GetPins(boardID int) ([]entity.Pin, error) {
-	grpcPinsList, err := pinApp.grpcClient.GetPins(context.Background(), &grpcPins.BoardID{BoardID: int64(boardID)})
+func (pinApp *PinApp) GetPins(boardID int, requesterID int) ([]entity.Pin, error) {
+	grpcPinsList, err := pinApp.grpcClient.GetPins(context.Background(),
+		&grpcPins.BoardRequest{BoardID: int64(boardID), RequesterID: int64(requesterID)})
 	if err != nil {
 		switch {
 		case strings.Contains(err.Error(), entity.PinScanError.Error()):
@@ -186,8 +225,8 @@ func (pinApp *PinApp) GetPins(boardID int) ([]entity.Pin, error) {
 
 // DeletePin deletes pin with passed pinID, deleting associated comments and board relations
 // It returns nil on success and error on failure
-func (pinApp *PinApp) DeletePin(pinID int) error {
-	pin, err := pinApp.GetPin(pinID)
+func (pinApp *PinApp) DeletePin(userID int, pinID int) error {
+	pin, err := pinApp.GetPin(pinID, userID)
 	if err != nil {
 		return err
 	}
@@ -214,8 +253,8 @@ func (pinApp *PinApp) DeletePin(pinID int) error {
 
 // RemovePin deletes pin from user's passed board
 // It returns nil on success and error on failure
-func (pinApp *PinApp) RemovePin(boardID int, pinID int) error {
-	pin, err := pinApp.GetPin(pinID)
+func (pinApp *PinApp) RemovePin(userID int, boardID int, pinID int) error {
+	pin, err := pinApp.GetPin(pinID, userID)
 	if err != nil {
 		return err
 	}
@@ -234,25 +273,7 @@ func (pinApp *PinApp) RemovePin(boardID int, pinID int) error {
 		}
 	}
 
-	lastPin, err := pinApp.grpcClient.GetLastBoardPin(context.Background(), &grpcPins.BoardID{BoardID: int64(boardID)})
-	var boardAvatar = new(grpcPins.FileInfo)
-	boardAvatar.BoardID = int64(boardID)
-	switch {
-	case err == nil:
-		boardAvatar.ImageLink = lastPin.ImageLink
-		boardAvatar.ImageHeight = int64(lastPin.ImageHeight)
-		boardAvatar.ImageWidth = int64(lastPin.ImageWidth)
-		boardAvatar.ImageAvgColor = lastPin.ImageAvgColor
-	case strings.Contains(err.Error(), entity.PinNotFoundError.Error()): // If there are no pins left, we take default image
-		boardAvatar.ImageLink = string(entity.BoardAvatarDefaultPath)
-		boardAvatar.ImageHeight = 480
-		boardAvatar.ImageWidth = 1200
-		boardAvatar.ImageAvgColor = "5a5a5a"
-	default:
-		return err
-	}
-
-	_, err = pinApp.grpcClient.UploadBoardAvatar(context.Background(), boardAvatar)
+	err = pinApp.boardApp.RefreshBoardAvatar(userID, boardID)
 	if err != nil {
 		return err
 	}
@@ -266,7 +287,7 @@ func (pinApp *PinApp) RemovePin(boardID int, pinID int) error {
 	}
 
 	if refCount.Number == 0 {
-		err = pinApp.DeletePin(pinID)
+		err = pinApp.DeletePin(pin.UserID, pinID) // Pin is on no boards now, so only its author can see it
 		if err != nil {
 			return err
 		}
@@ -311,25 +332,136 @@ func (pinApp *PinApp) GetLastPinID(userID int) (int, error) {
 	return int(grpcPinID.PinID), nil
 }
 
-//UploadPicture uploads picture to pin and saves new picture path in S3
+// UpdatePin saves pin's new title and description, replacing pin's image if file is not nil
+// Boards which had old image as their avatar get the new one
+// It returns nil on success and error on failure
+func (pinApp *PinApp) UpdatePin(pin *entity.Pin, file io.Reader, extension string) error {
+	oldPin, err := pinApp.GetPin(pin.PinID, pin.UserID)
+	if err != nil {
+		return err
+	}
+
+	pin.ImageLink = oldPin.ImageLink
+	pin.ImageHeight = oldPin.ImageHeight
+	pin.ImageWidth = oldPin.ImageWidth
+	pin.ImageAvgColor = oldPin.ImageAvgColor
+	if file != nil {
+		imageStruct, imageLink, err := pinApp.uploadImage(file, extension)
+		if err != nil {
+			return err
+		}
+
+		pin.ImageLink = imageLink
+		pin.ImageHeight = imageStruct.height
+		pin.ImageWidth = imageStruct.width
+		pin.ImageAvgColor = imageStruct.averageColor
+	}
+
+	grpcPin := grpcPins.Pin{}
+	ConvertToGrpcPin(&grpcPin, pin)
+	_, err = pinApp.grpcClient.UpdatePin(context.Background(), &grpcPin)
+	if err != nil {
+		if pin.ImageLink != oldPin.ImageLink {
+			pinApp.grpcClient.DeleteFile(context.Background(), &grpcPins.FilePath{ImagePath: pin.ImageLink})
+		}
+		switch {
+		case strings.Contains(err.Error(), entity.PinNotFoundError.Error()):
+			return entity.PinNotFoundError
+		case strings.Contains(err.Error(), entity.PinSavingError.Error()):
+			return entity.PinSavingError
+		default:
+			return err
+		}
+	}
+
+	if pin.ImageLink == oldPin.ImageLink {
+		return nil
+	}
+
+	err = pinApp.refreshBoardAvatars(oldPin.ImageLink, pin)
+	if err != nil {
+		return err
+	}
+
+	_, err = pinApp.grpcClient.DeleteFile(context.Background(), &grpcPins.FilePath{ImagePath: oldPin.ImageLink})
+	if err != nil {
+		return entity.FileDeletionError
+	}
+
+	return nil
+}
+
+// refreshBoardAvatars sets pin's image as avatar of boards containing pin whose avatar was oldImageLink
 // It returns nil on success and error on failure
-func (pinApp *PinApp) UploadPicture(pinID int, file io.Reader, extension string) error {
-	pin, err := pinApp.GetPin(pinID)
+func (pinApp *PinApp) refreshBoardAvatars(oldImageLink string, pin *entity.Pin) error {
+	boards, err := pinApp.grpcClient.GetBoardsWithPin(context.Background(), &grpcPins.PinID{PinID: int64(pin.PinID)})
+	if err != nil {
+		return err
+	}
+
+	for _, board := range boards.Boards {
+		if board.ImageLink != oldImageLink { // Board's avatar was taken from some other pin
+			continue
+		}
+
+		avatarInfo := new(grpcPins.FileInfo)
+		avatarInfo.BoardID = board.BoardID
+		avatarInfo.ImageLink = pin.ImageLink
+		avatarInfo.ImageHeight = int64(pin.ImageHeight)
+		avatarInfo.ImageWidth = int64(pin.ImageWidth)
+		avatarInfo.ImageAvgColor = pin.ImageAvgColor
+		_, err = pinApp.grpcClient.UploadBoardAvatar(context.Background(), avatarInfo)
+		if err != nil {
+			if strings.Contains(err.Error(), entity.BoardAvatarUploadError.Error()) {
+				return entity.BoardAvatarUploadError
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+//UploadPicture uploads picture to user's pin and saves new picture path in S3
+// It returns nil on success and error on failure
+func (pinApp *PinApp) UploadPicture(userID int, pinID int, file io.Reader, extension string) error {
+	pin, err := pinApp.GetPin(pinID, userID)
 	if err != nil {
 		return entity.PinNotFoundError
 	}
 
+	imageStruct, imageLink, err := pinApp.uploadImage(file, extension)
+	if err != nil {
+		return err
+	}
+
+	pin.ImageLink = imageLink
+	pin.ImageHeight = imageStruct.height
+	pin.ImageWidth = imageStruct.width
+	pin.ImageAvgColor = imageStruct.averageColor
+
+	err = pinApp.SavePicture(pin)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// uploadImage uploads image to S3 through pins service
+// It returns image's properties, path to uploaded image and nil on success, nil, empty string and error on failure
+func (pinApp *PinApp) uploadImage(file io.Reader, extension string) (*imageInfo, string, error) {
 	var fileAsBytes []byte
 	imageStruct := new(imageInfo)
 	switch extension {
 	case ".png", ".jpg", ".gif", ".jpeg":
 		fileAsBytes, _ = io.ReadAll(file) // TODO: this may be too slow, rework somehow? Maybe restore file after reading height/width?
-		err = imageStruct.fillFromImage(bytes.NewReader(fileAsBytes))
+		err := imageStruct.fillFromImage(bytes.NewReader(fileAsBytes))
 		if err != nil {
-			return fmt.Errorf("Image parsing failed")
+			return nil, "", fmt.Errorf("Image parsing failed")
 		}
 	default:
-		return fmt.Errorf("File extension not supported")
+		return nil, "", fmt.Errorf("File extension not supported")
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
@@ -340,7 +472,7 @@ func (pinApp *PinApp) UploadPicture(pinID int, file io.Reader, extension string)
 		case strings.Contains(err.Error(), entity.FilenameGenerationError.Error()):
 			stream, err = pinApp.grpcClient.UploadPicture(ctx)
 		default:
-			return entity.FileUploadError
+			return nil, "", entity.FileUploadError
 		}
 	}
 
@@ -351,7 +483,7 @@ func (pinApp *PinApp) UploadPicture(pinID int, file io.Reader, extension string)
 	}
 	err = stream.Send(req)
 	if err != nil {
-		return fmt.Errorf("cannot send image info to server: \n%s\n%s", err, stream.RecvMsg(nil))
+		return nil, "", fmt.Errorf("cannot send image info to server: \n%s\n%s", err, stream.RecvMsg(nil))
 	}
 	reader := bytes.NewReader(fileAsBytes)
 	buffer := make([]byte, 3.5*1024*1024) // jrpc cannot receive packages larger than 4 MB
@@ -362,7 +494,7 @@ func (pinApp *PinApp) UploadPicture(pinID int, file io.Reader, extension string)
 			break
 		}
 		if err != nil {
-			return fmt.Errorf("cannot read chunk to buffer: \n%s", err)
+			return nil, "", fmt.Errorf("cannot read chunk to buffer: \n%s", err)
 		}
 
 		req = &grpcPins.UploadImage{
@@ -372,26 +504,16 @@ func (pinApp *PinApp) UploadPicture(pinID int, file io.Reader, extension string)
 		}
 		err = stream.Send(req)
 		if err != nil {
-			return fmt.Errorf("cannot send chunk to server: \n%s", err)
+			return nil, "", fmt.Errorf("cannot send chunk to server: \n%s", err)
 		}
 	}
 
 	res, err := stream.CloseAndRecv()
 	if err != nil {
-		return fmt.Errorf("cannot receive response: \n%s", err)
-	}
-
-	pin.ImageLink = res.Path
-	pin.ImageHeight = imageStruct.height
-	pin.ImageWidth = imageStruct.width
-	pin.ImageAvgColor = imageStruct.averageColor
-
-	err = pinApp.SavePicture(pin)
-	if err != nil {
-		return err
+		return nil, "", fmt.Errorf("cannot receive response: \n%s", err)
 	}
 
-	return nil
+	return imageStruct, res.Path, nil
 }
 
 // GetPinsWithOffset generates the main feed
@@ -417,9 +539,9 @@ func (pinApp *PinApp) GetPinsWithOffset(offset int, amount int) ([]entity.Pin, e
 
 // SearchPins returns pins by keywords
 // It returns suitable pins and nil on success, nil and error on failure
-func (pinApp *PinApp) SearchPins(keyWords string, interval string) ([]entity.Pin, error) {
+func (pinApp *PinApp) SearchPins(keyWords string, interval string, requesterID int) ([]entity.Pin, error) {
 	grpcPinsList, err := pinApp.grpcClient.SearchPins(context.Background(),
-		&grpcPins.SearchInput{KeyWords: keyWords, Interval: interval})
+		&grpcPins.SearchInput{KeyWords: keyWords, Interval: interval, RequesterID: int64(requesterID)})
 	if err != nil {
 		switch {
 		case strings.Contains(err.Error(), entity.PinsNotFoundError.Error()):
@@ -457,10 +579,24 @@ func (pinApp *PinApp) GetPinsOfUsers(userIDs []int) ([]entity.Pin, error) {
 	return ConvertGrpcPins(grpcPinsList), nil
 }
 
+// GetPinsOfFollowedBoards outputs all pins from boards which user follows
+// It returns slice of pins, nil on success, nil, error on failure
+func (pinApp *PinApp) GetPinsOfFollowedBoards(userID int) ([]entity.Pin, error) {
+	grpcPinsList, err := pinApp.grpcClient.GetPinsOfFollowedBoards(context.Background(), &grpcPins.UserID{Uid: int64(userID)})
+	if err != nil {
+		if strings.Contains(err.Error(), entity.PinScanError.Error()) {
+			return nil, entity.PinScanError
+		}
+		return nil, err
+	}
+
+	return ConvertGrpcPins(grpcPinsList), nil
+}
+
 // CreateReport adds report with parameters of passed report struct to database
 // It returns added report's ID, nil on success, -1, error on failure
 func (pinApp *PinApp) CreateReport(report *entity.Report) (int, error) {
-	_, err := pinApp.GetPin(report.PinID)
+	_, err := pinApp.GetPin(report.PinID, report.SenderID)
 	if err != nil {
 		return -1, err
 	}
@@ -513,6 +649,8 @@ func ConvertToGrpcPin(grpcPin *grpcPins.Pin, pin *entity.Pin) {
 	grpcPin.ImageLink = pin.ImageLink
 	grpcPin.CreationDate = timestamppb.New(pin.CreationDate)
 	grpcPin.ReportsCount = int64(pin.ReportsCount)
+	grpcPin.IsSecret = pin.IsSecret
+	grpcPin.SectionID = int64(pin.SectionID)
 }
 
 func ConvertFromGrpcPin(pin *entity.Pin, grpcPin *grpcPins.Pin) {
@@ -527,6 +665,8 @@ func ConvertFromGrpcPin(pin *entity.Pin, grpcPin *grpcPins.Pin) {
 	pin.ImageLink = grpcPin.ImageLink
 	pin.CreationDate = grpcPin.CreationDate.AsTime()
 	pin.ReportsCount = int(grpcPin.ReportsCount)
+	pin.IsSecret = grpcPin.IsSecret
+	pin.SectionID = int(grpcPin.SectionID)
 }
 
 func ConvertGrpcPins(grpcPins *grpcPins.PinsList) []entity.Pin {
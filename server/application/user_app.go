@@ -25,16 +25,18 @@ func NewUserApp(us grpcUser.UserClient, boardApp BoardAppInterface) *UserApp {
 }
 
 type UserAppInterface interface {
-	CreateUser(user *entity.User) (int, error)                         // Create user, returns created user's ID
-	CreateUserWithVK(tokenInput *entity.UserVkTokenInput) (int, error) // Create user using vk's info
-	SaveUser(user *entity.User) error                                  // Save changed user to database
-	ChangePassword(user *entity.User) error                            // Change user's password
-	DeleteUser(userID int) error                                       // Delete user with passed userID from database
-	GetUser(userID int) (*entity.User, error)                          // Get user by his ID
-	GetUsers() ([]entity.User, error)                                  // Get all users
-	GetUserByUsername(username string) (*entity.User, error)           // Get user by his username
-	UpdateAvatar(userID int, file io.Reader, extension string) error   // Replace user's avatar with one passed as second parameter
-	SearchUsers(keywords string) ([]entity.User, error)                // Get all users by passed keywords
+	CreateUser(user *entity.User) (int, error)                           // Create user, returns created user's ID
+	CreateUserWithVK(tokenInput *entity.UserVkTokenInput) (int, error)   // Create user using vk's info
+	SaveUser(user *entity.User) error                                    // Save changed user to database
+	ChangePassword(user *entity.User) error                              // Change user's password
+	DeleteUser(userID int) error                                         // Delete user with passed userID from database
+	GetUser(userID int) (*entity.User, error)                            // Get user by his ID
+	GetUsers() ([]entity.User, error)                                    // Get all users
+	GetUserByUsername(username string) (*entity.User, error)             // Get user by his username
+	UpdateAvatar(userID int, file io.Reader, extension string) error     // Replace user's avatar with one passed as second parameter
+	SearchUsers(keywords string, requesterID int) ([]entity.User, error) // Get all users by passed keywords, except those who blocked requester or were blocked by them (0 means anonymous requester)
+	GetPrivacySettings(userID int) (*entity.PrivacySettings, error)      // Get user's privacy settings
+	SavePrivacySettings(settings *entity.PrivacySettings) error          // Save user's privacy settings
 }
 
 // CreateUser adds new user to database with passed fields
@@ -291,8 +293,9 @@ func (userApp *UserApp) UpdateAvatar(userID int, file io.Reader, extension strin
 
 // SearchUsers fetches all users from database suitable with passed keywords
 // It returns slice of users and nil on success, nil and error on failure
-func (userApp *UserApp) SearchUsers(keyWords string) ([]entity.User, error) {
-	usersList, err := userApp.grpcClient.SearchUsers(context.Background(), &grpcUser.SearchInput{KeyWords: keyWords})
+func (userApp *UserApp) SearchUsers(keyWords string, requesterID int) ([]entity.User, error) {
+	usersList, err := userApp.grpcClient.SearchUsers(context.Background(),
+		&grpcUser.SearchInput{KeyWords: keyWords, RequesterID: int64(requesterID)})
 	if err != nil {
 		switch {
 		case strings.Contains(err.Error(), entity.UsersNotFoundError.Error()):
@@ -361,3 +364,34 @@ func ReturnUsersList(userOutList []*grpcUser.UserOutput) []entity.User {
 	}
 	return userList
 }
+
+// GetPrivacySettings returns privacy settings of user with passed ID
+func (userApp *UserApp) GetPrivacySettings(userID int) (*entity.PrivacySettings, error) {
+	grpcSettings, err := userApp.grpcClient.GetPrivacySettings(context.Background(), &grpcUser.UserID{Uid: int64(userID)})
+	if err != nil {
+		if strings.Contains(err.Error(), entity.UserNotFoundError.Error()) {
+			return nil, entity.UserNotFoundError
+		}
+		return nil, err
+	}
+
+	return &entity.PrivacySettings{
+		UserID:       int(grpcSettings.UserID),
+		HidePresence: grpcSettings.HidePresence,
+		IsPrivate:    grpcSettings.IsPrivate,
+	}, nil
+}
+
+// SavePrivacySettings replaces user's privacy settings with passed ones
+func (userApp *UserApp) SavePrivacySettings(settings *entity.PrivacySettings) error {
+	_, err := userApp.grpcClient.SavePrivacySettings(context.Background(),
+		&grpcUser.PrivacySettings{UserID: int64(settings.UserID), HidePresence: settings.HidePresence, IsPrivate: settings.IsPrivate})
+	if err != nil {
+		if strings.Contains(err.Error(), entity.UserNotFoundError.Error()) {
+			return entity.UserNotFoundError
+		}
+		return err
+	}
+
+	return nil
+}
@@ -1,42 +1,88 @@
 package application
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"pinterest/domain/entity"
+	"pinterest/domain/repository"
 	"sync"
+	"time"
 
 	"github.com/gorilla/websocket"
 )
 
+// websocketClient is one of user's live connections (e.g. a browser tab)
+type websocketClient struct {
+	mu            sync.Mutex // Guards writes to conn
+	conn          *websocket.Conn
+	csrfToken     string        // Token client was connected with
+	sessionCookie *http.Cookie  // Cookie of session client was authenticated with
+	queue         chan []byte   // Broadcasted messages waiting to be written by client's writer goroutine
+	removed       chan struct{} // Is closed when client is removed, stopping its writer goroutine
+	removeOnce    sync.Once
+}
+
+const clientQueueSize = 256 // Clients which fall behind by more messages are disconnected
+
+// websocketInfo keeps all of user's live connections
 type websocketInfo struct {
-	csrfToken string
-	mu        sync.Mutex
-	client    *websocket.Conn
+	mu         sync.Mutex
+	clients    map[*websocket.Conn]*websocketClient
+	csrfTokens []string // Tokens user may connect with, oldest first
 }
 
+const maxCSRFTokensPerUser = 16 // Oldest tokens are forgotten, so that list does not grow with every login
+
 type WebsocketApp struct {
-	connections map[int]*websocketInfo
-	mu          sync.Mutex
-	userApp     UserAppInterface
+	connections  map[int]*websocketInfo
+	mu           sync.Mutex
+	userApp      UserAppInterface
+	authApp      AuthAppInterface
+	broadcaster  repository.BroadcasterInterface // Other server instances may hold user's clients, so messages go through broadcaster
+	outboxRepo   repository.OutboxRepositoryInterface
+	presenceRepo repository.PresenceRepositoryInterface
 }
 
-func NewWebsocketApp(userApp UserAppInterface) *WebsocketApp {
-	return &WebsocketApp{
-		connections: make(map[int]*websocketInfo),
-		userApp:     userApp,
+const presenceRefreshPeriod = 15 * time.Second // How often users connected to this instance are marked online again
+const presenceTTL = 45                         // In seconds, users whose instance stopped refreshing them go offline after that
+
+func NewWebsocketApp(userApp UserAppInterface, authApp AuthAppInterface, broadcaster repository.BroadcasterInterface,
+	outboxRepo repository.OutboxRepositoryInterface, presenceRepo repository.PresenceRepositoryInterface) *WebsocketApp {
+	websocketApp := &WebsocketApp{
+		connections:  make(map[int]*websocketInfo),
+		userApp:      userApp,
+		authApp:      authApp,
+		broadcaster:  broadcaster,
+		outboxRepo:   outboxRepo,
+		presenceRepo: presenceRepo,
 	}
+	broadcaster.Subscribe(websocketApp.deliverMessage)
+	broadcaster.SubscribeControl(websocketApp.handleControlEvent)
+	go websocketApp.refreshPresence()
+	return websocketApp
 }
 
 type WebsocketAppInterface interface {
-	ChangeClient(userID int, client *websocket.Conn) error // Switches client  that was assigned to user
-	GetClient(userID int) (*websocket.Conn, error)         // Get user's client
-	ChangeToken(userID int, csrfToken string) error        // Change user's CRSF token
-	CheckToken(userID int, csrfToken string) error         // Check if passed token is correct (nil on success)
-	SendMessage(userID int, message []byte) error          // Send message to specified user (concurrency-safe)
-	SendMessages(userID int, messages [][]byte) error      // Send messages to specified user (concurrency-safe)
+	AddClient(userID int, sessionCookie *http.Cookie, csrfToken string, client *websocket.Conn) error // Add one more client to user's clients
+	GetClients(userID int) ([]*websocket.Conn, error)                                                 // Get all of user's clients
+	AddToken(userID int, csrfToken string) error                                                      // Allow user to connect using passed CRSF token
+	CheckToken(userID int, csrfToken string) error                                                    // Check if passed token is correct (nil on success)
+	CheckSessions(userID int) error                                                                   // Disconnect user's clients whose sessions were logged out or expired
+	CheckClientSession(userID int, client *websocket.Conn) error                                      // Disconnect passed client if its session was logged out or expired
+	EndSessions(userID int) error                                                                     // Disconnect user's clients whose sessions were logged out on every server instance, without waiting for periodic checks
+	RemoveClient(userID int, client *websocket.Conn) error                                            // Remove passed client from user's clients, closing it
+	SendMessage(userID int, message []byte) error                                                     // Send message to all of specified user's clients on every server instance (concurrency-safe)
+	SendMessages(userID int, messages [][]byte) error                                                 // Send messages to all of specified user's clients on every server instance (concurrency-safe)
+	SendMessageToClient(userID int, client *websocket.Conn, message []byte) error                     // Send message to one of user's clients (concurrency-safe)
+	ReplayMessages(userID int, client *websocket.Conn, lastSequence int) error                        // Send client user's messages it has missed (EventsExpiredError if some of them were removed)
+	GetLastSequence(userID int) (int, error)                                                          // Get sequence number of the last message sent to user, 0 if there were none
+	PublishMessage(userID int, message []byte) error                                                  // Send message to all of specified user's clients without saving it to outbox, for short-lived events like typing
+	GetPresence(userID int) (*entity.Presence, error)                                                 // Get user's online status and last seen time
 }
 
-func (websocketApp *WebsocketApp) ChangeClient(userID int, client *websocket.Conn) error {
+// getOrCreateConnection returns user's connections info, creating it if user exists
+func (websocketApp *WebsocketApp) getOrCreateConnection(userID int) (*websocketInfo, error) {
 	websocketApp.mu.Lock()
 	defer websocketApp.mu.Unlock()
 
@@ -44,19 +90,14 @@ func (websocketApp *WebsocketApp) ChangeClient(userID int, client *websocket.Con
 	if !found {
 		_, err := websocketApp.userApp.GetUser(userID)
 		if err != nil {
-			return entity.UserNotFoundError
+			return nil, entity.UserNotFoundError
 		}
 
-		connection = &websocketInfo{}
-	}
-
-	if connection.client != nil {
-		connection.client.Close()
+		connection = &websocketInfo{clients: make(map[*websocket.Conn]*websocketClient)}
+		websocketApp.connections[userID] = connection
 	}
 
-	connection.client = client
-	websocketApp.connections[userID] = connection
-	return nil
+	return connection, nil
 }
 
 func (websocketApp *WebsocketApp) getConnection(userID int) (*websocketInfo, error) {
@@ -76,58 +117,82 @@ func (websocketApp *WebsocketApp) getConnection(userID int) (*websocketInfo, err
 	return connection, nil
 }
 
-func (websocketApp *WebsocketApp) GetClient(userID int) (*websocket.Conn, error) {
+// getClients returns snapshot of user's clients, so that they could be written to without holding connection's lock
+func (websocketApp *WebsocketApp) getClients(userID int) ([]*websocketClient, error) {
 	connection, err := websocketApp.getConnection(userID)
 	if err != nil {
 		return nil, err
 	}
 
-	return connection.client, nil
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+
+	if len(connection.clients) == 0 {
+		return nil, entity.ClientNotSetError
+	}
+
+	clients := make([]*websocketClient, 0, len(connection.clients))
+	for _, client := range connection.clients {
+		clients = append(clients, client)
+	}
+
+	return clients, nil
 }
 
-func (websocketApp *WebsocketApp) ChangeToken(userID int, csrfToken string) error {
-	connection, err := websocketApp.getConnection(userID)
+func (websocketApp *WebsocketApp) AddClient(userID int, sessionCookie *http.Cookie, csrfToken string, client *websocket.Conn) error {
+	connection, err := websocketApp.getOrCreateConnection(userID)
 	if err != nil {
-		switch err {
-		case entity.ClientNotSetError:
-			connection = &websocketInfo{}
-		default:
-			return err
-		}
+		return err
 	}
 
-	connection.csrfToken = csrfToken
-	websocketApp.mu.Lock()
-	websocketApp.connections[userID] = connection
-	websocketApp.mu.Unlock()
+	newClient := &websocketClient{
+		conn:          client,
+		csrfToken:     csrfToken,
+		sessionCookie: sessionCookie,
+		queue:         make(chan []byte, clientQueueSize),
+		removed:       make(chan struct{}),
+	}
+	connection.mu.Lock()
+	connection.clients[client] = newClient
+	connection.mu.Unlock()
+
+	go websocketApp.writeQueuedMessages(userID, newClient)
+
+	websocketApp.presenceRepo.SetOnline([]int{userID}, presenceTTL) // Presence is not worth rejecting client over
 	return nil
 }
 
-func (websocketApp *WebsocketApp) CheckToken(userID int, csrfToken string) error {
-	connection, err := websocketApp.getConnection(userID)
+func (websocketApp *WebsocketApp) GetClients(userID int) ([]*websocket.Conn, error) {
+	clients, err := websocketApp.getClients(userID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if connection.csrfToken != csrfToken {
-		return fmt.Errorf("Incorrect CSRF token")
+	conns := make([]*websocket.Conn, 0, len(clients))
+	for _, client := range clients {
+		conns = append(conns, client.conn)
 	}
 
-	return nil
+	return conns, nil
 }
 
-func sendMessage(client *websocket.Conn, message []byte) error { // Is not safe for concurrent use
-	w, err := client.NextWriter(websocket.TextMessage)
+func (websocketApp *WebsocketApp) AddToken(userID int, csrfToken string) error {
+	connection, err := websocketApp.getOrCreateConnection(userID)
 	if err != nil {
-		return fmt.Errorf("Could not start writing, %s", err)
+		return err
 	}
 
-	w.Write(message)
-	w.Close()
+	connection.mu.Lock()
+	defer connection.mu.Unlock()
+
+	connection.csrfTokens = append(connection.csrfTokens, csrfToken)
+	if len(connection.csrfTokens) > maxCSRFTokensPerUser {
+		connection.csrfTokens = connection.csrfTokens[len(connection.csrfTokens)-maxCSRFTokensPerUser:]
+	}
 	return nil
 }
 
-func (websocketApp *WebsocketApp) SendMessage(userID int, message []byte) error {
+func (websocketApp *WebsocketApp) CheckToken(userID int, csrfToken string) error {
 	connection, err := websocketApp.getConnection(userID)
 	if err != nil {
 		return err
@@ -136,37 +201,305 @@ func (websocketApp *WebsocketApp) SendMessage(userID int, message []byte) error
 	connection.mu.Lock()
 	defer connection.mu.Unlock()
 
-	if connection.client == nil {
-		return entity.ClientNotSetError
+	for _, token := range connection.csrfTokens {
+		if token == csrfToken {
+			return nil
+		}
 	}
 
-	err = sendMessage(connection.client, message)
+	return fmt.Errorf("Incorrect CSRF token")
+}
+
+func (websocketApp *WebsocketApp) CheckSessions(userID int) error {
+	clients, err := websocketApp.getClients(userID)
 	if err != nil {
 		return err
 	}
 
+	for _, client := range clients {
+		websocketApp.checkClientSession(userID, client)
+	}
+
 	return nil
 }
 
-func (websocketApp *WebsocketApp) SendMessages(userID int, messages [][]byte) error {
+func (websocketApp *WebsocketApp) CheckClientSession(userID int, client *websocket.Conn) error {
 	connection, err := websocketApp.getConnection(userID)
 	if err != nil {
 		return err
 	}
 
 	connection.mu.Lock()
-	defer connection.mu.Unlock()
+	websocketClient, found := connection.clients[client]
+	connection.mu.Unlock()
+	if !found {
+		return entity.ClientNotSetError
+	}
+
+	websocketApp.checkClientSession(userID, websocketClient)
+	return nil
+}
 
-	if connection.client == nil {
+// checkClientSession removes client if session it was authenticated with has ended
+func (websocketApp *WebsocketApp) checkClientSession(userID int, client *websocketClient) {
+	_, found := websocketApp.authApp.CheckCookie(client.sessionCookie)
+	if !found {
+		client.conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.ClosePolicyViolation, entity.UserNotLoggedInError.Error()),
+			time.Now().Add(time.Second))
+		websocketApp.RemoveClient(userID, client.conn)
+	}
+}
+
+func (websocketApp *WebsocketApp) EndSessions(userID int) error {
+	return websocketApp.broadcaster.PublishControl(userID, entity.SessionsEndedControlEvent)
+}
+
+func (websocketApp *WebsocketApp) RemoveClient(userID int, client *websocket.Conn) error {
+	connection, err := websocketApp.getConnection(userID)
+	if err != nil {
+		return err
+	}
+
+	connection.mu.Lock()
+	websocketClient, found := connection.clients[client]
+	if !found {
+		connection.mu.Unlock()
 		return entity.ClientNotSetError
 	}
 
+	client.Close()
+	delete(connection.clients, client)
+	clientsLeft := len(connection.clients)
+	connection.mu.Unlock()
+
+	websocketClient.removeOnce.Do(func() {
+		close(websocketClient.removed)
+	})
+
+	if clientsLeft == 0 {
+		websocketApp.presenceRepo.SetLastSeen(userID)
+	}
+	return nil
+}
+
+const writeWait = 10 * time.Second // Time allowed to write a message to client
+
+func sendMessage(client *websocket.Conn, message []byte) error { // Is not safe for concurrent use
+	client.SetWriteDeadline(time.Now().Add(writeWait))
+	w, err := client.NextWriter(websocket.TextMessage)
+	if err != nil {
+		return fmt.Errorf("Could not start writing, %s", err)
+	}
+
+	w.Write(message)
+	return w.Close()
+}
+
+// sendToClient writes messages to client, removing it from user's clients if it is dead
+func (websocketApp *WebsocketApp) sendToClient(userID int, client *websocketClient, messages [][]byte) error {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+
 	for _, message := range messages {
-		err := sendMessage(connection.client, message)
+		err := sendMessage(client.conn, message)
 		if err != nil {
+			websocketApp.RemoveClient(userID, client.conn)
 			return err
 		}
 	}
 
 	return nil
 }
+
+func (websocketApp *WebsocketApp) SendMessage(userID int, message []byte) error {
+	return websocketApp.SendMessages(userID, [][]byte{message})
+}
+
+// SendMessages saves messages to user's outbox, so that clients which are offline now could get them later,
+// and publishes them with their sequence numbers
+func (websocketApp *WebsocketApp) SendMessages(userID int, messages [][]byte) error {
+	sequencedMessages := make([][]byte, 0, len(messages))
+	for _, message := range messages {
+		sequence, err := websocketApp.outboxRepo.AddEvent(userID, message)
+		if err != nil {
+			return err
+		}
+
+		sequencedMessages = append(sequencedMessages, addSequence(message, sequence))
+	}
+
+	return websocketApp.broadcaster.Publish(userID, sequencedMessages)
+}
+
+// addSequence adds "sequence" field to message's JSON object
+func addSequence(message []byte, sequence int) []byte {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(message, &fields)
+	if err != nil { // Message is not an object, so it is sent as is
+		return message
+	}
+
+	fields["sequence"], _ = json.Marshal(sequence)
+	result, err := json.Marshal(fields)
+	if err != nil {
+		return message
+	}
+
+	return result
+}
+
+// deliverMessage is called by broadcaster for every published message
+// It queues message for those of user's clients which are connected to this server instance,
+// so that one slow client does not hold up delivery to everyone else
+func (websocketApp *WebsocketApp) deliverMessage(userID int, message []byte) {
+	websocketApp.mu.Lock()
+	_, found := websocketApp.connections[userID]
+	websocketApp.mu.Unlock()
+	if !found { // User is not connected to this instance, there is no need to check if they exist
+		return
+	}
+
+	clients, err := websocketApp.getClients(userID)
+	if err != nil {
+		return
+	}
+
+	for _, client := range clients {
+		websocketApp.queueMessage(userID, client, message)
+	}
+}
+
+// handleControlEvent is called by broadcaster for every published control event
+func (websocketApp *WebsocketApp) handleControlEvent(userID int, event entity.ControlEvent) {
+	websocketApp.mu.Lock()
+	_, found := websocketApp.connections[userID]
+	websocketApp.mu.Unlock()
+	if !found {
+		return
+	}
+
+	switch event {
+	case entity.SessionsEndedControlEvent:
+		go websocketApp.CheckSessions(userID) // Checking sessions takes requests to auth service, and handler must not block
+	}
+}
+
+// queueMessage passes message to client's writer goroutine without blocking
+// Client whose queue is full is disconnected, it gets missed messages from outbox when it reconnects
+func (websocketApp *WebsocketApp) queueMessage(userID int, client *websocketClient, message []byte) {
+	select {
+	case client.queue <- message:
+	case <-client.removed:
+	default:
+		websocketApp.RemoveClient(userID, client.conn)
+	}
+}
+
+// writeQueuedMessages writes messages queued for client until it is removed
+func (websocketApp *WebsocketApp) writeQueuedMessages(userID int, client *websocketClient) {
+	for {
+		select {
+		case <-client.removed:
+			return
+		case message := <-client.queue:
+			err := websocketApp.sendToClient(userID, client, [][]byte{message})
+			if err != nil { // Dead client was already removed
+				return
+			}
+		}
+	}
+}
+
+func (websocketApp *WebsocketApp) SendMessageToClient(userID int, client *websocket.Conn, message []byte) error {
+	connection, err := websocketApp.getConnection(userID)
+	if err != nil {
+		return err
+	}
+
+	connection.mu.Lock()
+	websocketClient, found := connection.clients[client]
+	connection.mu.Unlock()
+	if !found {
+		return entity.ClientNotSetError
+	}
+
+	return websocketApp.sendToClient(userID, websocketClient, [][]byte{message})
+}
+
+// ReplayMessages sends messages from user's outbox to one of their clients
+// Messages sent while replay is in progress may reach client twice, client is expected to skip already seen sequence numbers
+func (websocketApp *WebsocketApp) ReplayMessages(userID int, client *websocket.Conn, lastSequence int) error {
+	connection, err := websocketApp.getConnection(userID)
+	if err != nil {
+		return err
+	}
+
+	connection.mu.Lock()
+	websocketClient, found := connection.clients[client]
+	connection.mu.Unlock()
+	if !found {
+		return entity.ClientNotSetError
+	}
+
+	events, err := websocketApp.outboxRepo.GetEvents(userID, lastSequence)
+	if err != nil {
+		return err
+	}
+
+	messages := make([][]byte, 0, len(events))
+	for _, event := range events {
+		messages = append(messages, addSequence(event.Payload, event.Sequence))
+	}
+
+	return websocketApp.sendToClient(userID, websocketClient, messages)
+}
+
+func (websocketApp *WebsocketApp) GetLastSequence(userID int) (int, error) {
+	return websocketApp.outboxRepo.GetLastSequence(userID)
+}
+
+func (websocketApp *WebsocketApp) PublishMessage(userID int, message []byte) error {
+	return websocketApp.broadcaster.Publish(userID, [][]byte{message})
+}
+
+// GetPresence combines user's presence saved by all server instances with their clients connected to this one
+func (websocketApp *WebsocketApp) GetPresence(userID int) (*entity.Presence, error) {
+	presence, err := websocketApp.presenceRepo.GetPresence(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = websocketApp.getClients(userID)
+	if err == nil {
+		presence.IsOnline = true
+	}
+
+	return presence, nil
+}
+
+// refreshPresence keeps marking users with live clients on this instance as online
+func (websocketApp *WebsocketApp) refreshPresence() {
+	ticker := time.NewTicker(presenceRefreshPeriod)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		websocketApp.mu.Lock()
+		connections := make(map[int]*websocketInfo, len(websocketApp.connections))
+		for userID, connection := range websocketApp.connections {
+			connections[userID] = connection
+		}
+		websocketApp.mu.Unlock()
+
+		onlineUserIDs := make([]int, 0, len(connections))
+		for userID, connection := range connections {
+			connection.mu.Lock()
+			if len(connection.clients) > 0 {
+				onlineUserIDs = append(onlineUserIDs, userID)
+			}
+			connection.mu.Unlock()
+		}
+
+		websocketApp.presenceRepo.SetOnline(onlineUserIDs, presenceTTL)
+	}
+}
@@ -0,0 +1,189 @@
+package application
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"pinterest/application/mock_application"
+	"pinterest/domain/entity"
+	"pinterest/domain/repository/mock_repository"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+type websocketTestMocks struct {
+	outboxRepo  *mock_repository.MockOutboxRepositoryInterface
+	broadcaster *mock_repository.MockBroadcasterInterface
+	authApp     *mock_application.MockAuthAppInterface
+}
+
+// newTestWebsocketApp creates WebsocketApp with mocked repositories, in which user with passed ID exists
+func newTestWebsocketApp(mockCtrl *gomock.Controller, userID int) (*WebsocketApp, websocketTestMocks) {
+	mockUserApp := mock_application.NewMockUserAppInterface(mockCtrl)
+	mocks := websocketTestMocks{
+		outboxRepo:  mock_repository.NewMockOutboxRepositoryInterface(mockCtrl),
+		broadcaster: mock_repository.NewMockBroadcasterInterface(mockCtrl),
+		authApp:     mock_application.NewMockAuthAppInterface(mockCtrl),
+	}
+	mockPresenceRepo := mock_repository.NewMockPresenceRepositoryInterface(mockCtrl)
+
+	mockUserApp.EXPECT().GetUser(userID).Return(&entity.User{UserID: userID}, nil).AnyTimes()
+	mocks.broadcaster.EXPECT().Subscribe(gomock.Any()).Times(1)
+	mocks.broadcaster.EXPECT().SubscribeControl(gomock.Any()).Times(1)
+	mockPresenceRepo.EXPECT().SetOnline(gomock.Any(), presenceTTL).Return(nil).AnyTimes() // Presence is not checked here
+	mockPresenceRepo.EXPECT().SetLastSeen(userID).Return(nil).AnyTimes()
+
+	return NewWebsocketApp(mockUserApp, mocks.authApp, mocks.broadcaster, mocks.outboxRepo, mockPresenceRepo), mocks
+}
+
+// connectTestClient connects client to test server and adds server's end of connection to user's clients
+// It returns both ends of connection
+func connectTestClient(t *testing.T, websocketApp *WebsocketApp, userID int) (*websocket.Conn, *websocket.Conn) {
+	serverConns := make(chan *websocket.Conn, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		serverConns <- conn
+	}))
+	t.Cleanup(server.Close)
+
+	clientConn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { clientConn.Close() })
+
+	serverConn := <-serverConns
+	err = websocketApp.AddClient(userID, nil, "token", serverConn)
+	require.NoError(t, err)
+	return clientConn, serverConn
+}
+
+// readTestMessages reads amount of messages from client, failing if they don't come in time
+func readTestMessages(t *testing.T, clientConn *websocket.Conn, amount int) []string {
+	messages := make([]string, 0, amount)
+	clientConn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for i := 0; i < amount; i++ {
+		_, message, err := clientConn.ReadMessage()
+		require.NoError(t, err)
+		messages = append(messages, string(message))
+	}
+	return messages
+}
+
+func TestReplayMessages(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	const userID = 1
+	websocketApp, mocks := newTestWebsocketApp(mockCtrl, userID)
+	clientConn, serverConn := connectTestClient(t, websocketApp, userID)
+
+	mocks.outboxRepo.EXPECT().GetEvents(userID, 2).Return([]*entity.OutboxEvent{
+		{UserID: userID, Sequence: 3, Payload: []byte(`{"type":"message"}`)},
+		{UserID: userID, Sequence: 4, Payload: []byte(`{"type":"chat"}`)},
+	}, nil).Times(1)
+
+	err := websocketApp.ReplayMessages(userID, serverConn, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{`{"sequence":3,"type":"message"}`, `{"sequence":4,"type":"chat"}`},
+		readTestMessages(t, clientConn, 2), "Missed messages should be sent in order with their sequence numbers")
+
+	mocks.outboxRepo.EXPECT().GetEvents(userID, 0).Return(nil, entity.EventsExpiredError).Times(1)
+
+	err = websocketApp.ReplayMessages(userID, serverConn, 0)
+	require.Equal(t, entity.EventsExpiredError, err, "Client should be told to resync if its messages expired")
+
+	err = websocketApp.RemoveClient(userID, serverConn)
+	require.NoError(t, err)
+
+	err = websocketApp.ReplayMessages(userID, serverConn, 4)
+	require.Equal(t, entity.ClientNotSetError, err, "Removed client should not get any messages")
+}
+
+func TestSendMessages(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	const userID = 1
+	websocketApp, mocks := newTestWebsocketApp(mockCtrl, userID)
+	clientConn, _ := connectTestClient(t, websocketApp, userID)
+
+	gomock.InOrder(
+		mocks.outboxRepo.EXPECT().AddEvent(userID, []byte(`{"type":"message"}`)).Return(7, nil),
+		mocks.outboxRepo.EXPECT().AddEvent(userID, []byte(`{"type":"chat"}`)).Return(8, nil),
+	)
+	sequencedMessages := [][]byte{[]byte(`{"sequence":7,"type":"message"}`), []byte(`{"sequence":8,"type":"chat"}`)}
+	mocks.broadcaster.EXPECT().Publish(userID, sequencedMessages).DoAndReturn(
+		func(userID int, messages [][]byte) error {
+			for _, message := range messages { // Broadcaster passes messages back to every instance, this one included
+				websocketApp.deliverMessage(userID, message)
+			}
+			return nil
+		}).Times(1)
+
+	err := websocketApp.SendMessages(userID, [][]byte{[]byte(`{"type":"message"}`), []byte(`{"type":"chat"}`)})
+	require.NoError(t, err)
+	require.Equal(t, []string{`{"sequence":7,"type":"message"}`, `{"sequence":8,"type":"chat"}`},
+		readTestMessages(t, clientConn, 2), "Published messages should reach user's client")
+}
+
+func TestSlowClientDoesNotBlockOthers(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	const userID = 1
+	websocketApp, _ := newTestWebsocketApp(mockCtrl, userID)
+	_, slowConn := connectTestClient(t, websocketApp, userID) // Is never read, so writes to it get stuck once buffers fill
+	clientConn, _ := connectTestClient(t, websocketApp, userID)
+	defer websocketApp.RemoveClient(userID, slowConn)
+
+	const messagesAmount = clientQueueSize - 1 // Slow client must not be disconnected for overflowing its queue
+	message := []byte(`"` + strings.Repeat("a", 64*1024) + `"`)
+
+	delivered := make(chan struct{})
+	go func() {
+		for i := 0; i < messagesAmount; i++ {
+			websocketApp.deliverMessage(userID, message)
+		}
+		close(delivered)
+	}()
+
+	select {
+	case <-delivered:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Delivery should not wait for slow client")
+	}
+
+	messages := readTestMessages(t, clientConn, messagesAmount)
+	require.Len(t, messages, messagesAmount, "Other clients should get all messages while slow client is stuck")
+
+	clients, err := websocketApp.GetClients(userID)
+	require.NoError(t, err)
+	require.Len(t, clients, 2, "Slow client should be kept while its queue has room")
+}
+
+func TestCheckClientSession(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	const userID = 1
+	websocketApp, mocks := newTestWebsocketApp(mockCtrl, userID)
+	_, loggedOutConn := connectTestClient(t, websocketApp, userID)
+	_, otherConn := connectTestClient(t, websocketApp, userID)
+
+	mocks.authApp.EXPECT().CheckCookie(gomock.Any()).Return(nil, false).Times(1) // Only checked client's session is checked
+
+	err := websocketApp.CheckClientSession(userID, loggedOutConn)
+	require.NoError(t, err)
+
+	clients, err := websocketApp.GetClients(userID)
+	require.NoError(t, err)
+	require.Equal(t, []*websocket.Conn{otherConn}, clients, "Only client of ended session should be disconnected")
+
+	err = websocketApp.CheckClientSession(userID, loggedOutConn)
+	require.Equal(t, entity.ClientNotSetError, err, "Removed client should not be checked again")
+}
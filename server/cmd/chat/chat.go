@@ -1 +1,68 @@
-package chat
+package main
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"os"
+	chatService "pinterest/services/chat"
+	chatProto "pinterest/services/chat/proto"
+
+	"github.com/joho/godotenv"
+	"github.com/tarantool/go-tarantool"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+func runService(addr string) {
+	logger, _ := zap.NewDevelopment()
+	defer logger.Sync()
+
+	sugarLogger := logger.Sugar()
+
+	err := godotenv.Load(".env")
+	if err != nil {
+		sugarLogger.Fatal("Could not load .env file", zap.String("error", err.Error()))
+	}
+
+	err = godotenv.Load("docker_vars.env")
+	if err != nil {
+		sugarLogger.Fatal("Could not load docker_vars.env file", zap.String("error", err.Error()))
+	}
+
+	dockerStatus := os.Getenv("CONTAINER_PREFIX")
+	if dockerStatus != "DOCKER" && dockerStatus != "LOCALHOST" {
+		sugarLogger.Fatalf("Wrong prefix: %s , should be DOCKER or LOCALHOST", dockerStatus)
+	}
+
+	tarantoolConn, err := tarantool.Connect(os.Getenv(dockerStatus+"_TARANTOOL_PREFIX")+":3301", tarantool.Opts{
+		User: os.Getenv("TARANTOOL_USER"),
+		Pass: os.Getenv("TARANTOOL_PASSWORD"),
+	})
+	if err != nil {
+		sugarLogger.Fatal("Could not connect to tarantool database", zap.String("error", err.Error()))
+	}
+
+	fmt.Println("Successfully connected to tarantool database")
+	defer tarantoolConn.Close()
+
+	server := grpc.NewServer()
+
+	service := chatService.NewService(tarantoolConn)
+	chatProto.RegisterChatsServer(server, service)
+
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalln("Listen chat error: ", err)
+	}
+
+	fmt.Printf("Starting server at localhost%s\n", addr)
+	err = server.Serve(lis)
+	if err != nil {
+		log.Fatalln("Serve chat error: ", err)
+	}
+}
+
+func main() {
+	runService(":8086")
+}
@@ -1,14 +1,98 @@
 package entity
 
+import "github.com/asaskevich/govalidator"
+
 type Board struct {
-	BoardID       int    `json:"ID"`
-	UserID        int    `json:"userID"`
-	Title         string `json:"title"`
-	Description   string `json:"description"`
-	ImageLink     string `json:"avatarLink"`
-	ImageHeight   int    `json:"avatarHeight"`
-	ImageWidth    int    `json:"avatarWidth"`
-	ImageAvgColor string `json:"avatarAvgColor"`
+	BoardID        int    `json:"ID"`
+	UserID         int    `json:"userID"`
+	Title          string `json:"title"`
+	Description    string `json:"description"`
+	ImageLink      string `json:"avatarLink"`
+	ImageHeight    int    `json:"avatarHeight"`
+	ImageWidth     int    `json:"avatarWidth"`
+	ImageAvgColor  string `json:"avatarAvgColor"`
+	CoverPinID     int    `json:"coverPinID"` // 0 if board's last pin is used as cover
+	IsArchived     bool   `json:"isArchived"`
+	IsSecret       bool   `json:"isSecret"`      // Secret boards are seen only by their owner and collaborators
+	LayoutVersion  int    `json:"layoutVersion"` // Changes every time board's pins, sections or their order are changed
+	FollowersCount int    `json:"followersCount"`
+}
+
+// BoardCollaborator describes user who was invited to someone else's board
+type BoardCollaborator struct {
+	UserID     int    `json:"userID"`
+	Username   string `json:"username"`
+	AvatarLink string `json:"avatarLink"`
+	Role       string `json:"role"` // "editor" can add and remove pins, "viewer" can only see secret board
+}
+
+// BoardInvitationInput is used when parsing JSON in collaborator invitation handler
+type BoardInvitationInput struct {
+	UserID int    `json:"userID"`
+	Role   string `json:"role"`
+}
+
+// BoardInvitation describes board which user was invited to, but has not joined yet
+type BoardInvitation struct {
+	Board Board  `json:"board"`
+	Role  string `json:"role"`
+}
+
+type BoardInvitationsOutput struct {
+	Invitations []BoardInvitation `json:"invitations"`
+}
+
+// BoardDetailsOutput is used to marshal JSON with board, its sections and users who accepted invitation to it
+type BoardDetailsOutput struct {
+	Board
+	Sections     []BoardSection      `json:"sections"`
+	Contributors []BoardCollaborator `json:"contributors"`
+}
+
+// BoardSection is named group of pins inside board
+type BoardSection struct {
+	SectionID int    `json:"ID"`
+	Title     string `json:"title"`
+}
+
+// BoardSectionInput is used when parsing JSON in section creation and renaming handlers
+type BoardSectionInput struct {
+	Title string `json:"title" valid:"required,stringlength(1|100)"`
+}
+
+type BoardSectionID struct {
+	SectionID int `json:"ID"`
+}
+
+// PinMoveInput is used when parsing JSON in pin moving handler
+type PinMoveInput struct {
+	SectionID int `json:"sectionID"` // 0 to take pin out of any section
+}
+
+// PinPlacement describes pin's section, pin's position is its index in BoardOrderInput.Pins
+type PinPlacement struct {
+	PinID     int `json:"pinID"`
+	SectionID int `json:"sectionID"` // 0 if pin is not in any section
+}
+
+// BoardOrderInput is used when parsing JSON in board reordering handler
+type BoardOrderInput struct {
+	LayoutVersion int            `json:"layoutVersion"` // Board's layout version which order is based on
+	Pins          []PinPlacement `json:"pins"`
+	SectionIDs    []int          `json:"sections"`
+}
+
+type LayoutVersionOutput struct {
+	LayoutVersion int `json:"layoutVersion"`
+}
+
+// BoardEditInput is used when parsing JSON in board edit handler
+type BoardEditInput struct {
+	Title       string `json:"title" valid:"required,stringlength(1|100)"`
+	Description string `json:"description" valid:"stringlength(0|1000),optional"`
+	CoverPinID  int    `json:"coverPinID"` // 0 to use board's last pin as cover
+	IsArchived  bool   `json:"isArchived"`
+	IsSecret    bool   `json:"isSecret"`
 }
 
 type BoardsOutput struct {
@@ -18,3 +102,17 @@ type BoardsOutput struct {
 type BoardID struct {
 	BoardID int `json:"ID"`
 }
+
+// Validate validates BoardSectionInput struct according to following rules:
+// Title - 1-100 characters
+func (sectionInput *BoardSectionInput) Validate() (bool, error) {
+	return govalidator.ValidateStruct(*sectionInput)
+}
+
+// Validate validates BoardEditInput struct according to following rules:
+// Title - 1-100 characters
+// Description - up to 1000 characters, may be empty
+// Cover pin's presence on the board is NOT checked
+func (boardInput *BoardEditInput) Validate() (bool, error) {
+	return govalidator.ValidateStruct(*boardInput)
+}
@@ -1,53 +1,179 @@
 package entity
 
+import (
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
 type Message struct {
-	MessageID      int    `json:"ID"`
-	ChatID         int    `json:"chatID"`
-	AuthorID       int    `json:"authorID"`
-	Text           string `json:"text"`
-	TimeOfCreation string `json:"addingTime"`
+	MessageID      int                  `json:"ID"`
+	ChatID         int                  `json:"chatID"`
+	AuthorID       int                  `json:"authorID"`
+	Text           string               `json:"text"`
+	TimeOfCreation string               `json:"addingTime"`
+	CreationDate   time.Time            `json:"-"`                  // Used to check if message can still be edited or deleted
+	EditDate       *time.Time           `json:"editDate,omitempty"` // Is set only for edited messages
+	IsDeleted      bool                 `json:"isDeleted"`          // Deleted messages are kept as tombstones without text
+	Attachments    []*MessageAttachment `json:"attachments,omitempty"`
+}
+
+// MessageAttachment references pin or board shared in message
+// Only type and ID are stored, preview is filled when message is sent, so that it is always up to date
+type MessageAttachment struct {
+	Type        string             `json:"type"` // Either "pin" or "board"
+	ID          int                `json:"ID"`
+	IsAvailable bool               `json:"isAvailable"`       // False if pin or board was deleted
+	Preview     *AttachmentPreview `json:"preview,omitempty"` // Is set only for available attachments
+}
+
+type AttachmentPreview struct {
+	Title         string `json:"title"`
+	ImageLink     string `json:"imageLink"`
+	ImageHeight   int    `json:"imageHeight"`
+	ImageWidth    int    `json:"imageWidth"`
+	ImageAvgColor string `json:"imageAvgColor"`
+}
+
+// FillFromPin fills preview with pin's title and image
+func (preview *AttachmentPreview) FillFromPin(pin *Pin) {
+	preview.Title = pin.Title
+	preview.ImageLink = pin.ImageLink
+	preview.ImageHeight = pin.ImageHeight
+	preview.ImageWidth = pin.ImageWidth
+	preview.ImageAvgColor = pin.ImageAvgColor
+}
+
+// FillFromBoard fills preview with board's title and avatar
+func (preview *AttachmentPreview) FillFromBoard(board *Board) {
+	preview.Title = board.Title
+	preview.ImageLink = board.ImageLink
+	preview.ImageHeight = board.ImageHeight
+	preview.ImageWidth = board.ImageWidth
+	preview.ImageAvgColor = board.ImageAvgColor
 }
 
 type MessageInput struct {
-	MessageText string `json:"messageText"`
+	MessageText string                   `json:"messageText"`
+	Attachments []MessageAttachmentInput `json:"attachments"`
+}
+
+type MessageAttachmentInput struct {
+	Type string `json:"type"`
+	ID   int    `json:"ID"`
 }
 
 type Chat struct {
-	ChatID         int
-	FirstUserID    int
-	SecondUserID   int
-	FirstUserRead  bool
-	SecondUserRead bool
+	ChatID     int
+	Type       string // Either "direct" (between two users) or "group"
+	Title      string // Only group chats have title and avatar
+	AvatarLink string
+	Members    []*ChatMember
+}
+
+type ChatMember struct {
+	UserID                 int
+	Role                   string // "owner", "admin" or "member", direct chats have only members
+	LastReadMessageID      int    // Messages with greater IDs are not read by this member yet
+	LastDeliveredMessageID int    // Messages with greater IDs have not reached any of this member's clients yet
+}
+
+// GetMember returns member with specified user ID, nil if user is not in chat
+func (chat *Chat) GetMember(userID int) *ChatMember {
+	for _, member := range chat.Members {
+		if member.UserID == userID {
+			return member
+		}
+	}
+	return nil
+}
+
+// CreateChatInput is used when creating group chat
+type CreateChatInput struct {
+	Title     string `json:"title"`
+	MemberIDs []int  `json:"memberIDs"` // Creator is added automatically
+}
+
+type UpdateChatInput struct {
+	Title string `json:"title"`
+}
+
+type ChatIDOutput struct {
+	ChatID int `json:"ID"`
+}
+
+type ChatMemberInput struct {
+	UserID int `json:"userID"`
+}
+
+type ChatRoleInput struct {
+	Role string `json:"role"`
+}
+
+type ChatMemberOutput struct {
+	Profile                UserOutput `json:"profile"`
+	Role                   string     `json:"role"`
+	LastReadMessageID      int        `json:"lastReadMessageID"`
+	LastDeliveredMessageID int        `json:"lastDeliveredMessageID"`
 }
 
 type ChatOutput struct {
-	ChatID        int        `json:"ID"`
-	TargetProfile UserOutput `json:"targetProfile"`
-	Messages      []Message  `json:"messages"`
-	IsRead        bool       `json:"isRead"`
+	ChatID        int                `json:"ID"`
+	Type          string             `json:"chatType"`
+	Title         string             `json:"title,omitempty"`
+	AvatarLink    string             `json:"avatarLink,omitempty"`
+	TargetProfile *UserOutput        `json:"targetProfile,omitempty"` // Only in direct chats
+	Members       []ChatMemberOutput `json:"members"`
+	LastMessage   *Message           `json:"lastMessage,omitempty"` // Older messages are fetched page by page
+	UnreadCount   int                `json:"unreadCount"`
+	IsRead        bool               `json:"isRead"`
 }
 
-// FillFromChat fills ChatOutput from Chat
-func (output *ChatOutput) FillFromChat(chat *Chat, target *User, messages []*Message) {
+// FillFromChat fills ChatOutput from Chat, as it is seen by user with passed ID
+// profiles should contain all of chat's members, lastMessage is nil for empty chats
+func (output *ChatOutput) FillFromChat(chat *Chat, userID int, profiles map[int]*User, lastMessage *Message, unreadCount int) {
 	output.ChatID = chat.ChatID
-	output.Messages = make([]Message, 0, len(messages))
-	for _, message := range messages {
-		output.Messages = append(output.Messages, *message)
-	}
+	output.Type = chat.Type
+	output.Title = chat.Title
+	output.AvatarLink = chat.AvatarLink
+	output.LastMessage = lastMessage
+	output.UnreadCount = unreadCount
+	output.IsRead = unreadCount == 0
+
+	output.Members = make([]ChatMemberOutput, 0, len(chat.Members))
+	for _, member := range chat.Members {
+		profile, found := profiles[member.UserID]
+		if !found {
+			continue
+		}
 
-	var targetProfileOutput UserOutput
-	targetProfileOutput.FillFromUser(target)
-	targetProfileOutput.Email = ""
-	output.TargetProfile = targetProfileOutput
+		var memberOutput ChatMemberOutput
+		memberOutput.Profile.FillFromUser(profile)
+		memberOutput.Profile.Email = ""
+		memberOutput.Role = member.Role
+		memberOutput.LastReadMessageID = member.LastReadMessageID
+		memberOutput.LastDeliveredMessageID = member.LastDeliveredMessageID
+		output.Members = append(output.Members, memberOutput)
 
-	switch chat.FirstUserID == target.UserID {
-	case true:
-		output.IsRead = chat.SecondUserRead
-	case false:
-		output.IsRead = chat.FirstUserRead
+		if chat.Type == string(DirectChatTypeKey) && member.UserID != userID {
+			targetProfile := memberOutput.Profile
+			output.TargetProfile = &targetProfile
+		}
 	}
 }
 
+// ChatsListOutput is used to marshal JSON with user's chats
+type ChatsListOutput struct {
+	Chats []ChatOutput `json:"chats"`
+}
+
+// MessagesListOutput is used to marshal JSON with page of chat's messages
+type MessagesListOutput struct {
+	Messages   []Message `json:"messages"`             // Oldest first
+	NextBefore int       `json:"nextBefore,omitempty"` // Pass as "before" to get older messages, is not set if there are none
+}
+
 type AllChatsOutput struct {
 	Type  key          `json:"type"`
 	Chats []ChatOutput `json:"allChats"`
@@ -62,3 +188,102 @@ type OneMessageOutput struct {
 	Type    key     `json:"type"`
 	Message Message `json:"message"`
 }
+
+// ChatRemovedOutput is sent to user who left or was removed from chat
+type ChatRemovedOutput struct {
+	Type   key `json:"type"`
+	ChatID int `json:"chatID"`
+}
+
+// ReceiptOutput is sent to chat's members when one of them receives or reads messages
+// Member has received (or read) every message with ID up to the passed one
+type ReceiptOutput struct {
+	Type                   key `json:"type"`
+	ChatID                 int `json:"chatID"`
+	UserID                 int `json:"userID"`
+	LastDeliveredMessageID int `json:"lastDeliveredMessageID"`
+	LastReadMessageID      int `json:"lastReadMessageID"`
+}
+
+// MessageSearchResult is message that matches search query, along with messages sent right before and after it
+type MessageSearchResult struct {
+	Message    Message         `json:"message"`
+	Highlights []TextHighlight `json:"highlights"` // Parts of message's text that match query
+	Before     []Message       `json:"before"`     // Oldest first
+	After      []Message       `json:"after"`      // Oldest first
+}
+
+// TextHighlight is a part of text, offsets are in characters, not bytes
+type TextHighlight struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
+// MessageSearchOutput is used to marshal JSON with page of search results
+type MessageSearchOutput struct {
+	Results    []MessageSearchResult `json:"results"`              // Newest first
+	NextBefore int                   `json:"nextBefore,omitempty"` // Pass as "before" to get older results, is not set if there are none
+}
+
+const searchMaxWordLength = 32  // In characters, search index keeps only beginnings of longer words
+const searchMinPrefixLength = 3 // In characters, shorter query words match whole words only, same as in search index
+
+// isSearchSeparator tells if character separates words, same as in search index
+// Every non-ASCII character is treated as part of a word
+func isSearchSeparator(char rune) bool {
+	return char < utf8.RuneSelf && !unicode.IsLetter(char) && !unicode.IsDigit(char)
+}
+
+// SplitSearchWords splits text into distinct lowercase words, the same way search index does
+func SplitSearchWords(text string) []string {
+	words := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), isSearchSeparator) {
+		if wordRunes := []rune(word); len(wordRunes) > searchMaxWordLength {
+			word = string(wordRunes[:searchMaxWordLength])
+		}
+		if !seen[word] {
+			seen[word] = true
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// searchTermMatches tells if word matches query's word, short query words have to match it as a whole
+func searchTermMatches(word string, term string) bool {
+	if len([]rune(term)) < searchMinPrefixLength {
+		return word == term
+	}
+	return strings.HasPrefix(word, term)
+}
+
+// FindHighlights returns parts of text that match one of query's words
+func FindHighlights(text string, query string) []TextHighlight {
+	terms := SplitSearchWords(query)
+	highlights := make([]TextHighlight, 0)
+
+	runes := []rune(text)
+	for start := 0; start < len(runes); {
+		if isSearchSeparator(runes[start]) {
+			start++
+			continue
+		}
+
+		end := start
+		for end < len(runes) && !isSearchSeparator(runes[end]) {
+			end++
+		}
+
+		word := strings.ToLower(string(runes[start:end]))
+		for _, term := range terms {
+			if searchTermMatches(word, term) {
+				highlights = append(highlights, TextHighlight{Start: start, Length: len([]rune(term))})
+				break
+			}
+		}
+		start = end
+	}
+
+	return highlights
+}
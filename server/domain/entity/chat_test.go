@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var splitSearchWordsTest = []struct {
+	name          string
+	text          string
+	expectedWords []string
+}{
+	{
+		"Testing words separated by spaces and punctuation",
+		"Hello, world! How-are you?",
+		[]string{"hello", "world", "how", "are", "you"},
+	},
+	{
+		"Testing repeated words in different case",
+		"Cat cat CAT dog",
+		[]string{"cat", "dog"},
+	},
+	{
+		"Testing non-ASCII words",
+		"Привет, мир",
+		[]string{"привет", "мир"},
+	},
+	{
+		"Testing word longer than index keeps",
+		strings.Repeat("a", searchMaxWordLength+10),
+		[]string{strings.Repeat("a", searchMaxWordLength)},
+	},
+	{
+		"Testing text without words",
+		" ,.!? ",
+		[]string{},
+	},
+}
+
+func TestSplitSearchWords(t *testing.T) {
+	for _, tt := range splitSearchWordsTest {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expectedWords, SplitSearchWords(tt.text))
+		})
+	}
+}
+
+var findHighlightsTest = []struct {
+	name               string
+	text               string
+	query              string
+	expectedHighlights []TextHighlight
+}{
+	{
+		"Testing words starting with query's words",
+		"Meeting at the station, meet me there",
+		"meet STAT",
+		[]TextHighlight{{Start: 0, Length: 4}, {Start: 15, Length: 4}, {Start: 24, Length: 4}},
+	},
+	{
+		"Testing short query word matching whole words only",
+		"I am at home, attic is at the top",
+		"at",
+		[]TextHighlight{{Start: 5, Length: 2}, {Start: 23, Length: 2}},
+	},
+	{
+		"Testing non-ASCII text",
+		"Привет, приветствую",
+		"привет",
+		[]TextHighlight{{Start: 0, Length: 6}, {Start: 8, Length: 6}},
+	},
+	{
+		"Testing query not found in text",
+		"Nothing to see here",
+		"missing",
+		[]TextHighlight{},
+	},
+}
+
+func TestFindHighlights(t *testing.T) {
+	for _, tt := range findHighlightsTest {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expectedHighlights, FindHighlights(tt.text, tt.query))
+		})
+	}
+}
@@ -1,15 +1,121 @@
 package entity
 
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+)
+
+// RepliesPreviewSize is how many first replies of each thread are returned with comments page,
+// the rest are fetched page by page from the thread itself
+const RepliesPreviewSize = 3
+
+// MaxMentionsPerComment is how many users at most are notified about being mentioned in one comment
+const MaxMentionsPerComment = 10
+
+// mentionRegexp matches "@username" unless "@" is part of a word, e.g. of an email
+var mentionRegexp = regexp.MustCompile(`(?:^|[^a-zA-Z0-9_@])@([a-zA-Z0-9_]+)`)
+
 type Comment struct {
-	UserID     int    `json:"userID"`
-	PinID      int    `json:"pinID"`
-	PinComment string `json:"text"`
+	CommentID    int        `json:"ID"`
+	UserID       int        `json:"userID"`
+	PinID        int        `json:"pinID"`
+	PinComment   string     `json:"text"`
+	CreationDate time.Time  `json:"creationDate"`
+	EditDate     *time.Time `json:"editDate,omitempty"` // nil if comment was never edited
+	ParentID     int        `json:"parentID,omitempty"` // ID of thread's first comment, 0 if comment is not a reply
+	RepliesCount int        `json:"repliesCount"`       // Number of replies in comment's thread
+	Replies      []Comment  `json:"replies,omitempty"`  // Thread's first replies, oldest first
+	Username     string     `json:"username"`           // Author's username
+	AvatarLink   string     `json:"avatarLink"`         // Author's avatar
 }
 
 type CommentTextOutput struct {
-	Text string `json:"text"`
+	CommentID int    `json:"ID"`
+	Text      string `json:"text"`
+}
+
+// CommentEditInput is used when parsing JSON in comment edit handler
+type CommentEditInput struct {
+	Text string `json:"text" valid:"required"`
 }
 
 type CommentsOutput struct {
-	Comments []Comment `json:"comments"`
-}
\ No newline at end of file
+	Comments   []Comment `json:"comments"`
+	TotalCount int       `json:"totalCount"`           // Amount of all pin's comments, including replies, or of thread's replies
+	NextCursor string    `json:"nextCursor,omitempty"` // Pass as "cursor" to get next page, is not set if there are no more comments
+}
+
+// CommentsPageInput describes requested page of pin's threads
+type CommentsPageInput struct {
+	Sort  string         // "newest", "oldest" or "most-replied"
+	After CommentsCursor // Zero value for the first page
+	Limit int            // Maximum amount of threads, replies are not counted
+}
+
+// RepliesPageInput describes requested page of thread's replies, which are always sorted from oldest
+type RepliesPageInput struct {
+	After CommentsCursor // Zero value for the first page
+	Limit int            // Maximum amount of replies
+}
+
+// CommentsCursor points at the last comment of the page, next page starts right after it
+// Replies count is not a snapshot, so when sorting by replies, threads whose replies count
+// changes between requests may be repeated on the next page or skipped
+type CommentsCursor struct {
+	CommentID    int
+	RepliesCount int // Is needed to continue pages sorted by replies
+}
+
+// String encodes cursor, so that clients don't depend on its format
+func (cursor CommentsCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", cursor.CommentID, cursor.RepliesCount)))
+}
+
+// ParseCommentsCursor decodes cursor made by CommentsCursor.String
+// It returns cursor and nil on success, zero cursor and CommentsCursorError on failure
+func ParseCommentsCursor(encodedCursor string) (CommentsCursor, error) {
+	decodedCursor, err := base64.RawURLEncoding.DecodeString(encodedCursor)
+	if err != nil {
+		return CommentsCursor{}, CommentsCursorError
+	}
+
+	cursor := CommentsCursor{}
+	_, err = fmt.Sscanf(string(decodedCursor), "%d:%d", &cursor.CommentID, &cursor.RepliesCount)
+	if err != nil || cursor.CommentID <= 0 || cursor.RepliesCount < 0 {
+		return CommentsCursor{}, CommentsCursorError
+	}
+	return cursor, nil
+}
+
+// Validate validates CommentEditInput struct according to following rules:
+// Text - not empty
+func (commentInput *CommentEditInput) Validate() (bool, error) {
+	return govalidator.ValidateStruct(*commentInput)
+}
+
+// FindMentions returns usernames mentioned in text as "@username", without duplicates and in order of appearance
+// At most MaxMentionsPerComment usernames are returned
+func FindMentions(text string) []string {
+	usernames := make([]string, 0)
+	mentioned := make(map[string]bool)
+	for _, match := range mentionRegexp.FindAllStringSubmatch(text, -1) {
+		username := match[1]
+		if mentioned[username] {
+			continue
+		}
+		if matched, _ := regexp.MatchString(usernameRegexp, username); !matched {
+			continue
+		}
+
+		mentioned[username] = true
+		usernames = append(usernames, username)
+		if len(usernames) == MaxMentionsPerComment {
+			break
+		}
+	}
+	return usernames
+}
@@ -1,14 +1,18 @@
 package entity
 
 import (
+	"net"
 	"net/http"
+	"strings"
 	"sync"
+	"time"
 )
 
-// CookieInfo contains information about a cookie: which user it belongs to and cookie itself
+// CookieInfo contains information about a cookie: which user it belongs to, cookie itself and session it represents
 type CookieInfo struct {
-	UserID int
-	Cookie *http.Cookie
+	UserID  int
+	Cookie  *http.Cookie
+	Session *Session
 }
 
 // SessionMap is used to keep track of users currently logged in
@@ -16,3 +20,114 @@ type SessionMap struct {
 	Sessions map[string]CookieInfo // key is cookie value, for easier lookup
 	Mu       sync.Mutex
 }
+
+// Session describes one of the devices user is logged in from
+type Session struct {
+	SessionID int       `json:"ID"`
+	Device    string    `json:"device"`
+	IP        string    `json:"IP"`
+	UserAgent string    `json:"userAgent"`
+	Created   time.Time `json:"created"`
+	LastSeen  time.Time `json:"lastSeen"`
+	IsCurrent bool      `json:"isCurrent"` // True if request was made from this session
+}
+
+// SessionsListOutput is used to marshal JSON with user's active sessions
+type SessionsListOutput struct {
+	Sessions []*Session `json:"sessions"`
+}
+
+// NewSessionFromRequest fills session's device information using request's headers
+// Reverse proxy headers are only used if request came from one of trustedProxies
+func NewSessionFromRequest(r *http.Request, trustedProxies []string) *Session {
+	userAgent := r.UserAgent()
+	return &Session{
+		Device:    deviceFromUserAgent(userAgent),
+		IP:        ipFromRequest(r, trustedProxies),
+		UserAgent: userAgent,
+	}
+}
+
+// ipFromRequest returns client's IP, taking headers of trusted reverse proxies into account
+// Anyone can send these headers, so they are ignored if request came from elsewhere
+func ipFromRequest(r *http.Request, trustedProxies []string) string {
+	remoteIP, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		remoteIP = r.RemoteAddr
+	}
+	if !isTrustedProxy(remoteIP, trustedProxies) {
+		return remoteIP
+	}
+
+	if forwardedFor := r.Header.Get("X-Forwarded-For"); forwardedFor != "" {
+		addresses := strings.Split(forwardedFor, ",")
+		for i := len(addresses) - 1; i >= 0; i-- { // Proxies append addresses, so ones before the last untrusted could be forged
+			address := strings.TrimSpace(addresses[i])
+			if !isTrustedProxy(address, trustedProxies) {
+				return address
+			}
+		}
+	}
+	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
+		return realIP
+	}
+
+	return remoteIP
+}
+
+func isTrustedProxy(ip string, trustedProxies []string) bool {
+	for _, proxy := range trustedProxies {
+		if ip == proxy {
+			return true
+		}
+	}
+	return false
+}
+
+var userAgentBrowsers = []struct{ token, name string }{ // Order matters, e.g. Edge's user agent contains "Chrome" too
+	{"YaBrowser", "Yandex Browser"},
+	{"Edg", "Edge"},
+	{"OPR", "Opera"},
+	{"Firefox", "Firefox"},
+	{"Chrome", "Chrome"},
+	{"Safari", "Safari"},
+}
+
+var userAgentSystems = []struct{ token, name string }{
+	{"iPhone", "iPhone"},
+	{"iPad", "iPad"},
+	{"Android", "Android"},
+	{"Windows", "Windows"},
+	{"Macintosh", "macOS"},
+	{"Linux", "Linux"},
+}
+
+// deviceFromUserAgent returns human-readable device label such as "Chrome on Windows"
+func deviceFromUserAgent(userAgent string) string {
+	browser := ""
+	for _, candidate := range userAgentBrowsers {
+		if strings.Contains(userAgent, candidate.token) {
+			browser = candidate.name
+			break
+		}
+	}
+
+	system := ""
+	for _, candidate := range userAgentSystems {
+		if strings.Contains(userAgent, candidate.token) {
+			system = candidate.name
+			break
+		}
+	}
+
+	switch {
+	case browser != "" && system != "":
+		return browser + " on " + system
+	case browser != "":
+		return browser
+	case system != "":
+		return system
+	default:
+		return "Unknown device"
+	}
+}
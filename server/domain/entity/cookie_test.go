@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testTrustedProxies = []string{"10.0.0.1", "10.0.0.2"}
+
+var ipFromRequestTest = []struct {
+	name       string
+	remoteAddr string
+	headers    map[string]string
+	expectedIP string
+}{
+	{
+		"Testing request without proxy headers",
+		"203.0.113.5:51234",
+		map[string]string{},
+		"203.0.113.5",
+	},
+	{
+		"Testing forged X-Forwarded-For from untrusted address",
+		"203.0.113.5:51234",
+		map[string]string{"X-Forwarded-For": "198.51.100.7"},
+		"203.0.113.5",
+	},
+	{
+		"Testing forged X-Real-IP from untrusted address",
+		"203.0.113.5:51234",
+		map[string]string{"X-Real-IP": "198.51.100.7"},
+		"203.0.113.5",
+	},
+	{
+		"Testing X-Forwarded-For from trusted proxy",
+		"10.0.0.1:51234",
+		map[string]string{"X-Forwarded-For": "198.51.100.7"},
+		"198.51.100.7",
+	},
+	{
+		"Testing X-Forwarded-For with forged address before client's one",
+		"10.0.0.1:51234",
+		map[string]string{"X-Forwarded-For": "192.0.2.1, 198.51.100.7, 10.0.0.2"},
+		"198.51.100.7",
+	},
+	{
+		"Testing X-Real-IP from trusted proxy",
+		"10.0.0.1:51234",
+		map[string]string{"X-Real-IP": "198.51.100.7"},
+		"198.51.100.7",
+	},
+	{
+		"Testing trusted proxy without headers",
+		"10.0.0.1:51234",
+		map[string]string{},
+		"10.0.0.1",
+	},
+}
+
+func TestIPFromRequest(t *testing.T) {
+	for _, tt := range ipFromRequestTest {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/api/auth/login", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for header, value := range tt.headers {
+				req.Header.Set(header, value)
+			}
+
+			require.Equal(t, tt.expectedIP, ipFromRequest(req, testTrustedProxies))
+		})
+	}
+}
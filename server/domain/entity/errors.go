@@ -15,6 +15,7 @@ const UserNotFoundError customError = "User not found"
 const UsersNotFoundError customError = "Users not found"
 const UsernameEmailDuplicateError customError = "Username or email is already taken"
 const IncorrectPasswordError customError = "Password is incorrect"
+const PasswordHashingError customError = "Could not hash password"
 const UserSavingError customError = "User saving failed"
 const ValidationError customError = "Validation error"
 const UnauthorizedError customError = "Unauthorized"
@@ -23,17 +24,28 @@ const FollowNotFoundError customError = "Follow relation not found"
 const FollowAlreadyExistsError customError = "Follow relation already exists"
 const FollowCountUpdateError customError = "Failed to update follow(er/ing) counter"
 const SelfFollowError customError = "Users can't follow themselves"
+const FollowRequestNotFoundError customError = "Follow request not found"
+const FollowRequestAlreadyExistsError customError = "Follow request already exists"
+const PrivateAccountError customError = "Only followers can see this user's content"
+const BlockNotFoundError customError = "Block not found"
+const BlockAlreadyExistsError customError = "Block already exists"
+const SelfBlockError customError = "Users can't block themselves"
+const UserBlockedError customError = "One of users has blocked the other"
 
 const CookieGenerationError customError = "Could not generate cookie"
 const CookieNotFoundError customError = "Could not find cookie"
 const CookieFoundError customError = "Cookie with such value already exists"
 const GetCookieFromContextError customError = "Could not get cookie from context"
+const SessionNotFoundError customError = "Session not found"
 
 const FilenameGenerationError customError = "Could not generate filename"
 const FileUploadError customError = "File upload failed"
 const FileDeletionError customError = "File deletion failed"
 
 const ClientNotSetError customError = "Websocket client not set"
+const UnknownCommandError customError = "Unknown websocket command"
+const UnknownTopicError customError = "Unknown websocket subscription topic"
+const EventsExpiredError customError = "Some of requested events were already removed"
 
 const NotificationsNotFoundError customError = "Notifications not found"
 const NotificationNotFoundError customError = "Notification not found"
@@ -45,10 +57,24 @@ const ChatsNotFoundError customError = "Chats not found"
 const ChatAlreadyExistsError customError = "Chat already exists"
 const UserNotInChatError customError = "User is not in chat"
 const ChatAlreadyReadError customError = "Chat is already read"
+const ChatCreationError customError = "Could not create chat"
+const UserAlreadyInChatError customError = "User is already in chat"
+const NotGroupChatError customError = "Chat is not a group chat"
+const ChatPermissionError customError = "User is not allowed to do this in chat"
+const IncorrectChatRoleError customError = "Incorrect chat role"
+const EmptyChatTitleError customError = "Chat title is empty"
 
 const MessageAddingError customError = "Could not add message"
+const EmptyMessageError customError = "Passed message is empty"
 const MessageNotFoundError customError = "Message not found"
 const MessagesNotFoundError customError = "Messages not found"
+const MessageAuthorError customError = "User is not message's author"
+const MessageChangeExpiredError customError = "Message can not be changed anymore"
+const MessageDeletedError customError = "Message was deleted"
+const IncorrectAttachmentError customError = "Incorrect message attachment"
+const TooManyAttachmentsError customError = "Message has too many attachments"
+const EmptySearchQueryError customError = "Search query is empty"
+const PresenceForbiddenError customError = "User is not allowed to see this user's presence"
 
 const JsonMarshallError customError = "Could not parse struct into JSON"
 
@@ -61,6 +87,25 @@ const GetBoardsByUserIDError customError = "No boards found in database with pas
 const DeleteInitBoardError customError = "Can not delete user's first board"
 const CheckBoardOwnerError customError = "That board is not associated with that user"
 const BoardAvatarUploadError customError = "Could not upload board's new avatar"
+const UpdateBoardError customError = "Could not update board"
+const BoardTitleAlreadyExistsError customError = "User already has board with that title"
+const CoverPinNotInBoardError customError = "Chosen cover pin is not on the board"
+const ArchiveInitBoardError customError = "Can not archive user's first board"
+const BoardArchivedError customError = "Can not add pins to archived board"
+const IncorrectBoardRoleError customError = "Incorrect board role"
+const SelfInviteError customError = "Board owner can't invite themselves"
+const CollaboratorAlreadyExistsError customError = "User is already invited to board"
+const CollaboratorNotFoundError customError = "User is not a collaborator of board"
+const InvitationNotFoundError customError = "Board invitation not found"
+const CollaboratorScanError customError = "Something went wrong when scanning board collaborator from database"
+const SectionNotFoundError customError = "No board section found"
+const SectionTitleAlreadyExistsError customError = "Board already has section with that title"
+const SectionScanError customError = "Something went wrong when scanning board section from database"
+const PinNotInBoardError customError = "Pin is not on the board"
+const BoardLayoutConflictError customError = "Board was changed since its order was fetched"
+const BoardFollowAlreadyExistsError customError = "User already follows this board"
+const BoardFollowNotFoundError customError = "User does not follow this board"
+const SelfBoardFollowError customError = "User can't follow their own board"
 
 const DeletePinError customError = "Could not delete pin"
 const RemovePinError customError = "Could not remove pin from board"
@@ -72,6 +117,7 @@ const PinsNotFoundError customError = "No pins found"
 const GetPinsByBoardIdError customError = "Could not get pins from passed board"
 const GetPinsByUserIdError customError = "Could not get user's pins by their ID"
 const PinSavingError customError = "Pin saving failed"
+const CheckPinOwnerError customError = "That pin is not associated with that user"
 const FeedLoadingError customError = "Could not extract pins for feed"
 const NonPositiveNumOfPinsError customError = "Cannot get negative amount of pins"
 
@@ -88,6 +134,11 @@ const GetCommentsError customError = "Could not get comments"
 const ReturnCommentsError customError = "Could not return comments"
 const CommentScanError customError = "something went wrong when scanning comment from database"
 const CommentsNotFoundError customError = "No comments found"
+const CommentNotFoundError customError = "No comment found"
+const CheckCommentOwnerError customError = "That comment is not associated with that user"
+const ParentCommentNotFoundError customError = "Comment to reply to was not found on this pin"
+const CommentsSortError customError = "Unknown comments sort order"
+const CommentsCursorError customError = "Comments cursor is not valid"
 
 const NoPicturePassed customError = "No picture was passed"
 const TooLargePicture customError = "Picture is too large"
@@ -12,6 +12,8 @@ const VkAddTokenURLKey key = "https://pinterbest.ru/add_vk/callback"
 const VkCreateUserURLKey key = "https://pinterbest.ru/signup/callback"
 
 const IDKey key = "id"
+const MemberIDKey key = "memberID"
+const SectionIDKey key = "sectionID"
 const UsernameKey key = "username"
 const SearchKeyQuery key = "searchKey"
 
@@ -24,6 +26,50 @@ const OneNotificationTypeKey key = "notification"
 const AllChatsTypeKey key = "all-chats"
 const OneChatTypeKey key = "new-chat"
 const OneMessageTypeKey key = "new-message"
+const ChatUpdateTypeKey key = "chat-update"
+const ChatRemovedTypeKey key = "chat-removed"
+const MessageEditedTypeKey key = "message-edited"
+const MessageDeletedTypeKey key = "message-deleted"
+const ReceiptTypeKey key = "receipt"
+const TypingTypeKey key = "typing"
+const PresenceTypeKey key = "presence"
+
+const DirectChatTypeKey key = "direct"
+const GroupChatTypeKey key = "group"
+
+const OwnerChatRoleKey key = "owner"
+const AdminChatRoleKey key = "admin"
+const MemberChatRoleKey key = "member"
+
+const OwnerBoardRoleKey key = "owner"
+const EditorBoardRoleKey key = "editor"
+const ViewerBoardRoleKey key = "viewer"
+
+const NewestCommentsSortKey key = "newest"
+const OldestCommentsSortKey key = "oldest"
+const MostRepliedCommentsSortKey key = "most-replied"
+
+const PinAttachmentTypeKey key = "pin"
+const BoardAttachmentTypeKey key = "board"
+
+const ChatAvatarDefaultPath key = "assets/img/default-chat-avatar.jpg"
+
+const CommandAckTypeKey key = "ack"
+const CommandErrorTypeKey key = "error"
+const ResyncTypeKey key = "resync"
+
+const SendMessageCommandKey key = "send-message"
+const ReadChatCommandKey key = "read-chat"
+const EditMessageCommandKey key = "edit-message"
+const DeleteMessageCommandKey key = "delete-message"
+const DeliverMessagesCommandKey key = "deliver-messages"
+const TypingCommandKey key = "typing"
+const GetPresenceCommandKey key = "get-presence"
+const ReadNotificationCommandKey key = "read-notification"
+const SubscribeCommandKey key = "subscribe"
+
+const NotificationsTopicKey key = "notifications"
+const ChatsTopicKey key = "chats"
 
 const PinInfoLabelKey key = "pinInfo"
 const PinImageLabelKey key = "pinImage"
@@ -0,0 +1,109 @@
+package entity
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const passwordHashPrefix = "$argon2id$"
+const passwordSaltLength = 16
+const passwordKeyLength = 32
+
+// PasswordHashParams describes argon2id cost
+// Parameters are stored alongside every hash, so they can be raised without breaking old hashes
+type PasswordHashParams struct {
+	Time    uint32 // Number of passes over memory
+	Memory  uint32 // Memory usage in KiB
+	Threads uint8  // Degree of parallelism
+}
+
+// DefaultPasswordHashParams are taken from argon2 package recommendations
+var DefaultPasswordHashParams = PasswordHashParams{
+	Time:    1,
+	Memory:  64 * 1024,
+	Threads: 4,
+}
+
+// CurrentPasswordHashParams returns hashing cost set by PASSWORD_HASH_TIME, PASSWORD_HASH_MEMORY
+// and PASSWORD_HASH_THREADS environment variables, falling back to DefaultPasswordHashParams
+func CurrentPasswordHashParams() PasswordHashParams {
+	params := DefaultPasswordHashParams
+	if value, err := strconv.ParseUint(os.Getenv("PASSWORD_HASH_TIME"), 10, 32); err == nil && value > 0 {
+		params.Time = uint32(value)
+	}
+	if value, err := strconv.ParseUint(os.Getenv("PASSWORD_HASH_MEMORY"), 10, 32); err == nil && value > 0 {
+		params.Memory = uint32(value)
+	}
+	if value, err := strconv.ParseUint(os.Getenv("PASSWORD_HASH_THREADS"), 10, 8); err == nil && value > 0 {
+		params.Threads = uint8(value)
+	}
+	return params
+}
+
+// HashPassword hashes password with argon2id using newly generated salt and current cost parameters
+// It returns encoded hash and salt, which should both be saved to database
+func HashPassword(password string) (string, string, error) {
+	salt, err := GenerateRandomString(passwordSaltLength)
+	if err != nil {
+		return "", "", PasswordHashingError
+	}
+
+	params := CurrentPasswordHashParams()
+	return encodePasswordHash(password, salt, params), salt, nil
+}
+
+// CheckPassword checks if password matches hash and salt stored in database
+// Second returned value is true if hash should be replaced by HashPassword's result,
+// either because it was made with other parameters or because it is not hashed at all
+func CheckPassword(password string, hash string, salt string) (bool, bool) {
+	if !strings.HasPrefix(hash, passwordHashPrefix) { // Password was saved before hashing was introduced
+		matches := subtle.ConstantTimeCompare([]byte(password), []byte(hash)) == 1
+		return matches, matches
+	}
+
+	params, ok := decodePasswordHashParams(hash)
+	if !ok {
+		return false, false
+	}
+
+	expectedHash := encodePasswordHash(password, salt, params)
+	if subtle.ConstantTimeCompare([]byte(expectedHash), []byte(hash)) != 1 {
+		return false, false
+	}
+
+	return true, params != CurrentPasswordHashParams()
+}
+
+// encodePasswordHash returns hash in "$argon2id$v=19$m=65536,t=1,p=4$<key>" format
+func encodePasswordHash(password string, salt string, params PasswordHashParams) string {
+	key := argon2.IDKey([]byte(password), []byte(salt), params.Time, params.Memory, params.Threads, passwordKeyLength)
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s", passwordHashPrefix, argon2.Version,
+		params.Memory, params.Time, params.Threads, base64.RawStdEncoding.EncodeToString(key))
+}
+
+func decodePasswordHashParams(hash string) (PasswordHashParams, bool) {
+	var version int
+	var params PasswordHashParams
+	parts := strings.Split(strings.TrimPrefix(hash, passwordHashPrefix), "$")
+	if len(parts) != 3 {
+		return params, false
+	}
+
+	_, err := fmt.Sscanf(parts[0], "v=%d", &version)
+	if err != nil || version != argon2.Version {
+		return params, false
+	}
+
+	_, err = fmt.Sscanf(parts[1], "m=%d,t=%d,p=%d", &params.Memory, &params.Time, &params.Threads)
+	if err != nil {
+		return params, false
+	}
+
+	return params, true
+}
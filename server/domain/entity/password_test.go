@@ -0,0 +1,163 @@
+package entity
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testPasswordHashParams are cheap, so that tests don't spend time hashing
+var testPasswordHashParams = PasswordHashParams{Time: 1, Memory: 1024, Threads: 1}
+
+// setTestPasswordHashParams makes testPasswordHashParams current hashing cost
+func setTestPasswordHashParams(t *testing.T) {
+	os.Setenv("PASSWORD_HASH_TIME", "1")
+	os.Setenv("PASSWORD_HASH_MEMORY", "1024")
+	os.Setenv("PASSWORD_HASH_THREADS", "1")
+	t.Cleanup(func() {
+		os.Unsetenv("PASSWORD_HASH_TIME")
+		os.Unsetenv("PASSWORD_HASH_MEMORY")
+		os.Unsetenv("PASSWORD_HASH_THREADS")
+	})
+}
+
+// tamperPasswordHash changes last character of hash's key
+func tamperPasswordHash(hash string) string {
+	lastChar := hash[len(hash)-1]
+	newChar := "A"
+	if lastChar == 'A' {
+		newChar = "B"
+	}
+	return hash[:len(hash)-1] + newChar
+}
+
+const testPassword = "TestPassword123"
+const testSalt = "someRandomSalt16"
+
+var checkPasswordTest = []struct {
+	name           string
+	password       string
+	hash           string
+	salt           string
+	expectedMatch  bool
+	expectedRehash bool
+}{
+	{
+		"Testing correct password",
+		testPassword,
+		encodePasswordHash(testPassword, testSalt, testPasswordHashParams),
+		testSalt,
+		true,
+		false,
+	},
+	{
+		"Testing wrong password",
+		"WrongPassword123",
+		encodePasswordHash(testPassword, testSalt, testPasswordHashParams),
+		testSalt,
+		false,
+		false,
+	},
+	{
+		"Testing correct password with wrong salt",
+		testPassword,
+		encodePasswordHash(testPassword, testSalt, testPasswordHashParams),
+		"otherRandomSalt1",
+		false,
+		false,
+	},
+	{
+		"Testing tampered hash",
+		testPassword,
+		tamperPasswordHash(encodePasswordHash(testPassword, testSalt, testPasswordHashParams)),
+		testSalt,
+		false,
+		false,
+	},
+	{
+		"Testing hash with tampered parameters",
+		testPassword,
+		strings.Replace(encodePasswordHash(testPassword, testSalt, testPasswordHashParams), "t=1", "t=2", 1),
+		testSalt,
+		false,
+		false,
+	},
+	{
+		"Testing hash with unknown version",
+		testPassword,
+		strings.Replace(encodePasswordHash(testPassword, testSalt, testPasswordHashParams), "v=19", "v=16", 1),
+		testSalt,
+		false,
+		false,
+	},
+	{
+		"Testing malformed hash",
+		testPassword,
+		passwordHashPrefix + "v=19$m=1024",
+		testSalt,
+		false,
+		false,
+	},
+	{
+		"Testing correct password with hash made with older parameters",
+		testPassword,
+		encodePasswordHash(testPassword, testSalt, PasswordHashParams{Time: 2, Memory: 1024, Threads: 1}),
+		testSalt,
+		true,
+		true,
+	},
+	{
+		"Testing correct password saved before hashing",
+		testPassword,
+		testPassword,
+		"",
+		true,
+		true,
+	},
+	{
+		"Testing wrong password saved before hashing",
+		"WrongPassword123",
+		testPassword,
+		"",
+		false,
+		false,
+	},
+}
+
+func TestCheckPassword(t *testing.T) {
+	setTestPasswordHashParams(t)
+
+	for _, tt := range checkPasswordTest {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			match, rehash := CheckPassword(tt.password, tt.hash, tt.salt)
+			require.Equal(t, tt.expectedMatch, match, "Password match is checked incorrectly")
+			require.Equal(t, tt.expectedRehash, rehash, "Need to rehash password is checked incorrectly")
+		})
+	}
+}
+
+func TestHashPassword(t *testing.T) {
+	setTestPasswordHashParams(t)
+
+	hash, salt, err := HashPassword(testPassword)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(hash, passwordHashPrefix+"v=19$m=1024,t=1,p=1$"),
+		"Hash should be encoded with its parameters")
+	require.NotContains(t, hash, testPassword)
+
+	params, ok := decodePasswordHashParams(hash)
+	require.True(t, ok)
+	require.Equal(t, testPasswordHashParams, params)
+
+	match, rehash := CheckPassword(testPassword, hash, salt)
+	require.True(t, match)
+	require.False(t, rehash)
+
+	otherHash, otherSalt, err := HashPassword(testPassword)
+	require.NoError(t, err)
+	require.NotEqual(t, salt, otherSalt, "Every password should get its own salt")
+	require.NotEqual(t, hash, otherHash)
+}
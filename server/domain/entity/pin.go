@@ -1,6 +1,10 @@
 package entity
 
-import "time"
+import (
+	"time"
+
+	"github.com/asaskevich/govalidator"
+)
 
 type Pin struct {
 	PinID         int       `json:"ID"`
@@ -14,6 +18,8 @@ type Pin struct {
 	Description   string    `json:"description"`
 	CreationDate  time.Time `json:"creationDate"`
 	ReportsCount  int       `json:"reportsCount"`
+	IsSecret      bool      `json:"isSecret"`            // True if pin is only on secret boards
+	SectionID     int       `json:"sectionID,omitempty"` // Pin's section on board it was fetched from, 0 if there is none
 }
 
 type PinOutput struct {
@@ -28,6 +34,7 @@ type PinOutput struct {
 	Description   string `json:"description"`
 	CreationDate  string `json:"creationDate"`
 	ReportsCount  int    `json:"reportsCount"`
+	SectionID     int    `json:"sectionID,omitempty"`
 }
 
 //type SearchPinInput struct {
@@ -35,6 +42,12 @@ type PinOutput struct {
 //	KeyWords string `json:"searchKey"`
 //}
 
+// PinEditInput is used when parsing JSON in pin edit handler
+type PinEditInput struct {
+	Title       string `json:"title" valid:"required,stringlength(1|100)"`
+	Description string `json:"description" valid:"stringlength(0|1000),optional"`
+}
+
 type PinsListOutput struct {
 	Pins []PinOutput `json:"pins"`
 }
@@ -43,6 +56,13 @@ type PinID struct {
 	PinID int `json:"ID"`
 }
 
+// Validate validates PinEditInput struct according to following rules:
+// Title - 1-100 characters
+// Description - up to 1000 characters, may be empty
+func (pinInput *PinEditInput) Validate() (bool, error) {
+	return govalidator.ValidateStruct(*pinInput)
+}
+
 func (pinOutput *PinOutput) FillFromPin(pin *Pin) {
 	pinOutput.PinID = pin.PinID
 	pinOutput.UserID = pin.UserID
@@ -55,4 +75,5 @@ func (pinOutput *PinOutput) FillFromPin(pin *Pin) {
 	pinOutput.Description = pin.Description
 	pinOutput.CreationDate = pin.CreationDate.String()
 	pinOutput.ReportsCount = pin.ReportsCount
+	pinOutput.SectionID = pin.SectionID
 }
@@ -0,0 +1,30 @@
+package entity
+
+// InterfaceToUint64 converts number returned by tarantool, which msgpack may decode into any integer type
+// Negative numbers are not expected, as they are only used for IDs, counters and timestamps
+func InterfaceToUint64(number interface{}) uint64 {
+	switch value := number.(type) {
+	case uint64:
+		return value
+	case uint32:
+		return uint64(value)
+	case uint16:
+		return uint64(value)
+	case uint8:
+		return uint64(value)
+	case uint:
+		return uint64(value)
+	case int64:
+		return uint64(value)
+	case int32:
+		return uint64(value)
+	case int16:
+		return uint64(value)
+	case int8:
+		return uint64(value)
+	case int:
+		return uint64(value)
+	default:
+		return 0
+	}
+}
@@ -47,7 +47,7 @@ func init() {
 type User struct {
 	UserID      int    `json:"ID"`
 	Username    string `json:"username,omitempty"`
-	Password    string `json:"-"` // TODO: hashing
+	Password    string `json:"-"`
 	FirstName   string `json:"firstName,omitempty"`
 	LastName    string `json:"lastName,omitempty"`
 	Email       string `json:"email,omitempty"`
@@ -73,6 +73,7 @@ type UserOutput struct {
 	BoardsCount int    `json:"boardsCount"`
 	PinsCount   int    `json:"pinsCount"`
 	Followed    *bool  `json:"followed,omitempty"` // pointer because we need to not send this sometimes
+	Blocked     *bool  `json:"blocked,omitempty"`  // True if current user blocked this one, not sent to anonymous users
 }
 
 // UserRegInput is used when parsing JSON in auth/signup handler
@@ -163,13 +164,13 @@ func (user *User) UpdateFrom(userInput interface{}) error {
 		{
 			userRegInput := *userInput.(*UserRegInput)
 			user.Username = userRegInput.Username
-			user.Password = userRegInput.Password // TODO: hashing
+			user.Password = userRegInput.Password
 			user.Email = userRegInput.Email
 			user.FirstName = userRegInput.FirstName
 			user.LastName = userRegInput.LastName
 		}
 	case *UserPassChangeInput:
-		user.Password = userInput.(*UserPassChangeInput).Password // TODO: hashing
+		user.Password = userInput.(*UserPassChangeInput).Password
 	case *UserEditInput:
 		{
 			userEditInput := *userInput.(*UserEditInput)
@@ -208,3 +209,16 @@ func (userOutput *UserOutput) FillFromUser(user *User) {
 	userOutput.BoardsCount = user.BoardsCount
 	userOutput.PinsCount = user.PinsCount
 }
+
+// PrivacySettings describes what user lets others know about them
+type PrivacySettings struct {
+	UserID       int  `json:"-"`
+	HidePresence bool `json:"hidePresence"` // If true, nobody sees if user is online or when they were last seen
+	IsPrivate    bool `json:"isPrivate"`    // If true, only followers see user's pins, boards and followers, and new followers have to be accepted
+}
+
+// BlockStatus describes blocks between two users
+type BlockStatus struct {
+	IsBlocked   bool // First user blocked second one
+	IsBlockedBy bool // Second user blocked first one
+}
@@ -1,6 +1,117 @@
 package entity
 
+import "time"
+
 type InitialMessage struct {
-	UserID    int    `json:"userID"`
-	CSRFToken string `json:"CSRFToken"`
+	UserID       int    `json:"userID"` // Optional, connection belongs to the user session cookie was issued for
+	CSRFToken    string `json:"CSRFToken"`
+	LastSequence int    `json:"lastSequence"` // Sequence number of the last message client received, 0 if none
+}
+
+// OutboxEvent is a message sent to user, kept so that it could be sent again to reconnected clients
+type OutboxEvent struct {
+	UserID   int
+	Sequence int // Grows by one with every user's event
+	Payload  []byte
+}
+
+// ResyncOutput is sent before full notifications and chats lists, if client is new or its missed messages could not be replayed
+// Client should drop everything it has, wait for the lists and pass Sequence as lastSequence when it reconnects
+// Messages with sequence numbers up to Sequence are already included in the lists, later ones may be included too
+type ResyncOutput struct {
+	Type     key `json:"type"`
+	Sequence int `json:"sequence"` // Sequence number of user's last message before lists were built
+}
+
+// ControlEvent tells every server instance to do something with user's connections
+// Control events are passed apart from messages, so they never reach clients or user's outbox
+type ControlEvent string
+
+const SessionsEndedControlEvent ControlEvent = "sessions-ended" // Some of user's sessions were logged out, their clients have to be disconnected
+
+// WebsocketCommand is the envelope of every message client sends through websocket
+type WebsocketCommand struct {
+	Type      key `json:"type"`
+	RequestID int `json:"requestID"` // Is copied to command's ack or error, so that client could match them
+}
+
+type SendMessageCommand struct {
+	ChatID      int                      `json:"chatID"`   // Chat to send message to, if it is not passed, message goes to direct chat with target
+	TargetID    int                      `json:"targetID"` // Used only if chat ID was not passed
+	MessageText string                   `json:"messageText"`
+	Attachments []MessageAttachmentInput `json:"attachments"`
+}
+
+type ReadChatCommand struct {
+	ChatID    int `json:"chatID"`
+	MessageID int `json:"messageID"` // Messages up to this one are marked read, if it is not passed, whole chat is read
+}
+
+type EditMessageCommand struct {
+	MessageID   int    `json:"messageID"`
+	MessageText string `json:"messageText"`
+}
+
+type DeleteMessageCommand struct {
+	MessageID int `json:"messageID"`
+}
+
+// DeliverMessagesCommand is sent by client after it has received chat's messages up to passed one
+type DeliverMessagesCommand struct {
+	ChatID    int `json:"chatID"`
+	MessageID int `json:"messageID"`
+}
+
+type ReadNotificationCommand struct {
+	NotificationID int `json:"notificationID"`
+}
+
+type SubscribeCommand struct {
+	Topic key `json:"topic"`
+}
+
+// CommandAckOutput is sent after command was successfully executed
+type CommandAckOutput struct {
+	Type      key `json:"type"`
+	RequestID int `json:"requestID"`
+	Command   key `json:"command"`
+	ID        int `json:"ID,omitempty"` // ID of created object, if any
+}
+
+// CommandErrorOutput is sent if command could not be executed
+type CommandErrorOutput struct {
+	Type      key    `json:"type"`
+	RequestID int    `json:"requestID"`
+	Command   key    `json:"command"`
+	Error     string `json:"error"`
+}
+
+// Presence tells if user is online now and when they were last seen
+type Presence struct {
+	UserID   int        `json:"userID"`
+	IsOnline bool       `json:"isOnline"`
+	LastSeen *time.Time `json:"lastSeen,omitempty"` // Is not set if user was never online
+	IsHidden bool       `json:"isHidden"`           // True if user hides their presence, other fields are not set then
+}
+
+// PresenceOutput is sent in response to client's presence request
+type PresenceOutput struct {
+	Type     key      `json:"type"`
+	Presence Presence `json:"presence"`
+}
+
+// TypingOutput is sent to chat's members while one of them is typing
+// It is not saved to outbox, so clients that were offline never get it
+type TypingOutput struct {
+	Type   key `json:"type"`
+	ChatID int `json:"chatID"`
+	UserID int `json:"userID"`
+}
+
+type TypingCommand struct {
+	ChatID int `json:"chatID"`
+}
+
+type GetPresenceCommand struct {
+	UserID int `json:"userID"`
 }
@@ -0,0 +1,11 @@
+package repository
+
+import "pinterest/domain/entity"
+
+type BroadcasterInterface interface {
+	Publish(userID int, messages [][]byte) error                          // Deliver messages for specified user to every server instance
+	Subscribe(handler func(userID int, message []byte))                   // Handler is called for every published message, including ones published by this instance, and must not block
+	PublishControl(userID int, event entity.ControlEvent) error           // Deliver control event about specified user's connections to every server instance
+	SubscribeControl(handler func(userID int, event entity.ControlEvent)) // Handler is called for every published control event, including ones published by this instance, and must not block
+	Close() error                                                         // Stop receiving messages
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/repository/broadcaster.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	entity "pinterest/domain/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBroadcasterInterface is a mock of BroadcasterInterface interface.
+type MockBroadcasterInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockBroadcasterInterfaceMockRecorder
+}
+
+// MockBroadcasterInterfaceMockRecorder is the mock recorder for MockBroadcasterInterface.
+type MockBroadcasterInterfaceMockRecorder struct {
+	mock *MockBroadcasterInterface
+}
+
+// NewMockBroadcasterInterface creates a new mock instance.
+func NewMockBroadcasterInterface(ctrl *gomock.Controller) *MockBroadcasterInterface {
+	mock := &MockBroadcasterInterface{ctrl: ctrl}
+	mock.recorder = &MockBroadcasterInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBroadcasterInterface) EXPECT() *MockBroadcasterInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockBroadcasterInterface) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockBroadcasterInterfaceMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockBroadcasterInterface)(nil).Close))
+}
+
+// Publish mocks base method.
+func (m *MockBroadcasterInterface) Publish(userID int, messages [][]byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", userID, messages)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockBroadcasterInterfaceMockRecorder) Publish(userID, messages interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockBroadcasterInterface)(nil).Publish), userID, messages)
+}
+
+// PublishControl mocks base method.
+func (m *MockBroadcasterInterface) PublishControl(userID int, event entity.ControlEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishControl", userID, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishControl indicates an expected call of PublishControl.
+func (mr *MockBroadcasterInterfaceMockRecorder) PublishControl(userID, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishControl", reflect.TypeOf((*MockBroadcasterInterface)(nil).PublishControl), userID, event)
+}
+
+// Subscribe mocks base method.
+func (m *MockBroadcasterInterface) Subscribe(handler func(int, []byte)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Subscribe", handler)
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockBroadcasterInterfaceMockRecorder) Subscribe(handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockBroadcasterInterface)(nil).Subscribe), handler)
+}
+
+// SubscribeControl mocks base method.
+func (m *MockBroadcasterInterface) SubscribeControl(handler func(int, entity.ControlEvent)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SubscribeControl", handler)
+}
+
+// SubscribeControl indicates an expected call of SubscribeControl.
+func (mr *MockBroadcasterInterfaceMockRecorder) SubscribeControl(handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeControl", reflect.TypeOf((*MockBroadcasterInterface)(nil).SubscribeControl), handler)
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/repository/outbox.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	entity "pinterest/domain/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOutboxRepositoryInterface is a mock of OutboxRepositoryInterface interface.
+type MockOutboxRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRepositoryInterfaceMockRecorder
+}
+
+// MockOutboxRepositoryInterfaceMockRecorder is the mock recorder for MockOutboxRepositoryInterface.
+type MockOutboxRepositoryInterfaceMockRecorder struct {
+	mock *MockOutboxRepositoryInterface
+}
+
+// NewMockOutboxRepositoryInterface creates a new mock instance.
+func NewMockOutboxRepositoryInterface(ctrl *gomock.Controller) *MockOutboxRepositoryInterface {
+	mock := &MockOutboxRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockOutboxRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxRepositoryInterface) EXPECT() *MockOutboxRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AddEvent mocks base method.
+func (m *MockOutboxRepositoryInterface) AddEvent(userID int, payload []byte) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEvent", userID, payload)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddEvent indicates an expected call of AddEvent.
+func (mr *MockOutboxRepositoryInterfaceMockRecorder) AddEvent(userID, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEvent", reflect.TypeOf((*MockOutboxRepositoryInterface)(nil).AddEvent), userID, payload)
+}
+
+// GetEvents mocks base method.
+func (m *MockOutboxRepositoryInterface) GetEvents(userID, afterSequence int) ([]*entity.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvents", userID, afterSequence)
+	ret0, _ := ret[0].([]*entity.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents.
+func (mr *MockOutboxRepositoryInterfaceMockRecorder) GetEvents(userID, afterSequence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockOutboxRepositoryInterface)(nil).GetEvents), userID, afterSequence)
+}
+
+// GetLastSequence mocks base method.
+func (m *MockOutboxRepositoryInterface) GetLastSequence(userID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastSequence", userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastSequence indicates an expected call of GetLastSequence.
+func (mr *MockOutboxRepositoryInterfaceMockRecorder) GetLastSequence(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastSequence", reflect.TypeOf((*MockOutboxRepositoryInterface)(nil).GetLastSequence), userID)
+}
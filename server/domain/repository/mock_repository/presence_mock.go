@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/repository/presence.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	entity "pinterest/domain/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPresenceRepositoryInterface is a mock of PresenceRepositoryInterface interface.
+type MockPresenceRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPresenceRepositoryInterfaceMockRecorder
+}
+
+// MockPresenceRepositoryInterfaceMockRecorder is the mock recorder for MockPresenceRepositoryInterface.
+type MockPresenceRepositoryInterfaceMockRecorder struct {
+	mock *MockPresenceRepositoryInterface
+}
+
+// NewMockPresenceRepositoryInterface creates a new mock instance.
+func NewMockPresenceRepositoryInterface(ctrl *gomock.Controller) *MockPresenceRepositoryInterface {
+	mock := &MockPresenceRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockPresenceRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPresenceRepositoryInterface) EXPECT() *MockPresenceRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetPresence mocks base method.
+func (m *MockPresenceRepositoryInterface) GetPresence(userID int) (*entity.Presence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPresence", userID)
+	ret0, _ := ret[0].(*entity.Presence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPresence indicates an expected call of GetPresence.
+func (mr *MockPresenceRepositoryInterfaceMockRecorder) GetPresence(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresence", reflect.TypeOf((*MockPresenceRepositoryInterface)(nil).GetPresence), userID)
+}
+
+// SetLastSeen mocks base method.
+func (m *MockPresenceRepositoryInterface) SetLastSeen(userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLastSeen", userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLastSeen indicates an expected call of SetLastSeen.
+func (mr *MockPresenceRepositoryInterfaceMockRecorder) SetLastSeen(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastSeen", reflect.TypeOf((*MockPresenceRepositoryInterface)(nil).SetLastSeen), userID)
+}
+
+// SetOnline mocks base method.
+func (m *MockPresenceRepositoryInterface) SetOnline(userIDs []int, ttlSeconds int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOnline", userIDs, ttlSeconds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOnline indicates an expected call of SetOnline.
+func (mr *MockPresenceRepositoryInterfaceMockRecorder) SetOnline(userIDs, ttlSeconds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOnline", reflect.TypeOf((*MockPresenceRepositoryInterface)(nil).SetOnline), userIDs, ttlSeconds)
+}
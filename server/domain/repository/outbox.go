@@ -0,0 +1,9 @@
+package repository
+
+import "pinterest/domain/entity"
+
+type OutboxRepositoryInterface interface {
+	AddEvent(userID int, payload []byte) (int, error)                       // Save event for specified user, returning its sequence number
+	GetEvents(userID int, afterSequence int) ([]*entity.OutboxEvent, error) // Get user's events that came after specified sequence number
+	GetLastSequence(userID int) (int, error)                                // Get sequence number of user's last event, 0 if there were none
+}
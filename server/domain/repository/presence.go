@@ -0,0 +1,9 @@
+package repository
+
+import "pinterest/domain/entity"
+
+type PresenceRepositoryInterface interface {
+	SetOnline(userIDs []int, ttlSeconds int) error    // Mark users as online for specified time, updating their last seen time
+	SetLastSeen(userID int) error                     // Save current time as user's last seen time
+	GetPresence(userID int) (*entity.Presence, error) // Get user's online status and last seen time
+}
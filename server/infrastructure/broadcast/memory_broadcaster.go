@@ -0,0 +1,62 @@
+package broadcast
+
+import (
+	"pinterest/domain/entity"
+	"sync"
+)
+
+// MemoryBroadcaster delivers messages only inside current process
+// It is meant for tests and single-instance deployments
+type MemoryBroadcaster struct {
+	handlers        []func(userID int, message []byte)
+	controlHandlers []func(userID int, event entity.ControlEvent)
+	mu              sync.RWMutex
+}
+
+func NewMemoryBroadcaster() *MemoryBroadcaster {
+	return &MemoryBroadcaster{}
+}
+
+func (broadcaster *MemoryBroadcaster) Publish(userID int, messages [][]byte) error {
+	broadcaster.mu.RLock()
+	defer broadcaster.mu.RUnlock()
+
+	for _, message := range messages {
+		for _, handler := range broadcaster.handlers {
+			handler(userID, message)
+		}
+	}
+
+	return nil
+}
+
+func (broadcaster *MemoryBroadcaster) Subscribe(handler func(userID int, message []byte)) {
+	broadcaster.mu.Lock()
+	broadcaster.handlers = append(broadcaster.handlers, handler)
+	broadcaster.mu.Unlock()
+}
+
+func (broadcaster *MemoryBroadcaster) PublishControl(userID int, event entity.ControlEvent) error {
+	broadcaster.mu.RLock()
+	defer broadcaster.mu.RUnlock()
+
+	for _, handler := range broadcaster.controlHandlers {
+		handler(userID, event)
+	}
+
+	return nil
+}
+
+func (broadcaster *MemoryBroadcaster) SubscribeControl(handler func(userID int, event entity.ControlEvent)) {
+	broadcaster.mu.Lock()
+	broadcaster.controlHandlers = append(broadcaster.controlHandlers, handler)
+	broadcaster.mu.Unlock()
+}
+
+func (broadcaster *MemoryBroadcaster) Close() error {
+	broadcaster.mu.Lock()
+	broadcaster.handlers = nil
+	broadcaster.controlHandlers = nil
+	broadcaster.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,75 @@
+package broadcast
+
+import (
+	"pinterest/domain/entity"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// receivedMessage is a message as handler got it
+type receivedMessage struct {
+	userID  int
+	message string
+}
+
+// receivedControlEvent is a control event as handler got it
+type receivedControlEvent struct {
+	userID int
+	event  entity.ControlEvent
+}
+
+func TestMemoryBroadcasterFanOut(t *testing.T) {
+	broadcaster := NewMemoryBroadcaster()
+
+	subscribersReceived := make([][]receivedMessage, 3)
+	for i := range subscribersReceived {
+		i := i
+		broadcaster.Subscribe(func(userID int, message []byte) {
+			subscribersReceived[i] = append(subscribersReceived[i], receivedMessage{userID, string(message)})
+		})
+	}
+
+	err := broadcaster.Publish(1, [][]byte{[]byte(`{"type":"message"}`), []byte(`{"type":"chat"}`)})
+	require.NoError(t, err)
+
+	expectedReceived := []receivedMessage{{1, `{"type":"message"}`}, {1, `{"type":"chat"}`}}
+	for _, received := range subscribersReceived {
+		require.Equal(t, expectedReceived, received, "Every subscriber should get every message in order")
+	}
+}
+
+func TestMemoryBroadcasterControlEvents(t *testing.T) {
+	broadcaster := NewMemoryBroadcaster()
+
+	var messagesReceived []receivedMessage
+	broadcaster.Subscribe(func(userID int, message []byte) {
+		messagesReceived = append(messagesReceived, receivedMessage{userID, string(message)})
+	})
+	var eventsReceived []receivedControlEvent
+	broadcaster.SubscribeControl(func(userID int, event entity.ControlEvent) {
+		eventsReceived = append(eventsReceived, receivedControlEvent{userID, event})
+	})
+
+	err := broadcaster.PublishControl(1, entity.SessionsEndedControlEvent)
+	require.NoError(t, err)
+
+	require.Equal(t, []receivedControlEvent{{1, entity.SessionsEndedControlEvent}}, eventsReceived)
+	require.Empty(t, messagesReceived, "Control events should never be passed as messages")
+}
+
+func TestMemoryBroadcasterClose(t *testing.T) {
+	broadcaster := NewMemoryBroadcaster()
+
+	received := 0
+	broadcaster.Subscribe(func(userID int, message []byte) {
+		received++
+	})
+
+	err := broadcaster.Close()
+	require.NoError(t, err)
+
+	err = broadcaster.Publish(1, [][]byte{[]byte(`{"type":"message"}`)})
+	require.NoError(t, err)
+	require.Equal(t, 0, received, "Closed broadcaster should not pass messages to handlers")
+}
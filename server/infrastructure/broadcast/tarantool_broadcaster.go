@@ -0,0 +1,126 @@
+package broadcast
+
+import (
+	"pinterest/domain/entity"
+	"sync"
+	"time"
+
+	"github.com/tarantool/go-tarantool"
+)
+
+const waitTimeout = 10 * time.Second // How long one wait_realtime_events call may block in tarantool
+const retryDelay = time.Second       // Pause after failed wait, so that unavailable database is not spammed
+
+// TarantoolBroadcaster passes messages between server instances through "realtime_events" space
+// Every instance long-polls the space, so messages published by any instance reach all of them
+type TarantoolBroadcaster struct {
+	tarantoolDB     *tarantool.Connection
+	handlers        []func(userID int, message []byte)
+	controlHandlers []func(userID int, event entity.ControlEvent)
+	mu              sync.RWMutex
+	done            chan struct{}
+	closeOnce       sync.Once
+	lastEventID     uint64
+}
+
+func NewTarantoolBroadcaster(tarantoolDB *tarantool.Connection) (*TarantoolBroadcaster, error) {
+	resp, err := tarantoolDB.Call17("last_realtime_event_id", []interface{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	broadcaster := &TarantoolBroadcaster{
+		tarantoolDB: tarantoolDB,
+		done:        make(chan struct{}),
+		lastEventID: entity.InterfaceToUint64(resp.Data[0]), // Events published before start are not interesting
+	}
+
+	go broadcaster.listen()
+	return broadcaster, nil
+}
+
+func (broadcaster *TarantoolBroadcaster) Publish(userID int, messages [][]byte) error {
+	for _, message := range messages {
+		_, err := broadcaster.tarantoolDB.Call17("publish_realtime_event", []interface{}{uint(userID), string(message)})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (broadcaster *TarantoolBroadcaster) Subscribe(handler func(userID int, message []byte)) {
+	broadcaster.mu.Lock()
+	broadcaster.handlers = append(broadcaster.handlers, handler)
+	broadcaster.mu.Unlock()
+}
+
+func (broadcaster *TarantoolBroadcaster) PublishControl(userID int, event entity.ControlEvent) error {
+	_, err := broadcaster.tarantoolDB.Call17("publish_realtime_event", []interface{}{uint(userID), "", string(event)})
+	return err
+}
+
+func (broadcaster *TarantoolBroadcaster) SubscribeControl(handler func(userID int, event entity.ControlEvent)) {
+	broadcaster.mu.Lock()
+	broadcaster.controlHandlers = append(broadcaster.controlHandlers, handler)
+	broadcaster.mu.Unlock()
+}
+
+func (broadcaster *TarantoolBroadcaster) Close() error {
+	broadcaster.closeOnce.Do(func() {
+		close(broadcaster.done)
+	})
+	return nil
+}
+
+// listen receives new events until broadcaster is closed
+func (broadcaster *TarantoolBroadcaster) listen() {
+	for {
+		select {
+		case <-broadcaster.done:
+			return
+		default:
+		}
+
+		resp, err := broadcaster.tarantoolDB.Call17("wait_realtime_events",
+			[]interface{}{broadcaster.lastEventID, waitTimeout.Seconds()})
+		if err != nil || len(resp.Data) == 0 {
+			select {
+			case <-broadcaster.done:
+				return
+			case <-time.After(retryDelay):
+			}
+			continue
+		}
+
+		events, _ := resp.Data[0].([]interface{})
+		for _, event := range events {
+			broadcaster.handleEvent(event.([]interface{}))
+		}
+	}
+}
+
+// handleEvent passes event tuple (event_id, user_id, payload, creation_time, control_event) to all handlers
+// Events with control_event set go to control handlers only, their payload is empty
+// Handlers run on listener goroutine, so they only queue messages and never wait for clients
+func (broadcaster *TarantoolBroadcaster) handleEvent(event []interface{}) {
+	broadcaster.lastEventID = entity.InterfaceToUint64(event[0])
+	userID := int(entity.InterfaceToUint64(event[1]))
+	message := []byte(event[2].(string))
+
+	broadcaster.mu.RLock()
+	defer broadcaster.mu.RUnlock()
+
+	if len(event) > 4 && event[4] != nil {
+		controlEvent := entity.ControlEvent(event[4].(string))
+		for _, handler := range broadcaster.controlHandlers {
+			handler(userID, controlEvent)
+		}
+		return
+	}
+
+	for _, handler := range broadcaster.handlers {
+		handler(userID, message)
+	}
+}
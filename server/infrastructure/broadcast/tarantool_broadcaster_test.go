@@ -0,0 +1,36 @@
+package broadcast
+
+import (
+	"pinterest/domain/entity"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTarantoolBroadcasterHandleEvent(t *testing.T) {
+	broadcaster := &TarantoolBroadcaster{done: make(chan struct{})} // Events are passed straight to handleEvent, so no database is needed
+
+	subscribersReceived := make([][]receivedMessage, 2)
+	for i := range subscribersReceived {
+		i := i
+		broadcaster.Subscribe(func(userID int, message []byte) {
+			subscribersReceived[i] = append(subscribersReceived[i], receivedMessage{userID, string(message)})
+		})
+	}
+	var eventsReceived []receivedControlEvent
+	broadcaster.SubscribeControl(func(userID int, event entity.ControlEvent) {
+		eventsReceived = append(eventsReceived, receivedControlEvent{userID, event})
+	})
+
+	broadcaster.handleEvent([]interface{}{uint64(5), uint64(1), `{"type":"message"}`, uint64(1622548800)})
+	broadcaster.handleEvent([]interface{}{int64(6), uint8(2), `{"type":"chat"}`, uint64(1622548800), nil})
+	broadcaster.handleEvent([]interface{}{uint64(7), uint64(1), "", uint64(1622548800), string(entity.SessionsEndedControlEvent)})
+
+	expectedReceived := []receivedMessage{{1, `{"type":"message"}`}, {2, `{"type":"chat"}`}}
+	for _, received := range subscribersReceived {
+		require.Equal(t, expectedReceived, received, "Every subscriber should get every message, whatever number types tarantool uses")
+	}
+	require.Equal(t, []receivedControlEvent{{1, entity.SessionsEndedControlEvent}}, eventsReceived,
+		"Control events should go to control handlers only")
+	require.Equal(t, uint64(7), broadcaster.lastEventID, "Broadcaster should wait for events after the last handled one")
+}
@@ -0,0 +1,74 @@
+package persistance
+
+import (
+	"fmt"
+	"pinterest/domain/entity"
+
+	"github.com/tarantool/go-tarantool"
+)
+
+type OutboxRepo struct {
+	tarantoolDB *tarantool.Connection
+}
+
+func NewOutboxRepository(tarantoolDB *tarantool.Connection) *OutboxRepo {
+	return &OutboxRepo{tarantoolDB}
+}
+
+func (outboxRepo *OutboxRepo) AddEvent(userID int, payload []byte) (int, error) {
+	resp, err := outboxRepo.tarantoolDB.Call17("add_outbox_event", []interface{}{uint(userID), string(payload)})
+	if err != nil {
+		return -1, err
+	}
+
+	if len(resp.Data) != 1 {
+		return -1, fmt.Errorf("Could not add event to outbox")
+	}
+
+	return int(entity.InterfaceToUint64(resp.Data[0])), nil
+}
+
+func (outboxRepo *OutboxRepo) GetEvents(userID int, afterSequence int) ([]*entity.OutboxEvent, error) {
+	resp, err := outboxRepo.tarantoolDB.Call17("get_outbox_events", []interface{}{uint(userID), uint(afterSequence)})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Data) != 2 {
+		return nil, fmt.Errorf("Could not get events from outbox")
+	}
+
+	complete, _ := resp.Data[1].(bool)
+	if !complete {
+		return nil, entity.EventsExpiredError
+	}
+
+	eventsAsInterfaces, _ := resp.Data[0].([]interface{})
+	events := make([]*entity.OutboxEvent, 0, len(eventsAsInterfaces))
+	for _, eventAsInterface := range eventsAsInterfaces {
+		events = append(events, interfacesToOutboxEvent(eventAsInterface.([]interface{})))
+	}
+
+	return events, nil
+}
+
+func (outboxRepo *OutboxRepo) GetLastSequence(userID int) (int, error) {
+	resp, err := outboxRepo.tarantoolDB.Call17("last_outbox_sequence", []interface{}{uint(userID)})
+	if err != nil {
+		return -1, err
+	}
+
+	if len(resp.Data) != 1 {
+		return -1, fmt.Errorf("Could not get last sequence number from outbox")
+	}
+
+	return int(entity.InterfaceToUint64(resp.Data[0])), nil
+}
+
+func interfacesToOutboxEvent(interfaces []interface{}) *entity.OutboxEvent {
+	return &entity.OutboxEvent{
+		UserID:   int(entity.InterfaceToUint64(interfaces[0])),
+		Sequence: int(entity.InterfaceToUint64(interfaces[1])),
+		Payload:  []byte(interfaces[2].(string)),
+	}
+}
@@ -0,0 +1,56 @@
+package persistance
+
+import (
+	"fmt"
+	"pinterest/domain/entity"
+	"time"
+
+	"github.com/tarantool/go-tarantool"
+)
+
+type PresenceRepo struct {
+	tarantoolDB *tarantool.Connection
+}
+
+func NewPresenceRepository(tarantoolDB *tarantool.Connection) *PresenceRepo {
+	return &PresenceRepo{tarantoolDB}
+}
+
+func (presenceRepo *PresenceRepo) SetOnline(userIDs []int, ttlSeconds int) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	ids := make([]interface{}, 0, len(userIDs))
+	for _, userID := range userIDs {
+		ids = append(ids, uint(userID))
+	}
+
+	_, err := presenceRepo.tarantoolDB.Call17("set_users_online", []interface{}{ids, uint(ttlSeconds)})
+	return err
+}
+
+func (presenceRepo *PresenceRepo) SetLastSeen(userID int) error {
+	_, err := presenceRepo.tarantoolDB.Call17("set_user_last_seen", []interface{}{uint(userID)})
+	return err
+}
+
+func (presenceRepo *PresenceRepo) GetPresence(userID int) (*entity.Presence, error) {
+	resp, err := presenceRepo.tarantoolDB.Call17("get_user_presence", []interface{}{uint(userID)})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Data) != 2 {
+		return nil, fmt.Errorf("Could not get user's presence")
+	}
+
+	presence := entity.Presence{UserID: userID}
+	presence.IsOnline, _ = resp.Data[0].(bool)
+	if lastSeen := int64(entity.InterfaceToUint64(resp.Data[1])); lastSeen != 0 {
+		lastSeenTime := time.Unix(lastSeen, 0)
+		presence.LastSeen = &lastSeenTime
+	}
+
+	return &presence, nil
+}
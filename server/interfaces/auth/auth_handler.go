@@ -6,34 +6,38 @@ import (
 	"pinterest/application"
 	"pinterest/domain/entity"
 	"pinterest/interfaces/middleware"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/gorilla/mux"
 	"go.uber.org/zap"
 )
 
 // AuthInfo keep information about apps and cookies needed for auth package
 type AuthInfo struct {
-	userApp      application.UserAppInterface
-	authApp      application.AuthAppInterface
-	cookieApp    application.CookieAppInterface
-	s3App        application.S3AppInterface
-	boardApp     application.BoardAppInterface     // For initial user's board
-	websocketApp application.WebsocketAppInterface // For setting CSRF token during  login
-	logger       *zap.Logger
+	userApp        application.UserAppInterface
+	authApp        application.AuthAppInterface
+	cookieApp      application.CookieAppInterface
+	s3App          application.S3AppInterface
+	boardApp       application.BoardAppInterface     // For initial user's board
+	websocketApp   application.WebsocketAppInterface // For setting CSRF token during  login
+	trustedProxies []string                          // Addresses of reverse proxies whose headers tell client's IP
+	logger         *zap.Logger
 }
 
 func NewAuthInfo(userApp application.UserAppInterface, authApp application.AuthAppInterface, cookieApp application.CookieAppInterface,
 	s3App application.S3AppInterface, boardApp application.BoardAppInterface,
-	websocketApp application.WebsocketAppInterface, logger *zap.Logger) *AuthInfo {
+	websocketApp application.WebsocketAppInterface, trustedProxies []string, logger *zap.Logger) *AuthInfo {
 	return &AuthInfo{
-		userApp:      userApp,
-		authApp:      authApp,
-		cookieApp:    cookieApp,
-		s3App:        s3App,
-		boardApp:     boardApp,
-		websocketApp: websocketApp,
-		logger:       logger,
+		userApp:        userApp,
+		authApp:        authApp,
+		cookieApp:      cookieApp,
+		s3App:          s3App,
+		boardApp:       boardApp,
+		websocketApp:   websocketApp,
+		trustedProxies: trustedProxies,
+		logger:         logger,
 	}
 }
 
@@ -82,7 +86,8 @@ func (info *AuthInfo) HandleCreateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = info.cookieApp.AddCookieInfo(&entity.CookieInfo{UserID: newUser.UserID, Cookie: cookie})
+	err = info.cookieApp.AddCookieInfo(&entity.CookieInfo{UserID: newUser.UserID, Cookie: cookie,
+		Session: entity.NewSessionFromRequest(r, info.trustedProxies)})
 	if err != nil {
 		info.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
 		info.userApp.DeleteUser(newUser.UserID)
@@ -90,9 +95,9 @@ func (info *AuthInfo) HandleCreateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Replacing token in websocket connection info
+	// Allowing websocket connections with this token
 	token := r.Header.Get("X-CSRF-Token")
-	err = info.websocketApp.ChangeToken(newUser.UserID, token)
+	err = info.websocketApp.AddToken(newUser.UserID, token)
 	if err != nil {
 		info.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
 		w.WriteHeader(http.StatusInternalServerError)
@@ -113,7 +118,8 @@ func (info *AuthInfo) HandleLoginUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	cookieInfo, err := info.authApp.CheckUserCredentials(userInput.Username, userInput.Password)
+	cookieInfo, err := info.authApp.CheckUserCredentials(userInput.Username, userInput.Password,
+		entity.NewSessionFromRequest(r, info.trustedProxies))
 
 	if err != nil {
 		info.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
@@ -128,9 +134,9 @@ func (info *AuthInfo) HandleLoginUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Replacing token in websocket connection info
+	// Allowing websocket connections with this token
 	token := r.Header.Get("X-CSRF-Token")
-	err = info.websocketApp.ChangeToken(cookieInfo.UserID, token)
+	err = info.websocketApp.AddToken(cookieInfo.UserID, token)
 	if err != nil {
 		info.logger.Info(err.Error(), zap.String("url", r.RequestURI),
 			zap.Int("for user", cookieInfo.UserID),
@@ -147,7 +153,7 @@ func (info *AuthInfo) HandleLoginUser(w http.ResponseWriter, r *http.Request) {
 func (info *AuthInfo) HandleLogoutUser(w http.ResponseWriter, r *http.Request) {
 	userCookie := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo)
 
-	err := info.authApp.LogoutUser(userCookie.UserID)
+	err := info.authApp.LogoutSession(userCookie)
 	if err != nil {
 		info.logger.Info(err.Error(), zap.String("url", r.RequestURI),
 			zap.Int("for user", userCookie.UserID),
@@ -156,12 +162,84 @@ func (info *AuthInfo) HandleLogoutUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	info.websocketApp.EndSessions(userCookie.UserID) // Disconnecting real-time clients of ended sessions
+
 	userCookie.Cookie.Expires = time.Now().AddDate(0, 0, -1) // Making cookie expire
 	http.SetCookie(w, userCookie.Cookie)
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// HandleGetSessions returns all devices current user is logged in from
+func (info *AuthInfo) HandleGetSessions(w http.ResponseWriter, r *http.Request) {
+	userCookie := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo)
+
+	sessions, err := info.authApp.GetSessions(userCookie)
+	if err != nil {
+		info.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userCookie.UserID),
+			zap.String("method", r.Method))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	responseBody, err := json.Marshal(entity.SessionsListOutput{Sessions: sessions})
+	if err != nil {
+		info.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userCookie.UserID),
+			zap.String("method", r.Method))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBody)
+}
+
+// HandleLogoutSession logs current user out of one of their sessions
+func (info *AuthInfo) HandleLogoutSession(w http.ResponseWriter, r *http.Request) {
+	userCookie := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo)
+	vars := mux.Vars(r)
+	sessionID, _ := strconv.Atoi(vars[string(entity.IDKey)])
+
+	err := info.authApp.LogoutSessionByID(userCookie.UserID, sessionID)
+	if err != nil {
+		info.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userCookie.UserID),
+			zap.String("method", r.Method))
+		switch err {
+		case entity.SessionNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	info.websocketApp.EndSessions(userCookie.UserID) // Disconnecting real-time clients of ended sessions
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleLogoutOtherSessions logs current user out of every session except current one
+func (info *AuthInfo) HandleLogoutOtherSessions(w http.ResponseWriter, r *http.Request) {
+	userCookie := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo)
+
+	err := info.authApp.LogoutOtherSessions(userCookie)
+	if err != nil {
+		info.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userCookie.UserID),
+			zap.String("method", r.Method))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	info.websocketApp.EndSessions(userCookie.UserID) // Disconnecting real-time clients of ended sessions
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // HandleCheckUser checks if current user is logged in
 func (info *AuthInfo) HandleCheckUser(w http.ResponseWriter, r *http.Request) {
 	_, found := middleware.CheckCookies(r, info.authApp)
@@ -208,7 +286,8 @@ func (info *AuthInfo) HandleCreateUserWithVK(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	err = info.cookieApp.AddCookieInfo(&entity.CookieInfo{UserID: userID, Cookie: cookie})
+	err = info.cookieApp.AddCookieInfo(&entity.CookieInfo{UserID: userID, Cookie: cookie,
+		Session: entity.NewSessionFromRequest(r, info.trustedProxies)})
 	if err != nil {
 		info.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
 		info.userApp.DeleteUser(userID)
@@ -216,9 +295,9 @@ func (info *AuthInfo) HandleCreateUserWithVK(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	// Replacing token in websocket connection info
+	// Allowing websocket connections with this token
 	token := r.Header.Get("X-CSRF-Token")
-	err = info.websocketApp.ChangeToken(userID, token)
+	err = info.websocketApp.AddToken(userID, token)
 	if err != nil {
 		info.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
 		w.WriteHeader(http.StatusInternalServerError)
@@ -238,7 +317,8 @@ func (info *AuthInfo) HandleCheckVkToken(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	cookieInfo, err := info.authApp.CheckVkCode(vkCodeInput.Code, string(entity.VkAuthenticateURLKey))
+	cookieInfo, err := info.authApp.CheckVkCode(vkCodeInput.Code, string(entity.VkAuthenticateURLKey),
+		entity.NewSessionFromRequest(r, info.trustedProxies))
 
 	if err != nil {
 		info.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
@@ -251,9 +331,9 @@ func (info *AuthInfo) HandleCheckVkToken(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Replacing token in websocket connection info
+	// Allowing websocket connections with this token
 	token := r.Header.Get("X-CSRF-Token")
-	err = info.websocketApp.ChangeToken(cookieInfo.UserID, token)
+	err = info.websocketApp.AddToken(cookieInfo.UserID, token)
 	if err != nil {
 		info.logger.Info(err.Error(), zap.String("url", r.RequestURI),
 			zap.Int("for user", cookieInfo.UserID),
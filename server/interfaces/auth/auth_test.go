@@ -141,6 +141,41 @@ var authTestSuccess = []struct {
 		},
 		"Testing user login",
 	},
+	{
+		authInputStruct{
+			"/auth/sessions",
+			"GET",
+			nil,
+			nil,
+			testInfo.HandleGetSessions,
+			middleware.AuthMid,
+		},
+
+		authOutputStruct{
+			200,
+			nil,
+			[]byte(`{"sessions":[{"ID":1,"device":"Firefox on Linux","IP":"127.0.0.1","userAgent":"Mozilla/5.0 (X11; Linux x86_64; rv:89.0) Gecko/20100101 Firefox/89.0",` +
+				`"created":"2021-06-01T12:00:00Z","lastSeen":"2021-06-01T12:30:00Z","isCurrent":true}]}`),
+		},
+		"Testing getting user's sessions",
+	},
+	{
+		authInputStruct{
+			"/auth/sessions",
+			"DELETE",
+			nil,
+			nil,
+			testInfo.HandleLogoutOtherSessions,
+			middleware.AuthMid,
+		},
+
+		authOutputStruct{
+			204,
+			nil,
+			nil,
+		},
+		"Testing logging user out of other sessions",
+	},
 	{
 		authInputStruct{
 			"/auth/check",
@@ -187,22 +222,40 @@ func TestAuthSuccess(t *testing.T) {
 		Expires:  time.Now().Add(10 * time.Hour),
 		HttpOnly: true,
 	}
+	expectedSession := entity.Session{
+		SessionID: 1,
+		Device:    "Firefox on Linux",
+		IP:        "127.0.0.1",
+		UserAgent: "Mozilla/5.0 (X11; Linux x86_64; rv:89.0) Gecko/20100101 Firefox/89.0",
+		Created:   time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC),
+		LastSeen:  time.Date(2021, 6, 1, 12, 30, 0, 0, time.UTC),
+		IsCurrent: true,
+	}
 	expectedCookieInfo := entity.CookieInfo{
-		UserID: expectedUser.UserID,
-		Cookie: &expectedCookie,
+		UserID:  expectedUser.UserID,
+		Cookie:  &expectedCookie,
+		Session: &expectedSession,
 	}
 
 	mockCookieApp.EXPECT().GenerateCookie().Return(&expectedCookie, nil).Times(1)
 	mockUserApp.EXPECT().CreateUser(gomock.Any()).Return(expectedUser.UserID, nil).Times(1)
-	mockWebsocketApp.EXPECT().ChangeToken(expectedUser.UserID, gomock.Any()).Return(nil).Times(1) // Adding notification token during user creation
+	mockWebsocketApp.EXPECT().AddToken(expectedUser.UserID, gomock.Any()).Return(nil).Times(1) // Adding notification token during user creation
 	mockCookieApp.EXPECT().AddCookieInfo(gomock.Any()).Return(nil).Times(1)
 
 	mockAuthApp.EXPECT().CheckCookie(gomock.Any()).Return(&expectedCookieInfo, true).Times(1)
-	mockAuthApp.EXPECT().LogoutUser(expectedUser.UserID).Return(nil).Times(1)
+	mockAuthApp.EXPECT().LogoutSession(gomock.Any()).Return(nil).Times(1)
+	mockWebsocketApp.EXPECT().EndSessions(expectedUser.UserID).Return(nil).Times(1) // Disconnecting client of logged out session
 
 	mockAuthApp.EXPECT().CheckCookie(gomock.Any()).Return(nil, false).Times(1)
-	mockAuthApp.EXPECT().CheckUserCredentials(expectedUser.Username, expectedUser.Password).Return(&expectedCookieInfo, nil).Times(1)
-	mockWebsocketApp.EXPECT().ChangeToken(expectedUser.UserID, gomock.Any()).Return(nil).Times(1) // Changing notification token during login
+	mockAuthApp.EXPECT().CheckUserCredentials(expectedUser.Username, expectedUser.Password, gomock.Any()).Return(&expectedCookieInfo, nil).Times(1)
+	mockWebsocketApp.EXPECT().AddToken(expectedUser.UserID, gomock.Any()).Return(nil).Times(1) // Adding notification token during login
+
+	mockAuthApp.EXPECT().CheckCookie(gomock.Any()).Return(&expectedCookieInfo, true).Times(1)
+	mockAuthApp.EXPECT().GetSessions(gomock.Any()).Return([]*entity.Session{&expectedSession}, nil).Times(1)
+
+	mockAuthApp.EXPECT().CheckCookie(gomock.Any()).Return(&expectedCookieInfo, true).Times(1)
+	mockAuthApp.EXPECT().LogoutOtherSessions(gomock.Any()).Return(nil).Times(1)
+	mockWebsocketApp.EXPECT().EndSessions(expectedUser.UserID).Return(nil).Times(1) // Disconnecting clients of other sessions
 
 	mockAuthApp.EXPECT().CheckCookie(gomock.Any()).Return(&expectedCookieInfo, true).Times(1)
 
@@ -443,9 +496,9 @@ func TestAuthFailure(t *testing.T) {
 		HttpOnly: true,
 	}
 
-	mockAuthApp.EXPECT().CheckUserCredentials(expectedUser.Username, gomock.Any()).Return(nil, entity.IncorrectPasswordError).Times(1) // Checking incorrect username/password pair
+	mockAuthApp.EXPECT().CheckUserCredentials(expectedUser.Username, gomock.Any(), gomock.Any()).Return(nil, entity.IncorrectPasswordError).Times(1) // Checking incorrect username/password pair
 
-	mockAuthApp.EXPECT().CheckUserCredentials(gomock.Any(), expectedUser.Password).Return(nil, entity.UserNotFoundError).Times(1) // Checking incorrect username/password pair
+	mockAuthApp.EXPECT().CheckUserCredentials(gomock.Any(), expectedUser.Password, gomock.Any()).Return(nil, entity.UserNotFoundError).Times(1) // Checking incorrect username/password pair
 
 	mockCookieApp.EXPECT().GenerateCookie().Return(&expectedCookie, nil).Times(1)
 	mockUserApp.EXPECT().CreateUser(gomock.Any()).Return(-1, entity.UsernameEmailDuplicateError).Times(1) // User creation with username conflict
@@ -2,6 +2,7 @@ package board
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"strconv"
@@ -16,14 +17,21 @@ import (
 )
 
 type BoardInfo struct {
-	boardApp application.BoardAppInterface
-	logger   *zap.Logger
+	boardApp        application.BoardAppInterface
+	followApp       application.FollowAppInterface
+	userApp         application.UserAppInterface
+	notificationApp application.NotificationAppInterface
+	logger          *zap.Logger
 }
 
-func NewBoardInfo(boardApp application.BoardAppInterface, logger *zap.Logger) *BoardInfo {
+func NewBoardInfo(boardApp application.BoardAppInterface, followApp application.FollowAppInterface,
+	userApp application.UserAppInterface, notificationApp application.NotificationAppInterface, logger *zap.Logger) *BoardInfo {
 	return &BoardInfo{
-		boardApp: boardApp,
-		logger:   logger,
+		boardApp:        boardApp,
+		followApp:       followApp,
+		userApp:         userApp,
+		notificationApp: notificationApp,
+		logger:          logger,
 	}
 }
 
@@ -48,6 +56,7 @@ func (boardInfo *BoardInfo) HandleCreateBoard(w http.ResponseWriter, r *http.Req
 		UserID:      userID,
 		Title:       currBoard.Title,
 		Description: currBoard.Description,
+		IsSecret:    currBoard.IsSecret,
 	}
 	boardInput.BoardID, err = boardInfo.boardApp.CreateBoard(boardInput)
 	if err != nil {
@@ -70,6 +79,85 @@ func (boardInfo *BoardInfo) HandleCreateBoard(w http.ResponseWriter, r *http.Req
 	w.Write(body)
 }
 
+// HandleEditBoard changes title, description, cover pin, archived and secret flags of user's board
+func (boardInfo *BoardInfo) HandleEditBoard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars[string(entity.IDKey)])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	boardInput := entity.BoardEditInput{}
+	err = json.Unmarshal(data, &boardInput)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	valid, _ := boardInput.Validate()
+	if !valid {
+		boardInfo.logger.Info(
+			entity.ValidationError.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = boardInfo.boardApp.CheckBoard(userID, boardID, string(entity.OwnerBoardRoleKey))
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		switch err {
+		case entity.BoardNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		case entity.CheckBoardOwnerError:
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	board := &entity.Board{
+		BoardID:     boardID,
+		UserID:      userID,
+		Title:       boardInput.Title,
+		Description: boardInput.Description,
+		CoverPinID:  boardInput.CoverPinID,
+		IsArchived:  boardInput.IsArchived,
+		IsSecret:    boardInput.IsSecret,
+	}
+	err = boardInfo.boardApp.UpdateBoard(board)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		switch err {
+		case entity.BoardNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		case entity.BoardTitleAlreadyExistsError:
+			w.WriteHeader(http.StatusConflict)
+		case entity.CoverPinNotInBoardError, entity.ArchiveInitBoardError:
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func (boardInfo *BoardInfo) HandleDelBoardByID(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	boardID, err := strconv.Atoi(vars[string(entity.IDKey)])
@@ -106,11 +194,16 @@ func (boardInfo *BoardInfo) HandleGetBoardByID(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	resultBoard, err := boardInfo.boardApp.GetBoard(boardId)
+	viewerID := 0 // Anonymous users can't see secret boards
+	if cookieInfo, found := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo); found {
+		viewerID = cookieInfo.UserID
+	}
+
+	resultBoard, err := boardInfo.boardApp.GetBoard(boardId, viewerID)
 	if err != nil {
 		boardInfo.logger.Info(
 			err.Error(), zap.String("url", r.RequestURI),
-			zap.String("method", r.Method))
+			zap.Int("for user", viewerID), zap.String("method", r.Method))
 		switch err {
 		case entity.BoardNotFoundError:
 			w.WriteHeader(http.StatusNotFound)
@@ -120,7 +213,34 @@ func (boardInfo *BoardInfo) HandleGetBoardByID(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	body, err := json.Marshal(resultBoard)
+	contributors, err := boardInfo.boardApp.GetCollaborators(boardId)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", viewerID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if contributors == nil {
+		contributors = make([]entity.BoardCollaborator, 0) // So that [] appears in json and not nil
+	}
+
+	sections, err := boardInfo.boardApp.GetSections(boardId)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", viewerID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if sections == nil {
+		sections = make([]entity.BoardSection, 0) // So that [] appears in json and not nil
+	}
+
+	boardOutput := entity.BoardDetailsOutput{Board: *resultBoard, Sections: sections, Contributors: contributors}
+	body, err := json.Marshal(boardOutput)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		return
@@ -139,7 +259,28 @@ func (boardInfo *BoardInfo) HandleGetBoardsByUserID(w http.ResponseWriter, r *ht
 		return
 	}
 
-	resultBoards, err := boardInfo.boardApp.GetBoards(userID)
+	viewerID := 0 // Anonymous users can only see boards of public accounts
+	if cookieInfo, found := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo); found {
+		viewerID = cookieInfo.UserID
+	}
+
+	err = boardInfo.followApp.CheckProfileAccess(viewerID, userID)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", viewerID), zap.String("method", r.Method))
+		switch err {
+		case entity.UserNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		case entity.PrivateAccountError:
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	resultBoards, err := boardInfo.boardApp.GetBoards(userID, viewerID)
 	if err != nil && err != entity.BoardsNotFoundError { // It's fine if no boards were found
 		boardInfo.logger.Info(
 			err.Error(), zap.String("url", r.RequestURI),
@@ -164,3 +305,549 @@ func (boardInfo *BoardInfo) HandleGetBoardsByUserID(w http.ResponseWriter, r *ht
 	w.WriteHeader(http.StatusOK)
 	w.Write(boardsBody)
 }
+
+// HandleInviteCollaborator invites user to current user's board as editor or viewer
+func (boardInfo *BoardInfo) HandleInviteCollaborator(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars[string(entity.IDKey)])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	invitationInput := new(entity.BoardInvitationInput)
+	err = json.NewDecoder(r.Body).Decode(invitationInput)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	isBlocked, err := boardInfo.followApp.CheckIfBlocked(userID, invitationInput.UserID)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if isBlocked {
+		boardInfo.logger.Info(
+			entity.UserBlockedError.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	err = boardInfo.boardApp.InviteCollaborator(userID, boardID, invitationInput.UserID, invitationInput.Role)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		switch err {
+		case entity.IncorrectBoardRoleError, entity.SelfInviteError:
+			w.WriteHeader(http.StatusBadRequest)
+		case entity.CheckBoardOwnerError:
+			w.WriteHeader(http.StatusForbidden)
+		case entity.BoardNotFoundError, entity.UserNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		case entity.CollaboratorAlreadyExistsError:
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	board, err := boardInfo.boardApp.GetBoard(boardID, userID)
+	if err == nil {
+		boardInfo.notifyAboutBoard(r, userID, invitationInput.UserID, board,
+			"New board invitation!", "User %s invites you to collaborate on board \"%s\"")
+	} else {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// HandleAcceptInvitation makes current user collaborator of board they were invited to
+func (boardInfo *BoardInfo) HandleAcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars[string(entity.IDKey)])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	err = boardInfo.boardApp.AcceptInvitation(userID, boardID)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		switch err {
+		case entity.InvitationNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	board, err := boardInfo.boardApp.GetBoard(boardID, userID)
+	if err == nil {
+		boardInfo.notifyAboutBoard(r, userID, board.UserID, board,
+			"Board invitation accepted!", "User %s is now collaborating on your board \"%s\"")
+	} else {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleRemoveCollaborator removes collaborator or pending invitation from board
+// Board's owner can remove anyone, other users can only leave board or reject their invitation
+func (boardInfo *BoardInfo) HandleRemoveCollaborator(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars[string(entity.IDKey)])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	collaboratorID, err := strconv.Atoi(vars[string(entity.MemberIDKey)])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	board, boardErr := boardInfo.boardApp.GetBoard(boardID, userID) // Collaborator who leaves secret board can't see it afterwards
+
+	err = boardInfo.boardApp.RemoveCollaborator(userID, boardID, collaboratorID)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		switch err {
+		case entity.CheckBoardOwnerError:
+			w.WriteHeader(http.StatusForbidden)
+		case entity.BoardNotFoundError, entity.CollaboratorNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	switch {
+	case boardErr != nil:
+		boardInfo.logger.Info(
+			boardErr.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+	case collaboratorID != userID:
+		boardInfo.notifyAboutBoard(r, userID, collaboratorID, board,
+			"Removed from board", "User %s removed you from board \"%s\"")
+	default:
+		boardInfo.notifyAboutBoard(r, userID, board.UserID, board,
+			"Collaborator left board", "User %s is no longer collaborating on your board \"%s\"")
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleGetInvitations returns boards current user was invited to, but has not joined yet
+func (boardInfo *BoardInfo) HandleGetInvitations(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	invitations, err := boardInfo.boardApp.GetInvitations(userID)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if invitations == nil {
+		invitations = make([]entity.BoardInvitation, 0) // So that [] appears in json and not nil
+	}
+
+	body, err := json.Marshal(entity.BoardInvitationsOutput{Invitations: invitations})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// HandleCreateSection adds section to the end of board, current user must be at least board's editor
+func (boardInfo *BoardInfo) HandleCreateSection(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars[string(entity.IDKey)])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	sectionInput := new(entity.BoardSectionInput)
+	err = json.NewDecoder(r.Body).Decode(sectionInput)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	valid, _ := sectionInput.Validate()
+	if !valid {
+		boardInfo.logger.Info(
+			entity.ValidationError.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	sectionID, err := boardInfo.boardApp.CreateSection(userID, boardID, sectionInput.Title)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		writeSectionError(w, err)
+		return
+	}
+
+	body, err := json.Marshal(entity.BoardSectionID{SectionID: sectionID})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	w.Write(body)
+}
+
+// HandleRenameSection changes title of board's section, current user must be at least board's editor
+func (boardInfo *BoardInfo) HandleRenameSection(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars[string(entity.IDKey)])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	sectionID, err := strconv.Atoi(vars[string(entity.SectionIDKey)])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	sectionInput := new(entity.BoardSectionInput)
+	err = json.NewDecoder(r.Body).Decode(sectionInput)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	valid, _ := sectionInput.Validate()
+	if !valid {
+		boardInfo.logger.Info(
+			entity.ValidationError.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = boardInfo.boardApp.RenameSection(userID, boardID, sectionID, sectionInput.Title)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		writeSectionError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleDeleteSection removes board's section, its pins stay on board without section
+func (boardInfo *BoardInfo) HandleDeleteSection(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars[string(entity.IDKey)])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	sectionID, err := strconv.Atoi(vars[string(entity.SectionIDKey)])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	err = boardInfo.boardApp.DeleteSection(userID, boardID, sectionID)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		writeSectionError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleMovePin moves board's pin to the end of another section of that board
+func (boardInfo *BoardInfo) HandleMovePin(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars[string(entity.IDKey)])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	pinID, err := strconv.Atoi(vars[string(entity.PinIDLabelKey)])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	moveInput := new(entity.PinMoveInput)
+	err = json.NewDecoder(r.Body).Decode(moveInput)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = boardInfo.boardApp.MovePin(userID, boardID, pinID, moveInput.SectionID)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		writeSectionError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleReorderBoard saves order of board's sections and pins after drag-and-drop
+// Order is rejected with 409 if board was changed since client fetched it
+func (boardInfo *BoardInfo) HandleReorderBoard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars[string(entity.IDKey)])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	orderInput := new(entity.BoardOrderInput)
+	err = json.NewDecoder(r.Body).Decode(orderInput)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	layoutVersion, err := boardInfo.boardApp.ReorderBoard(userID, boardID, orderInput)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		writeSectionError(w, err)
+		return
+	}
+
+	body, err := json.Marshal(entity.LayoutVersionOutput{LayoutVersion: layoutVersion})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// HandleFollowBoard makes current user follow someone else's board
+func (boardInfo *BoardInfo) HandleFollowBoard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars[string(entity.IDKey)])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	board, err := boardInfo.boardApp.GetBoard(boardID, userID)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		switch err {
+		case entity.BoardNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	err = boardInfo.followApp.CheckProfileAccess(userID, board.UserID)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		switch err {
+		case entity.PrivateAccountError:
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	isBlocked, err := boardInfo.followApp.CheckIfBlocked(userID, board.UserID)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if isBlocked {
+		boardInfo.logger.Info(
+			entity.UserBlockedError.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	err = boardInfo.boardApp.FollowBoard(userID, board)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		switch err {
+		case entity.SelfBoardFollowError:
+			w.WriteHeader(http.StatusBadRequest)
+		case entity.BoardNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		case entity.BoardFollowAlreadyExistsError:
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	boardInfo.notifyAboutBoard(r, userID, board.UserID, board,
+		"New board follower!", "User %s now follows your board \"%s\"")
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleUnfollowBoard makes current user stop following board
+func (boardInfo *BoardInfo) HandleUnfollowBoard(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	boardID, err := strconv.Atoi(vars[string(entity.IDKey)])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	err = boardInfo.boardApp.UnfollowBoard(userID, boardID)
+	if err != nil {
+		boardInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		switch err {
+		case entity.BoardFollowNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeSectionError writes status matching error of section or pin order operation
+func writeSectionError(w http.ResponseWriter, err error) {
+	switch err {
+	case entity.CheckBoardOwnerError:
+		w.WriteHeader(http.StatusForbidden)
+	case entity.BoardNotFoundError, entity.SectionNotFoundError, entity.PinNotInBoardError:
+		w.WriteHeader(http.StatusNotFound)
+	case entity.SectionTitleAlreadyExistsError, entity.BoardLayoutConflictError:
+		w.WriteHeader(http.StatusConflict)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// notifyAboutBoard sends notification about board to receiver, text is formatted with current user's username and board's title
+// Errors are only logged, as notifications are not essential
+func (boardInfo *BoardInfo) notifyAboutBoard(r *http.Request, currentUserID int, receiverID int, board *entity.Board, title string, textFormat string) {
+	user, err := boardInfo.userApp.GetUser(currentUserID)
+	if err != nil {
+		boardInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", currentUserID), zap.String("method", r.Method))
+		return
+	}
+
+	notification := &entity.Notification{
+		UserID:   receiverID,
+		Title:    title,
+		Category: "boards",
+		Text:     fmt.Sprintf(textFormat, user.Username, board.Title),
+		IsRead:   false,
+	}
+	notificationID, err := boardInfo.notificationApp.AddNotification(notification)
+	if err != nil {
+		boardInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", currentUserID), zap.String("method", r.Method))
+		return
+	}
+
+	boardInfo.notificationApp.SendNotification(receiverID, notificationID) // It's alright if notification could not be sent
+}
@@ -144,7 +144,17 @@ var boardTest = []struct {
 				`"avatarLink":"",` +
 				`"avatarHeight":0,` +
 				`"avatarWidth":0,` +
-				`"avatarAvgColor":""}`,
+				`"avatarAvgColor":"",` +
+				`"coverPinID":0,` +
+				`"isArchived":false,` +
+				`"isSecret":false,` +
+				`"layoutVersion":3,` +
+				`"followersCount":2,` +
+				`"sections":[{"ID":1,"title":"Recipes"}],` +
+				`"contributors":[{"userID":2,` +
+				`"username":"CollaboratorUsername",` +
+				`"avatarLink":"avatars/2",` +
+				`"role":"editor"}]}`,
 			),
 		},
 		"Testing get board by boardID",
@@ -169,7 +179,12 @@ var boardTest = []struct {
 				`"avatarLink":"",` +
 				`"avatarHeight":0,` +
 				`"avatarWidth":0,` +
-				`"avatarAvgColor":""},` +
+				`"avatarAvgColor":"",` +
+				`"coverPinID":0,` +
+				`"isArchived":false,` +
+				`"isSecret":false,` +
+				`"layoutVersion":0,` +
+				`"followersCount":0},` +
 				`{"ID":1,` +
 				`"userID":0,` +
 				`"title":"exampletitle2",` +
@@ -177,11 +192,33 @@ var boardTest = []struct {
 				`"avatarLink":"",` +
 				`"avatarHeight":0,` +
 				`"avatarWidth":0,` +
-				`"avatarAvgColor":""}]}`,
+				`"avatarAvgColor":"",` +
+				`"coverPinID":0,` +
+				`"isArchived":false,` +
+				`"isSecret":false,` +
+				`"layoutVersion":0,` +
+				`"followersCount":0}]}`,
 			),
 		},
 		"Testing get boards by user id",
 	},
+	{
+		InputStruct{
+			"/boards/2",
+			"/boards/{id:[0-9]+}",
+			"GET",
+			nil,
+			nil,
+			testBoardInfo.HandleGetBoardsByUserID,
+			middleware.AuthMid,
+		},
+		OutputStruct{
+			403,
+			nil,
+			nil,
+		},
+		"Testing get boards of private account by user who does not follow it",
+	},
 	{
 		InputStruct{
 			"/board/0",
@@ -218,6 +255,24 @@ var boardTest = []struct {
 		},
 		"Testing get not existent board by boardID",
 	},
+	{
+		InputStruct{
+			"/board/6",
+			"/board/{id:[0-9]+}",
+			"GET",
+			nil,
+			nil,
+			testBoardInfo.HandleGetBoardByID,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			404,
+			nil,
+			nil,
+		},
+		"Testing get secret board of other user",
+	},
 	{
 		InputStruct{
 			"/board/0",
@@ -236,6 +291,398 @@ var boardTest = []struct {
 		},
 		"Testing delete not existent board",
 	},
+	{
+		InputStruct{
+			"/board/1",
+			"/board/{id:[0-9]+}",
+			"PUT",
+			nil,
+			[]byte(`{"title":"newtitle","description":"newDescription","coverPinID":5,"isArchived":true}`),
+			testBoardInfo.HandleEditBoard,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			204,
+			nil,
+			nil,
+		},
+		"Testing edit board",
+	},
+	{
+		InputStruct{
+			"/board/1",
+			"/board/{id:[0-9]+}",
+			"PUT",
+			nil,
+			[]byte(`{"title":"","description":"newDescription"}`),
+			testBoardInfo.HandleEditBoard,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			400,
+			nil,
+			nil,
+		},
+		"Testing edit board with empty title",
+	},
+	{
+		InputStruct{
+			"/board/1",
+			"/board/{id:[0-9]+}",
+			"PUT",
+			nil,
+			[]byte(`{"title":"newtitle","coverPinID":1234}`),
+			testBoardInfo.HandleEditBoard,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			400,
+			nil,
+			nil,
+		},
+		"Testing choosing cover pin which is not on the board",
+	},
+	{
+		InputStruct{
+			"/board/4",
+			"/board/{id:[0-9]+}",
+			"PUT",
+			nil,
+			[]byte(`{"title":"newtitle"}`),
+			testBoardInfo.HandleEditBoard,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			403,
+			nil,
+			nil,
+		},
+		"Testing edit board of other user",
+	},
+	{
+		InputStruct{
+			"/board/1/collaborators",
+			"/board/{id:[0-9]+}/collaborators",
+			"POST",
+			nil,
+			[]byte(`{"userID":2,"role":"editor"}`),
+			testBoardInfo.HandleInviteCollaborator,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			201,
+			nil,
+			nil,
+		},
+		"Testing invite collaborator",
+	},
+	{
+		InputStruct{
+			"/board/1/collaborators",
+			"/board/{id:[0-9]+}/collaborators",
+			"POST",
+			nil,
+			[]byte(`{"userID":3,"role":"admin"}`),
+			testBoardInfo.HandleInviteCollaborator,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			400,
+			nil,
+			nil,
+		},
+		"Testing invite collaborator with incorrect role",
+	},
+	{
+		InputStruct{
+			"/board/1/collaborators",
+			"/board/{id:[0-9]+}/collaborators",
+			"POST",
+			nil,
+			[]byte(`{"userID":5,"role":"viewer"}`),
+			testBoardInfo.HandleInviteCollaborator,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			403,
+			nil,
+			nil,
+		},
+		"Testing invite blocked user",
+	},
+	{
+		InputStruct{
+			"/boards/invitations",
+			"/boards/invitations",
+			"GET",
+			nil,
+			nil,
+			testBoardInfo.HandleGetInvitations,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			200,
+			nil,
+			[]byte(`{"invitations":[{"board":{"ID":6,` +
+				`"userID":2,` +
+				`"title":"secrettitle",` +
+				`"description":"",` +
+				`"avatarLink":"",` +
+				`"avatarHeight":0,` +
+				`"avatarWidth":0,` +
+				`"avatarAvgColor":"",` +
+				`"coverPinID":0,` +
+				`"isArchived":false,` +
+				`"isSecret":true,` +
+				`"layoutVersion":0,` +
+				`"followersCount":0},` +
+				`"role":"viewer"}]}`,
+			),
+		},
+		"Testing get board invitations",
+	},
+	{
+		InputStruct{
+			"/board/6/invitation",
+			"/board/{id:[0-9]+}/invitation",
+			"PUT",
+			nil,
+			nil,
+			testBoardInfo.HandleAcceptInvitation,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			204,
+			nil,
+			nil,
+		},
+		"Testing accept board invitation",
+	},
+	{
+		InputStruct{
+			"/board/7/invitation",
+			"/board/{id:[0-9]+}/invitation",
+			"PUT",
+			nil,
+			nil,
+			testBoardInfo.HandleAcceptInvitation,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			404,
+			nil,
+			nil,
+		},
+		"Testing accept not existent board invitation",
+	},
+	{
+		InputStruct{
+			"/board/4/collaborators/2",
+			"/board/{id:[0-9]+}/collaborators/{memberID:[0-9]+}",
+			"DELETE",
+			nil,
+			nil,
+			testBoardInfo.HandleRemoveCollaborator,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			403,
+			nil,
+			nil,
+		},
+		"Testing remove collaborator from board of other user",
+	},
+	{
+		InputStruct{
+			"/board/1/sections",
+			"/board/{id:[0-9]+}/sections",
+			"POST",
+			nil,
+			[]byte(`{"title":"Recipes"}`),
+			testBoardInfo.HandleCreateSection,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			201,
+			nil,
+			[]byte(`{"ID":1}`),
+		},
+		"Testing create board section",
+	},
+	{
+		InputStruct{
+			"/board/1/sections",
+			"/board/{id:[0-9]+}/sections",
+			"POST",
+			nil,
+			[]byte(`{"title":""}`),
+			testBoardInfo.HandleCreateSection,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			400,
+			nil,
+			nil,
+		},
+		"Testing create board section with empty title",
+	},
+	{
+		InputStruct{
+			"/board/1/sections/1",
+			"/board/{id:[0-9]+}/sections/{sectionID:[0-9]+}",
+			"PUT",
+			nil,
+			[]byte(`{"title":"Desserts"}`),
+			testBoardInfo.HandleRenameSection,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			409,
+			nil,
+			nil,
+		},
+		"Testing rename board section to already used title",
+	},
+	{
+		InputStruct{
+			"/board/1/move/5",
+			"/board/{id:[0-9]+}/move/{pinID:[0-9]+}",
+			"PUT",
+			nil,
+			[]byte(`{"sectionID":1}`),
+			testBoardInfo.HandleMovePin,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			204,
+			nil,
+			nil,
+		},
+		"Testing move pin to board section",
+	},
+	{
+		InputStruct{
+			"/board/1/sections/1",
+			"/board/{id:[0-9]+}/sections/{sectionID:[0-9]+}",
+			"DELETE",
+			nil,
+			nil,
+			testBoardInfo.HandleDeleteSection,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			204,
+			nil,
+			nil,
+		},
+		"Testing delete board section",
+	},
+	{
+		InputStruct{
+			"/board/1/order",
+			"/board/{id:[0-9]+}/order",
+			"PUT",
+			nil,
+			[]byte(`{"layoutVersion":3,"pins":[{"pinID":5,"sectionID":0},{"pinID":4,"sectionID":0}],"sections":[]}`),
+			testBoardInfo.HandleReorderBoard,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			200,
+			nil,
+			[]byte(`{"layoutVersion":4}`),
+		},
+		"Testing reorder board",
+	},
+	{
+		InputStruct{
+			"/board/1/order",
+			"/board/{id:[0-9]+}/order",
+			"PUT",
+			nil,
+			[]byte(`{"layoutVersion":3,"pins":[{"pinID":4,"sectionID":0}],"sections":[]}`),
+			testBoardInfo.HandleReorderBoard,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			409,
+			nil,
+			nil,
+		},
+		"Testing reorder board which was changed by someone else",
+	},
+	{
+		InputStruct{
+			"/board/6/follow",
+			"/board/{id:[0-9]+}/follow",
+			"POST",
+			nil,
+			nil,
+			testBoardInfo.HandleFollowBoard,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			204,
+			nil,
+			nil,
+		},
+		"Testing follow board",
+	},
+	{
+		InputStruct{
+			"/board/1/follow",
+			"/board/{id:[0-9]+}/follow",
+			"POST",
+			nil,
+			nil,
+			testBoardInfo.HandleFollowBoard,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			400,
+			nil,
+			nil,
+		},
+		"Testing follow own board",
+	},
+	{
+		InputStruct{
+			"/board/4/follow",
+			"/board/{id:[0-9]+}/follow",
+			"DELETE",
+			nil,
+			nil,
+			testBoardInfo.HandleUnfollowBoard,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			404,
+			nil,
+			nil,
+		},
+		"Testing unfollow board which is not followed",
+	},
 }
 
 var successCookies []*http.Cookie
@@ -248,6 +695,8 @@ func TestBoards(t *testing.T) {
 	mockAuthApp := mock_application.NewMockAuthAppInterface(mockCtrl)
 	mockCookieApp := mock_application.NewMockCookieAppInterface(mockCtrl)
 	mockBoardApp := mock_application.NewMockBoardAppInterface(mockCtrl)
+	mockFollowApp := mock_application.NewMockFollowAppInterface(mockCtrl)
+	mockNotificationApp := mock_application.NewMockNotificationAppInterface(mockCtrl)
 	mockWebsocketApp := mock_application.NewMockWebsocketAppInterface(mockCtrl)
 	testLogger := zaptest.NewLogger(t)
 
@@ -293,10 +742,12 @@ func TestBoards(t *testing.T) {
 	}
 
 	boardInfo1 := entity.Board{
-		BoardID:     1,
-		UserID:      0,
-		Title:       "exampletitle2",
-		Description: "exampleDescription2",
+		BoardID:        1,
+		UserID:         0,
+		Title:          "exampletitle2",
+		Description:    "exampleDescription2",
+		LayoutVersion:  3,
+		FollowersCount: 2,
 	}
 	expectedUserBoards := []entity.Board{
 		expectedBoardFirst,
@@ -307,16 +758,101 @@ func TestBoards(t *testing.T) {
 
 	mockBoardApp.EXPECT().CreateBoard(gomock.Any()).Return(expectedBoardSecond.BoardID, nil).Times(1)
 
-	mockBoardApp.EXPECT().GetBoard(expectedBoardSecond.BoardID).Return(&boardInfo1, nil).Times(1)
+	mockBoardApp.EXPECT().GetBoard(expectedBoardSecond.BoardID, expectedUser.UserID).Return(&boardInfo1, nil).Times(1)
+	boardContributors := []entity.BoardCollaborator{{
+		UserID:     2,
+		Username:   "CollaboratorUsername",
+		AvatarLink: "avatars/2",
+		Role:       string(entity.EditorBoardRoleKey),
+	}}
+	mockBoardApp.EXPECT().GetCollaborators(expectedBoardSecond.BoardID).Return(boardContributors, nil).Times(1)
+	boardSections := []entity.BoardSection{{SectionID: 1, Title: "Recipes"}}
+	mockBoardApp.EXPECT().GetSections(expectedBoardSecond.BoardID).Return(boardSections, nil).Times(1)
+
+	mockFollowApp.EXPECT().CheckProfileAccess(expectedUser.UserID, expectedUser.UserID).Return(nil).Times(1)
+	mockBoardApp.EXPECT().GetBoards(expectedUser.UserID, expectedUser.UserID).Return(expectedUserBoards, nil).Times(1)
 
-	mockBoardApp.EXPECT().GetBoards(expectedUser.UserID).Return(expectedUserBoards, nil).Times(1)
+	mockFollowApp.EXPECT().CheckProfileAccess(expectedUser.UserID, 2).Return(entity.PrivateAccountError).Times(1)
 
 	mockBoardApp.EXPECT().DeleteBoard(expectedUser.UserID, expectedBoardFirst.BoardID).Return(nil).Times(1)
 
-	mockBoardApp.EXPECT().GetBoard(3).Return(nil, entity.BoardNotFoundError).Times(1)
+	mockBoardApp.EXPECT().GetBoard(3, expectedUser.UserID).Return(nil, entity.BoardNotFoundError).Times(1)
+
+	secretBoard := entity.Board{
+		BoardID:  6,
+		UserID:   2,
+		Title:    "secrettitle",
+		IsSecret: true,
+	}
+	mockBoardApp.EXPECT().GetBoard(secretBoard.BoardID, expectedUser.UserID).Return(nil, entity.BoardNotFoundError).Times(1)
+	mockBoardApp.EXPECT().GetBoard(secretBoard.BoardID, expectedUser.UserID).Return(&secretBoard, nil).Times(2) // After user joined it
 
 	mockBoardApp.EXPECT().DeleteBoard(expectedUser.UserID, expectedBoardFirst.BoardID).Return(entity.BoardNotFoundError).Times(1)
 
+	editedBoard := entity.Board{
+		BoardID:     expectedBoardSecond.BoardID,
+		UserID:      expectedUser.UserID,
+		Title:       "newtitle",
+		Description: "newDescription",
+		CoverPinID:  5,
+		IsArchived:  true,
+	}
+	mockBoardApp.EXPECT().CheckBoard(expectedUser.UserID, expectedBoardSecond.BoardID, string(entity.OwnerBoardRoleKey)).Return(nil).Times(2)
+	mockBoardApp.EXPECT().UpdateBoard(&editedBoard).Return(nil).Times(1)
+
+	mockBoardApp.EXPECT().UpdateBoard(gomock.Any()).Return(entity.CoverPinNotInBoardError).Times(1)
+
+	mockBoardApp.EXPECT().CheckBoard(expectedUser.UserID, 4, string(entity.OwnerBoardRoleKey)).Return(entity.CheckBoardOwnerError).Times(1)
+
+	mockFollowApp.EXPECT().CheckIfBlocked(expectedUser.UserID, 2).Return(false, nil).Times(1)
+	mockBoardApp.EXPECT().InviteCollaborator(expectedUser.UserID, expectedBoardSecond.BoardID, 2, string(entity.EditorBoardRoleKey)).Return(nil).Times(1)
+	mockBoardApp.EXPECT().GetBoard(expectedBoardSecond.BoardID, expectedUser.UserID).Return(&boardInfo1, nil).Times(1)
+	mockUserApp.EXPECT().GetUser(expectedUser.UserID).Return(&expectedUser, nil).Times(3)
+	mockNotificationApp.EXPECT().AddNotification(gomock.Any()).Return(0, nil).Times(3)
+	mockNotificationApp.EXPECT().SendNotification(2, 0).Return(nil).Times(3)
+
+	mockFollowApp.EXPECT().CheckIfBlocked(expectedUser.UserID, 3).Return(false, nil).Times(1)
+	mockBoardApp.EXPECT().InviteCollaborator(expectedUser.UserID, expectedBoardSecond.BoardID, 3, "admin").Return(entity.IncorrectBoardRoleError).Times(1)
+
+	mockFollowApp.EXPECT().CheckIfBlocked(expectedUser.UserID, 5).Return(true, nil).Times(1)
+
+	boardInvitations := []entity.BoardInvitation{{Board: secretBoard, Role: string(entity.ViewerBoardRoleKey)}}
+	mockBoardApp.EXPECT().GetInvitations(expectedUser.UserID).Return(boardInvitations, nil).Times(1)
+
+	mockBoardApp.EXPECT().AcceptInvitation(expectedUser.UserID, secretBoard.BoardID).Return(nil).Times(1)
+
+	mockBoardApp.EXPECT().AcceptInvitation(expectedUser.UserID, 7).Return(entity.InvitationNotFoundError).Times(1)
+
+	mockBoardApp.EXPECT().GetBoard(4, expectedUser.UserID).Return(&entity.Board{BoardID: 4, UserID: 3}, nil).Times(1) // Board is fetched before user may lose access to it
+	mockBoardApp.EXPECT().RemoveCollaborator(expectedUser.UserID, 4, 2).Return(entity.CheckBoardOwnerError).Times(1)
+
+	mockBoardApp.EXPECT().CreateSection(expectedUser.UserID, expectedBoardSecond.BoardID, "Recipes").Return(1, nil).Times(1)
+
+	mockBoardApp.EXPECT().RenameSection(expectedUser.UserID, expectedBoardSecond.BoardID, 1, "Desserts").Return(entity.SectionTitleAlreadyExistsError).Times(1)
+
+	mockBoardApp.EXPECT().MovePin(expectedUser.UserID, expectedBoardSecond.BoardID, 5, 1).Return(nil).Times(1)
+
+	mockBoardApp.EXPECT().DeleteSection(expectedUser.UserID, expectedBoardSecond.BoardID, 1).Return(nil).Times(1)
+
+	boardOrder := entity.BoardOrderInput{
+		LayoutVersion: 3,
+		Pins:          []entity.PinPlacement{{PinID: 5}, {PinID: 4}},
+		SectionIDs:    []int{},
+	}
+	mockBoardApp.EXPECT().ReorderBoard(expectedUser.UserID, expectedBoardSecond.BoardID, &boardOrder).Return(4, nil).Times(1)
+	mockBoardApp.EXPECT().ReorderBoard(expectedUser.UserID, expectedBoardSecond.BoardID, gomock.Any()).Return(-1, entity.BoardLayoutConflictError).Times(1)
+
+	mockFollowApp.EXPECT().CheckProfileAccess(expectedUser.UserID, secretBoard.UserID).Return(nil).Times(1)
+	mockFollowApp.EXPECT().CheckIfBlocked(expectedUser.UserID, secretBoard.UserID).Return(false, nil).Times(1)
+	mockBoardApp.EXPECT().FollowBoard(expectedUser.UserID, &secretBoard).Return(nil).Times(1)
+
+	mockBoardApp.EXPECT().GetBoard(expectedBoardSecond.BoardID, expectedUser.UserID).Return(&boardInfo1, nil).Times(1)
+	mockFollowApp.EXPECT().CheckProfileAccess(expectedUser.UserID, expectedUser.UserID).Return(nil).Times(1)
+	mockFollowApp.EXPECT().CheckIfBlocked(expectedUser.UserID, expectedUser.UserID).Return(false, nil).Times(1)
+	mockBoardApp.EXPECT().FollowBoard(expectedUser.UserID, &boardInfo1).Return(entity.SelfBoardFollowError).Times(1)
+
+	mockBoardApp.EXPECT().UnfollowBoard(expectedUser.UserID, 4).Return(entity.BoardFollowNotFoundError).Times(1)
+
 	testAuthInfo = *auth.NewAuthInfo(
 		mockUserApp,
 		mockAuthApp,
@@ -324,12 +860,16 @@ func TestBoards(t *testing.T) {
 		nil, // We don't need S3 in these tests
 		mockBoardApp,
 		mockWebsocketApp,
+		nil, // Requests come straight from clients in these tests
 		testLogger,
 	)
 
 	testBoardInfo = BoardInfo{
-		boardApp: mockBoardApp,
-		logger:   testLogger,
+		boardApp:        mockBoardApp,
+		followApp:       mockFollowApp,
+		userApp:         mockUserApp,
+		notificationApp: mockNotificationApp,
+		logger:          testLogger,
 	}
 	for _, tt := range boardTest {
 		tt := tt
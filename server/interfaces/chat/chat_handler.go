@@ -3,9 +3,9 @@ package chat
 import (
 	"encoding/json"
 	"net/http"
+	"path/filepath"
 	"pinterest/domain/entity"
 	"strconv"
-	"time"
 
 	"pinterest/application"
 
@@ -76,35 +76,225 @@ func (chatInfo *ChatInfo) HandleAddMessage(w http.ResponseWriter, r *http.Reques
 		}
 	}
 
-	chatID, err := chatInfo.chatApp.GetChatIDByUsers(userID, otherUserID)
-	chatExisted := true
+	messageInput := new(entity.MessageInput)
+	err = json.NewDecoder(r.Body).Decode(messageInput)
 	if err != nil {
-		if err != entity.ChatNotFoundError {
-			chatInfo.logger.Info(err.Error(),
-				zap.String("url", r.RequestURI),
-				zap.String("method", r.Method))
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	_, err = chatInfo.chatApp.PostMessage(userID, otherUserID, messageInput.MessageText, messageInput.Attachments)
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		switch err {
+		case entity.EmptyMessageError, entity.IncorrectAttachmentError, entity.TooManyAttachmentsError:
+			w.WriteHeader(http.StatusBadRequest)
+		case entity.UserNotFoundError, entity.PinNotFoundError, entity.BoardNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		case entity.UserBlockedError:
+			w.WriteHeader(http.StatusForbidden)
+		default:
 			w.WriteHeader(http.StatusInternalServerError)
-			return
 		}
+		return
+	}
 
-		chatID, err = chatInfo.chatApp.CreateChat(userID, otherUserID)
-		chatExisted = false
-		if err != nil {
-			chatInfo.logger.Info(err.Error(),
-				zap.String("url", r.RequestURI),
-				zap.String("method", r.Method))
-			switch err {
-			case entity.UserNotFoundError:
-				w.WriteHeader(http.StatusNotFound)
-			default:
-				w.WriteHeader(http.StatusInternalServerError)
-			}
-			return
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (chatInfo *ChatInfo) HandleReadChat(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	chatIDStr := vars[string(entity.IDKey)]
+	chatID, _ := strconv.Atoi(chatIDStr)
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	err := chatInfo.chatApp.ReadChat(chatID, userID)
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		switch err {
+		case entity.ChatNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		case entity.UserNotInChatError:
+			w.WriteHeader(http.StatusForbidden)
+		case entity.ChatAlreadyReadError:
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleCreateChat creates group chat with current user as its owner
+func (chatInfo *ChatInfo) HandleCreateChat(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	chatInput := new(entity.CreateChatInput)
+	err := json.NewDecoder(r.Body).Decode(chatInput)
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	chatID, err := chatInfo.chatApp.CreateGroupChat(userID, chatInput.Title, chatInput.MemberIDs)
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		switch err {
+		case entity.EmptyChatTitleError:
+			w.WriteHeader(http.StatusBadRequest)
+		case entity.UserNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		case entity.UserBlockedError:
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
 		}
+		return
 	}
 
+	body, err := json.Marshal(entity.ChatIDOutput{ChatID: chatID})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	w.Write(body)
+}
+
+// HandleAddChatMessage adds message to any chat current user is in
+func (chatInfo *ChatInfo) HandleAddChatMessage(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	chatID, _ := strconv.Atoi(vars[string(entity.IDKey)])
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
 	messageInput := new(entity.MessageInput)
-	err = json.NewDecoder(r.Body).Decode(messageInput)
+	err := json.NewDecoder(r.Body).Decode(messageInput)
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	_, err = chatInfo.chatApp.PostChatMessage(userID, chatID, messageInput.MessageText, messageInput.Attachments)
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		switch err {
+		case entity.EmptyMessageError, entity.IncorrectAttachmentError, entity.TooManyAttachmentsError:
+			w.WriteHeader(http.StatusBadRequest)
+		case entity.ChatNotFoundError, entity.PinNotFoundError, entity.BoardNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		case entity.UserNotInChatError, entity.UserBlockedError:
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// HandleUpdateChat changes group chat's title
+func (chatInfo *ChatInfo) HandleUpdateChat(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	chatID, _ := strconv.Atoi(vars[string(entity.IDKey)])
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	chatInput := new(entity.UpdateChatInput)
+	err := json.NewDecoder(r.Body).Decode(chatInput)
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = chatInfo.chatApp.UpdateGroupChat(userID, chatID, chatInput.Title)
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		w.WriteHeader(chatErrorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+const maxPostChatAvatarBodySize = 8 * 1024 * 1024 // 8 mB
+
+// HandleUpdateChatAvatar replaces group chat's avatar with one passed in "avatarImage" form field
+func (chatInfo *ChatInfo) HandleUpdateChatAvatar(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	chatID, _ := strconv.Atoi(vars[string(entity.IDKey)])
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	bodySize := r.ContentLength
+	if bodySize <= 0 || bodySize > maxPostChatAvatarBodySize {
+		chatInfo.logger.Info(entity.NoPicturePassed.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	r.ParseMultipartForm(bodySize)
+	file, header, err := r.FormFile("avatarImage")
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	err = chatInfo.chatApp.UpdateChatAvatar(userID, chatID, file, filepath.Ext(header.Filename))
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		w.WriteHeader(chatErrorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleAddChatMember adds user to group chat
+func (chatInfo *ChatInfo) HandleAddChatMember(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	chatID, _ := strconv.Atoi(vars[string(entity.IDKey)])
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	memberInput := new(entity.ChatMemberInput)
+	err := json.NewDecoder(r.Body).Decode(memberInput)
 	if err != nil {
 		chatInfo.logger.Info(err.Error(),
 			zap.String("url", r.RequestURI),
@@ -112,23 +302,93 @@ func (chatInfo *ChatInfo) HandleAddMessage(w http.ResponseWriter, r *http.Reques
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
-	if messageInput.MessageText == "" {
-		chatInfo.logger.Info("Passed message is empty",
+
+	err = chatInfo.chatApp.AddChatMember(userID, chatID, memberInput.UserID)
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		w.WriteHeader(chatErrorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// HandleRemoveChatMember removes user from group chat
+func (chatInfo *ChatInfo) HandleRemoveChatMember(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	chatID, _ := strconv.Atoi(vars[string(entity.IDKey)])
+	memberID, _ := strconv.Atoi(vars[string(entity.MemberIDKey)])
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	err := chatInfo.chatApp.RemoveChatMember(userID, chatID, memberID)
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		w.WriteHeader(chatErrorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleSetChatMemberRole changes role of group chat's member
+func (chatInfo *ChatInfo) HandleSetChatMemberRole(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	chatID, _ := strconv.Atoi(vars[string(entity.IDKey)])
+	memberID, _ := strconv.Atoi(vars[string(entity.MemberIDKey)])
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	roleInput := new(entity.ChatRoleInput)
+	err := json.NewDecoder(r.Body).Decode(roleInput)
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
 			zap.String("url", r.RequestURI),
 			zap.String("method", r.Method))
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
 
-	message := entity.Message{
-		MessageID:      0,
-		ChatID:         chatID,
-		AuthorID:       userID,
-		Text:           messageInput.MessageText,
-		TimeOfCreation: time.Now().String(),
+	err = chatInfo.chatApp.SetChatMemberRole(userID, chatID, memberID, roleInput.Role)
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		w.WriteHeader(chatErrorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleLeaveChat removes current user from group chat
+func (chatInfo *ChatInfo) HandleLeaveChat(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	chatID, _ := strconv.Atoi(vars[string(entity.IDKey)])
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	err := chatInfo.chatApp.LeaveChat(userID, chatID)
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		w.WriteHeader(chatErrorStatus(err))
+		return
 	}
 
-	messageID, err := chatInfo.chatApp.AddMessage(&message)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleGetChats returns all of current user's chats with their last messages and unread counts
+func (chatInfo *ChatInfo) HandleGetChats(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	chats, err := chatInfo.chatApp.GetChats(userID)
 	if err != nil {
 		chatInfo.logger.Info(err.Error(),
 			zap.String("url", r.RequestURI),
@@ -137,81 +397,244 @@ func (chatInfo *ChatInfo) HandleAddMessage(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if chatExisted { // If chat existed, we only send added message
-		err = chatInfo.chatApp.SendMessage(chatID, messageID, userID)
-		if err != nil {
-			if err != entity.ClientNotSetError {
-				chatInfo.logger.Info(err.Error(),
-					zap.String("url", r.RequestURI),
-					zap.String("method", r.Method))
-				w.WriteHeader(http.StatusInternalServerError)
-				return
-			}
+	body, err := json.Marshal(entity.ChatsListOutput{Chats: chats})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+const defaultMessagesLimit = 50
+const maxMessagesLimit = 100
+
+// HandleGetMessages returns page of chat's messages
+// "before" query parameter is ID of the oldest message client has (newest messages are returned if it is not passed),
+// "limit" is maximum amount of messages to return
+func (chatInfo *ChatInfo) HandleGetMessages(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	chatID, _ := strconv.Atoi(vars[string(entity.IDKey)])
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	queryParams := r.URL.Query()
+	beforeMessageID := 0
+	var err error
+	if beforeStr := queryParams.Get("before"); beforeStr != "" {
+		beforeMessageID, err = strconv.Atoi(beforeStr)
+		if err != nil || beforeMessageID < 0 {
+			chatInfo.logger.Info("before is not a correct message ID",
+				zap.String("url", r.RequestURI),
+				zap.String("method", r.Method))
+			w.WriteHeader(http.StatusBadRequest)
+			return
 		}
+	}
 
-		err = chatInfo.chatApp.SendMessage(chatID, messageID, otherUserID)
-		if err != nil {
-			if err != entity.ClientNotSetError {
-				chatInfo.logger.Info(err.Error(),
-					zap.String("url", r.RequestURI),
-					zap.String("method", r.Method))
-				w.WriteHeader(http.StatusInternalServerError)
-				return
-			}
+	limit := defaultMessagesLimit
+	if limitStr := queryParams.Get("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 || limit > maxMessagesLimit {
+			chatInfo.logger.Info("limit is not a correct number",
+				zap.String("url", r.RequestURI),
+				zap.String("method", r.Method))
+			w.WriteHeader(http.StatusBadRequest)
+			return
 		}
+	}
 
-		w.WriteHeader(http.StatusCreated)
+	messages, err := chatInfo.chatApp.GetMessages(userID, chatID, beforeMessageID, limit)
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		w.WriteHeader(chatErrorStatus(err))
 		return
 	}
 
-	err = chatInfo.chatApp.SendChat(chatID, otherUserID)
+	messagesOutput := entity.MessagesListOutput{Messages: make([]entity.Message, 0, len(messages))}
+	for _, message := range messages {
+		messagesOutput.Messages = append(messagesOutput.Messages, *message)
+	}
+	if len(messages) == limit { // There may be older messages
+		messagesOutput.NextBefore = messages[0].MessageID
+	}
+
+	body, err := json.Marshal(messagesOutput)
 	if err != nil {
-		if err != entity.ClientNotSetError {
-			chatInfo.logger.Info(err.Error(),
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+const defaultSearchLimit = 20
+const maxSearchLimit = 50
+
+// HandleSearchMessages finds messages of current user's chats that match "q" query parameter
+func (chatInfo *ChatInfo) HandleSearchMessages(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	queryParams := r.URL.Query()
+	beforeMessageID := 0
+	var err error
+	if beforeStr := queryParams.Get("before"); beforeStr != "" {
+		beforeMessageID, err = strconv.Atoi(beforeStr)
+		if err != nil || beforeMessageID < 0 {
+			chatInfo.logger.Info("before is not a correct message ID",
 				zap.String("url", r.RequestURI),
 				zap.String("method", r.Method))
-			w.WriteHeader(http.StatusInternalServerError)
+			w.WriteHeader(http.StatusBadRequest)
 			return
 		}
 	}
 
-	err = chatInfo.chatApp.SendChat(chatID, userID)
-	if err != nil {
-		if err != entity.ClientNotSetError {
-			chatInfo.logger.Info(err.Error(),
+	limit := defaultSearchLimit
+	if limitStr := queryParams.Get("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 || limit > maxSearchLimit {
+			chatInfo.logger.Info("limit is not a correct number",
 				zap.String("url", r.RequestURI),
 				zap.String("method", r.Method))
-			w.WriteHeader(http.StatusInternalServerError)
+			w.WriteHeader(http.StatusBadRequest)
 			return
 		}
 	}
-	w.WriteHeader(http.StatusCreated)
+
+	results, err := chatInfo.chatApp.SearchMessages(userID, queryParams.Get("q"), beforeMessageID, limit)
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		switch err {
+		case entity.EmptySearchQueryError:
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	searchOutput := entity.MessageSearchOutput{Results: results}
+	if len(results) == limit { // There may be older matches
+		searchOutput.NextBefore = results[len(results)-1].Message.MessageID
+	}
+
+	body, err := json.Marshal(searchOutput)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
 }
 
-func (chatInfo *ChatInfo) HandleReadChat(w http.ResponseWriter, r *http.Request) {
+// HandleEditMessage changes text of current user's message
+func (chatInfo *ChatInfo) HandleEditMessage(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	chatIDStr := vars[string(entity.IDKey)]
-	chatID, _ := strconv.Atoi(chatIDStr)
+	messageID, _ := strconv.Atoi(vars[string(entity.IDKey)])
 
 	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
 
-	err := chatInfo.chatApp.ReadChat(chatID, userID)
+	messageInput := new(entity.MessageInput)
+	err := json.NewDecoder(r.Body).Decode(messageInput)
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = chatInfo.chatApp.EditMessage(userID, messageID, messageInput.MessageText)
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		w.WriteHeader(chatErrorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleDeleteMessage replaces current user's message with tombstone
+func (chatInfo *ChatInfo) HandleDeleteMessage(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	messageID, _ := strconv.Atoi(vars[string(entity.IDKey)])
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	err := chatInfo.chatApp.DeleteMessage(userID, messageID)
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		w.WriteHeader(chatErrorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleGetPresence returns online status and last seen time of specified user
+func (chatInfo *ChatInfo) HandleGetPresence(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	targetID, _ := strconv.Atoi(vars[string(entity.IDKey)])
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	presence, err := chatInfo.chatApp.GetPresence(userID, targetID)
 	if err != nil {
 		chatInfo.logger.Info(err.Error(),
 			zap.String("url", r.RequestURI),
 			zap.String("method", r.Method))
 		switch err {
-		case entity.ChatNotFoundError:
+		case entity.UserNotFoundError:
 			w.WriteHeader(http.StatusNotFound)
-		case entity.UserNotInChatError:
+		case entity.PresenceForbiddenError:
 			w.WriteHeader(http.StatusForbidden)
-		case entity.ChatAlreadyReadError:
-			w.WriteHeader(http.StatusConflict)
 		default:
 			w.WriteHeader(http.StatusInternalServerError)
 		}
 		return
 	}
 
-	w.WriteHeader(http.StatusNoContent)
+	responseBody, err := json.Marshal(presence)
+	if err != nil {
+		chatInfo.logger.Info(err.Error(),
+			zap.String("url", r.RequestURI),
+			zap.String("method", r.Method))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBody)
+}
+
+// chatErrorStatus returns status code for errors of chat management operations
+func chatErrorStatus(err error) int {
+	switch err {
+	case entity.EmptyChatTitleError, entity.IncorrectChatRoleError, entity.NotGroupChatError, entity.EmptyMessageError:
+		return http.StatusBadRequest
+	case entity.ChatNotFoundError, entity.UserNotFoundError, entity.MessageNotFoundError:
+		return http.StatusNotFound
+	case entity.UserNotInChatError, entity.ChatPermissionError, entity.MessageAuthorError, entity.MessageChangeExpiredError,
+		entity.UserBlockedError:
+		return http.StatusForbidden
+	case entity.UserAlreadyInChatError, entity.MessageDeletedError:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
 }
@@ -0,0 +1,405 @@
+package chat
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"pinterest/application"
+	"pinterest/application/mock_application"
+	"pinterest/domain/entity"
+	"pinterest/interfaces/middleware"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+)
+
+type InputStruct struct {
+	url          string
+	urlForRouter string
+	method       string
+	headers      map[string][]string
+	postBody     []byte // JSON
+	chatFunc     func(w http.ResponseWriter, r *http.Request)
+	middleware   func(next http.HandlerFunc, authApp application.AuthAppInterface) http.HandlerFunc
+}
+
+// toHTTPRequest transforms InputStruct to http.Request, adding global cookies
+func (input *InputStruct) toHTTPRequest(cookies []*http.Cookie) *http.Request {
+	reqURL, _ := url.Parse("https://localhost:8080" + input.url) // Scheme (https://) is required for URL parsing
+	reqBody := bytes.NewBuffer(input.postBody)
+	request := &http.Request{
+		Method: input.method,
+		URL:    reqURL,
+		Header: input.headers,
+		Body:   ioutil.NopCloser(reqBody),
+	}
+
+	if (len(cookies) > 0) && (request.Header == nil) {
+		request.Header = make(http.Header)
+	}
+
+	for _, cookie := range cookies {
+		request.AddCookie(cookie)
+	}
+
+	return request
+}
+
+type OutputStruct struct {
+	responseCode int
+	headers      map[string][]string
+	postBody     []byte // JSON
+}
+
+// fillFromResponse transforms http.Response to OutputStruct
+func (output *OutputStruct) fillFromResponse(response *http.Response) error {
+	output.responseCode = response.StatusCode
+	output.headers = response.Header
+	if len(output.headers) == 0 {
+		output.headers = nil
+	}
+	var err error
+	output.postBody, err = ioutil.ReadAll(response.Body)
+	if len(output.postBody) == 0 {
+		output.postBody = nil
+	}
+	return err
+}
+
+var testChatInfo ChatInfo
+
+var chatTest = []struct {
+	in   InputStruct
+	out  OutputStruct
+	name string
+}{
+	{
+		InputStruct{
+			"/chats/1/messages?limit=2",
+			"/chats/{id:[0-9]+}/messages",
+			"GET",
+			nil,
+			nil,
+			testChatInfo.HandleGetMessages,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			200,
+			nil,
+			[]byte(`{"messages":[{"ID":2,"chatID":1,"authorID":2,"text":"How are you?","addingTime":"",` +
+				`"isDeleted":false},{"ID":3,"chatID":1,"authorID":1,"text":"Fine","addingTime":"",` +
+				`"isDeleted":false}],"nextBefore":2}`),
+		},
+		"Testing get newest page of chat's messages",
+	},
+	{
+		InputStruct{
+			"/chats/1/messages?before=2&limit=2",
+			"/chats/{id:[0-9]+}/messages",
+			"GET",
+			nil,
+			nil,
+			testChatInfo.HandleGetMessages,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			200,
+			nil,
+			[]byte(`{"messages":[{"ID":1,"chatID":1,"authorID":2,"text":"Hi","addingTime":"",` +
+				`"isDeleted":false}]}`),
+		},
+		"Testing get last page of chat's messages",
+	},
+	{
+		InputStruct{
+			"/chats/1/messages?limit=500",
+			"/chats/{id:[0-9]+}/messages",
+			"GET",
+			nil,
+			nil,
+			testChatInfo.HandleGetMessages,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			400,
+			nil,
+			nil,
+		},
+		"Testing get messages with too big limit",
+	},
+	{
+		InputStruct{
+			"/chats/1/messages?before=first",
+			"/chats/{id:[0-9]+}/messages",
+			"GET",
+			nil,
+			nil,
+			testChatInfo.HandleGetMessages,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			400,
+			nil,
+			nil,
+		},
+		"Testing get messages before incorrect message ID",
+	},
+	{
+		InputStruct{
+			"/chats/2/messages",
+			"/chats/{id:[0-9]+}/messages",
+			"GET",
+			nil,
+			nil,
+			testChatInfo.HandleGetMessages,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			403,
+			nil,
+			nil,
+		},
+		"Testing get messages of chat user is not in",
+	},
+	{
+		InputStruct{
+			"/chats/1/members",
+			"/chats/{id:[0-9]+}/members",
+			"POST",
+			nil,
+			[]byte(`{"userID":5}`),
+			testChatInfo.HandleAddChatMember,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			201,
+			nil,
+			nil,
+		},
+		"Testing add member to group chat",
+	},
+	{
+		InputStruct{
+			"/chats/3/members",
+			"/chats/{id:[0-9]+}/members",
+			"POST",
+			nil,
+			[]byte(`{"userID":5}`),
+			testChatInfo.HandleAddChatMember,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			403,
+			nil,
+			nil,
+		},
+		"Testing add member to group chat as regular member",
+	},
+	{
+		InputStruct{
+			"/chats/1/members/5",
+			"/chats/{id:[0-9]+}/members/{memberID:[0-9]+}",
+			"PUT",
+			nil,
+			[]byte(`{"role":"admin"}`),
+			testChatInfo.HandleSetChatMemberRole,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			204,
+			nil,
+			nil,
+		},
+		"Testing make member an admin",
+	},
+	{
+		InputStruct{
+			"/chats/1/members/5",
+			"/chats/{id:[0-9]+}/members/{memberID:[0-9]+}",
+			"PUT",
+			nil,
+			[]byte(`{"role":"king"}`),
+			testChatInfo.HandleSetChatMemberRole,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			400,
+			nil,
+			nil,
+		},
+		"Testing set unknown role",
+	},
+	{
+		InputStruct{
+			"/chats/3/members/6",
+			"/chats/{id:[0-9]+}/members/{memberID:[0-9]+}",
+			"DELETE",
+			nil,
+			nil,
+			testChatInfo.HandleRemoveChatMember,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			403,
+			nil,
+			nil,
+		},
+		"Testing remove admin as another admin",
+	},
+	{
+		InputStruct{
+			"/chats/read/1",
+			"/chats/read/{id:[0-9]+}",
+			"PUT",
+			nil,
+			nil,
+			testChatInfo.HandleReadChat,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			204,
+			nil,
+			nil,
+		},
+		"Testing read chat",
+	},
+	{
+		InputStruct{
+			"/chats/read/1",
+			"/chats/read/{id:[0-9]+}",
+			"PUT",
+			nil,
+			nil,
+			testChatInfo.HandleReadChat,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			409,
+			nil,
+			nil,
+		},
+		"Testing read chat that was read already",
+	},
+	{
+		InputStruct{
+			"/presence/4",
+			"/presence/{id:[0-9]+}",
+			"GET",
+			nil,
+			nil,
+			testChatInfo.HandleGetPresence,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			403,
+			nil,
+			nil,
+		},
+		"Testing get presence of user who blocked current user",
+	},
+}
+
+var successCookies []*http.Cookie
+
+func TestChats(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockUserApp := mock_application.NewMockUserAppInterface(mockCtrl)
+	mockAuthApp := mock_application.NewMockAuthAppInterface(mockCtrl)
+	mockChatApp := mock_application.NewMockChatAppInterface(mockCtrl)
+	testLogger := zaptest.NewLogger(t)
+
+	expectedUser := entity.User{
+		UserID:   1,
+		Username: "TestUsername",
+	}
+	expectedCookie := http.Cookie{
+		Name:     string(entity.CookieNameKey),
+		Value:    "someRandomSessionValue",
+		Path:     "/", // Cookie should be usable on entire website
+		Expires:  time.Now().Add(10 * time.Hour),
+		HttpOnly: true,
+	}
+	expectedCookieInfo := entity.CookieInfo{
+		UserID: expectedUser.UserID,
+		Cookie: &expectedCookie,
+	}
+
+	successCookies = nil
+	successCookies = append(successCookies, &expectedCookie)
+
+	mockAuthApp.EXPECT().CheckCookie(gomock.Any()).Return(&expectedCookieInfo, true).AnyTimes() // User is never logged out during these tests
+
+	firstMessage := entity.Message{MessageID: 1, ChatID: 1, AuthorID: 2, Text: "Hi"}
+	secondMessage := entity.Message{MessageID: 2, ChatID: 1, AuthorID: 2, Text: "How are you?"}
+	thirdMessage := entity.Message{MessageID: 3, ChatID: 1, AuthorID: expectedUser.UserID, Text: "Fine"}
+
+	mockChatApp.EXPECT().GetMessages(expectedUser.UserID, 1, 0, 2).
+		Return([]*entity.Message{&secondMessage, &thirdMessage}, nil).Times(1)
+	mockChatApp.EXPECT().GetMessages(expectedUser.UserID, 1, secondMessage.MessageID, 2).
+		Return([]*entity.Message{&firstMessage}, nil).Times(1)
+	mockChatApp.EXPECT().GetMessages(expectedUser.UserID, 2, 0, defaultMessagesLimit).
+		Return(nil, entity.UserNotInChatError).Times(1)
+
+	mockChatApp.EXPECT().AddChatMember(expectedUser.UserID, 1, 5).Return(nil).Times(1)
+	mockChatApp.EXPECT().AddChatMember(expectedUser.UserID, 3, 5).Return(entity.ChatPermissionError).Times(1)
+	mockChatApp.EXPECT().SetChatMemberRole(expectedUser.UserID, 1, 5, string(entity.AdminChatRoleKey)).Return(nil).Times(1)
+	mockChatApp.EXPECT().SetChatMemberRole(expectedUser.UserID, 1, 5, "king").Return(entity.IncorrectChatRoleError).Times(1)
+	mockChatApp.EXPECT().RemoveChatMember(expectedUser.UserID, 3, 6).Return(entity.ChatPermissionError).Times(1)
+
+	mockChatApp.EXPECT().ReadChat(1, expectedUser.UserID).Return(nil).Times(1)
+	mockChatApp.EXPECT().ReadChat(1, expectedUser.UserID).Return(entity.ChatAlreadyReadError).Times(1)
+
+	mockChatApp.EXPECT().GetPresence(expectedUser.UserID, 4).Return(nil, entity.PresenceForbiddenError).Times(1)
+
+	testChatInfo = *NewChatnfo(mockChatApp, mockUserApp, testLogger)
+	for _, tt := range chatTest {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.in.toHTTPRequest(successCookies)
+
+			rw := httptest.NewRecorder() // not ResponseWriter because we need to read response
+			m := mux.NewRouter()
+			funcToHandle := tt.in.chatFunc
+			if tt.in.middleware != nil { // We don't always need middleware
+				funcToHandle = tt.in.middleware(funcToHandle, mockAuthApp)
+			}
+			m.HandleFunc(tt.in.urlForRouter, funcToHandle).Methods(tt.in.method)
+			m.ServeHTTP(rw, req)
+			resp := rw.Result()
+
+			var result OutputStruct
+			result.fillFromResponse(resp)
+
+			require.Equal(t, tt.out.responseCode, result.responseCode,
+				fmt.Sprintf("Expected: %d as response code\nbut got:  %d",
+					tt.out.responseCode, result.responseCode))
+			require.Equal(t, tt.out.postBody, result.postBody,
+				fmt.Sprintf("Expected: %v as response body\nbut got:  %v",
+					string(tt.out.postBody), string(result.postBody)))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package comment
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"pinterest/domain/entity"
@@ -15,21 +16,32 @@ import (
 )
 
 type CommentInfo struct {
-	commentApp application.CommentAppInterface
-	pinApp     application.PinAppInterface
-	logger     *zap.Logger
+	commentApp      application.CommentAppInterface
+	pinApp          application.PinAppInterface
+	userApp         application.UserAppInterface
+	followApp       application.FollowAppInterface
+	notificationApp application.NotificationAppInterface
+	logger          *zap.Logger
 }
 
 func NewCommentInfo(commentApp application.CommentAppInterface,
 	pinApp application.PinAppInterface,
+	userApp application.UserAppInterface,
+	followApp application.FollowAppInterface,
+	notificationApp application.NotificationAppInterface,
 	logger *zap.Logger) *CommentInfo {
 	return &CommentInfo{
-		commentApp: commentApp,
-		pinApp:     pinApp,
-		logger:     logger,
+		commentApp:      commentApp,
+		pinApp:          pinApp,
+		userApp:         userApp,
+		followApp:       followApp,
+		notificationApp: notificationApp,
+		logger:          logger,
 	}
 }
 
+// HandleAddComment adds comment to pin, or a reply if "parentID" is passed
+// Author of replied comment and users mentioned as "@username" are notified
 func (commentInfo *CommentInfo) HandleAddComment(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	pinID, err := strconv.Atoi(vars[string(entity.IDKey)])
@@ -61,23 +73,28 @@ func (commentInfo *CommentInfo) HandleAddComment(w http.ResponseWriter, r *http.
 		UserID:     userID,
 		PinID:      pinID,
 		PinComment: currComment.PinComment,
+		ParentID:   currComment.ParentID,
 	}
 
-	err = commentInfo.commentApp.AddComment(resultComment)
+	resultComment.CommentID, err = commentInfo.commentApp.AddComment(resultComment)
 	if err != nil {
 		commentInfo.logger.Info(
 			err.Error(), zap.String("url", r.RequestURI),
 			zap.Int("for user", userID), zap.String("method", r.Method))
 		switch err {
-		case entity.PinNotFoundError:
+		case entity.PinNotFoundError, entity.ParentCommentNotFoundError:
 			w.WriteHeader(http.StatusNotFound)
+		case entity.UserBlockedError:
+			w.WriteHeader(http.StatusForbidden)
 		default:
 			w.WriteHeader(http.StatusInternalServerError)
 		}
 		return
 	}
 
-	comment := entity.CommentTextOutput{Text: currComment.PinComment}
+	commentInfo.sendCommentNotifications(r, resultComment)
+
+	comment := entity.CommentTextOutput{CommentID: resultComment.CommentID, Text: currComment.PinComment}
 	body, err := json.Marshal(comment)
 	if err != nil {
 		commentInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
@@ -90,6 +107,12 @@ func (commentInfo *CommentInfo) HandleAddComment(w http.ResponseWriter, r *http.
 	w.Write(body)
 }
 
+const defaultCommentsLimit = 20
+const maxCommentsLimit = 50
+
+// HandleGetComments returns page of pin's comment threads with their first replies nested in them
+// "sort" query parameter is "newest" (default), "oldest" or "most-replied",
+// "cursor" is nextCursor of previous page, "limit" is maximum amount of threads to return
 func (commentInfo *CommentInfo) HandleGetComments(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	pinID, err := strconv.Atoi(vars[string(entity.IDKey)])
@@ -99,16 +122,69 @@ func (commentInfo *CommentInfo) HandleGetComments(w http.ResponseWriter, r *http
 		return
 	}
 
-	pinComments, err := commentInfo.commentApp.GetComments(pinID)
-	if err != nil && err != entity.CommentsNotFoundError {
+	viewerID := 0 // Anonymous users can't see comments on secret pins
+	if cookieInfo, found := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo); found {
+		viewerID = cookieInfo.UserID
+	}
+
+	_, err = commentInfo.pinApp.GetPin(pinID, viewerID)
+	if err != nil {
 		commentInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
-		w.WriteHeader(http.StatusNotFound)
+		switch err {
+		case entity.PinNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
 		return
 	}
 
-	comments := entity.CommentsOutput{Comments: pinComments}
+	queryParams := r.URL.Query()
+	page := entity.CommentsPageInput{
+		Sort:  string(entity.NewestCommentsSortKey),
+		Limit: defaultCommentsLimit,
+	}
+	if sort := queryParams.Get("sort"); sort != "" {
+		switch sort {
+		case string(entity.NewestCommentsSortKey), string(entity.OldestCommentsSortKey), string(entity.MostRepliedCommentsSortKey):
+			page.Sort = sort
+		default:
+			commentInfo.logger.Info(entity.CommentsSortError.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	if cursor := queryParams.Get("cursor"); cursor != "" {
+		page.After, err = entity.ParseCommentsCursor(cursor)
+		if err != nil {
+			commentInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	if limitStr := queryParams.Get("limit"); limitStr != "" {
+		page.Limit, err = strconv.Atoi(limitStr)
+		if err != nil || page.Limit <= 0 || page.Limit > maxCommentsLimit {
+			commentInfo.logger.Info("limit is not a correct number", zap.String("url", r.RequestURI), zap.String("method", r.Method))
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	comments, err := commentInfo.commentApp.GetComments(pinID, &page)
+	if err != nil {
+		if err != entity.CommentsNotFoundError {
+			commentInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		comments = &entity.CommentsOutput{}
+	}
+
 	if comments.Comments == nil {
-		comments.Comments = make([]entity.Comment, 0)
+		comments.Comments = make([]entity.Comment, 0) // So that [] appears in json and not nil
 	}
 
 	body, err := json.Marshal(comments)
@@ -122,3 +198,241 @@ func (commentInfo *CommentInfo) HandleGetComments(w http.ResponseWriter, r *http
 	w.WriteHeader(http.StatusOK)
 	w.Write(body)
 }
+
+const defaultRepliesLimit = 20
+const maxRepliesLimit = 50
+
+// HandleGetReplies returns page of thread's replies, oldest first, ID in the URL is ID of thread's first comment
+// "cursor" query parameter is nextCursor of previous page, "limit" is maximum amount of replies to return
+func (commentInfo *CommentInfo) HandleGetReplies(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	threadID, err := strconv.Atoi(vars[string(entity.IDKey)])
+	if err != nil {
+		commentInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	viewerID := 0 // Anonymous users can't see comments on secret pins
+	if cookieInfo, found := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo); found {
+		viewerID = cookieInfo.UserID
+	}
+
+	thread, err := commentInfo.commentApp.GetComment(threadID)
+	if err == nil {
+		_, err = commentInfo.pinApp.GetPin(thread.PinID, viewerID)
+	}
+	if err != nil {
+		commentInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
+		switch err {
+		case entity.CommentNotFoundError, entity.PinNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	queryParams := r.URL.Query()
+	page := entity.RepliesPageInput{Limit: defaultRepliesLimit}
+	if cursor := queryParams.Get("cursor"); cursor != "" {
+		page.After, err = entity.ParseCommentsCursor(cursor)
+		if err != nil {
+			commentInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	if limitStr := queryParams.Get("limit"); limitStr != "" {
+		page.Limit, err = strconv.Atoi(limitStr)
+		if err != nil || page.Limit <= 0 || page.Limit > maxRepliesLimit {
+			commentInfo.logger.Info("limit is not a correct number", zap.String("url", r.RequestURI), zap.String("method", r.Method))
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	replies, err := commentInfo.commentApp.GetReplies(threadID, &page)
+	if err != nil {
+		commentInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
+		switch err {
+		case entity.CommentNotFoundError: // Comment is a reply itself
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	body, err := json.Marshal(replies)
+	if err != nil {
+		commentInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// HandleEditComment changes text of current user's comment
+func (commentInfo *CommentInfo) HandleEditComment(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	commentID, err := strconv.Atoi(vars[string(entity.IDKey)])
+	if err != nil {
+		commentInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	commentInput := new(entity.CommentEditInput)
+	err = json.NewDecoder(r.Body).Decode(commentInput)
+	if err != nil {
+		commentInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	valid, _ := commentInput.Validate()
+	if !valid {
+		commentInfo.logger.Info(
+			entity.ValidationError.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = commentInfo.commentApp.EditComment(userID, commentID, commentInput.Text)
+	if err != nil {
+		commentInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		switch err {
+		case entity.CommentNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		case entity.CheckCommentOwnerError:
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleDeleteComment deletes comment written by current user or left on current user's pin
+func (commentInfo *CommentInfo) HandleDeleteComment(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	commentID, err := strconv.Atoi(vars[string(entity.IDKey)])
+	if err != nil {
+		commentInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	err = commentInfo.commentApp.DeleteComment(userID, commentID)
+	if err != nil {
+		commentInfo.logger.Info(
+			err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		switch err {
+		case entity.CommentNotFoundError, entity.PinNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		case entity.CheckCommentOwnerError:
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// sendCommentNotifications notifies author of replied comment and users mentioned in comment
+// Comment's author, users who blocked or were blocked by them and users who can't see commented pin
+// (it is on secret boards or its author's account is private) are not notified, nobody is notified twice
+func (commentInfo *CommentInfo) sendCommentNotifications(r *http.Request, comment *entity.Comment) {
+	mentions := entity.FindMentions(comment.PinComment)
+	if comment.ParentID == 0 && len(mentions) == 0 {
+		return
+	}
+
+	author, err := commentInfo.userApp.GetUser(comment.UserID)
+	if err != nil {
+		commentInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", comment.UserID), zap.String("method", r.Method))
+		return
+	}
+
+	pin, err := commentInfo.pinApp.GetPin(comment.PinID, comment.UserID)
+	if err != nil {
+		commentInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", comment.UserID), zap.String("method", r.Method))
+		return
+	}
+
+	notifiedUsers := map[int]bool{author.UserID: true}
+	notify := func(receiverID int, title string, text string) {
+		if notifiedUsers[receiverID] {
+			return
+		}
+		notifiedUsers[receiverID] = true
+
+		isBlocked, err := commentInfo.followApp.CheckIfBlocked(author.UserID, receiverID)
+		if err != nil || isBlocked {
+			return
+		}
+
+		if commentInfo.pinApp.CheckPinVisibility(receiverID, pin) != nil ||
+			commentInfo.followApp.CheckProfileAccess(receiverID, pin.UserID) != nil {
+			return
+		}
+
+		notification := &entity.Notification{
+			UserID:   receiverID,
+			Title:    title,
+			Category: "comments",
+			Text:     text,
+			IsRead:   false,
+		}
+		notificationID, err := commentInfo.notificationApp.AddNotification(notification)
+		if err != nil {
+			commentInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+				zap.Int("for user", receiverID), zap.String("method", r.Method))
+			return
+		}
+
+		commentInfo.notificationApp.SendNotification(receiverID, notificationID) // It's alright if notification could not be sent
+	}
+
+	if comment.ParentID != 0 {
+		parentComment, err := commentInfo.commentApp.GetComment(comment.ParentID)
+		if err == nil {
+			notify(parentComment.UserID, "New reply to your comment!",
+				fmt.Sprintf(`User %s replied to your comment: "%s"`, author.Username, comment.PinComment))
+		} else {
+			commentInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+				zap.Int("for user", comment.UserID), zap.String("method", r.Method))
+		}
+	}
+
+	for _, username := range mentions {
+		mentionedUser, err := commentInfo.userApp.GetUserByUsername(username)
+		if err != nil { // Text looked like a mention, but there is no such user
+			continue
+		}
+
+		notify(mentionedUser.UserID, "You were mentioned in a comment!",
+			fmt.Sprintf(`User %s mentioned you in a comment: "%s"`, author.Username, comment.PinComment))
+	}
+}
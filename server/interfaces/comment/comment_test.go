@@ -98,7 +98,7 @@ var commentTest = []struct {
 		OutputStruct{
 			201,
 			nil,
-			[]byte(`{"text":"Hello, my friends!!!"}`),
+			[]byte(`{"ID":1,"text":"Hello, my friends!!!"}`),
 		},
 		"Testing add first comment",
 	},
@@ -116,10 +116,64 @@ var commentTest = []struct {
 		OutputStruct{
 			201,
 			nil,
-			[]byte(`{"text":"Welcome to the club, buddy!!!"}`),
+			[]byte(`{"ID":2,"text":"Welcome to the club, buddy!!!"}`),
 		},
 		"Testing add second comment",
 	},
+	{
+		InputStruct{
+			"/comment/1",
+			"/comment/{id:[0-9]+}",
+			"POST",
+			nil,
+			[]byte(`{"parentID":5,"text":"@ThirdUser @UnknownUser @SecretFan look at this"}`),
+			testCommentInfo.HandleAddComment,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			201,
+			nil,
+			[]byte(`{"ID":6,"text":"@ThirdUser @UnknownUser @SecretFan look at this"}`),
+		},
+		"Testing add reply with mentions",
+	},
+	{
+		InputStruct{
+			"/comment/1",
+			"/comment/{id:[0-9]+}",
+			"POST",
+			nil,
+			[]byte(`{"parentID":7,"text":"Reply to nothing"}`),
+			testCommentInfo.HandleAddComment,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			404,
+			nil,
+			nil,
+		},
+		"Testing add reply to not existent comment",
+	},
+	{
+		InputStruct{
+			"/comment/1",
+			"/comment/{id:[0-9]+}",
+			"POST",
+			nil,
+			[]byte(`{"pinID":1,"text":"Hello again!!!"}`),
+			testCommentInfo.HandleAddComment,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			403,
+			nil,
+			nil,
+		},
+		"Testing add comment to pin of user who blocked commenter",
+	},
 	{
 		InputStruct{
 			"/comments/3",
@@ -152,12 +206,94 @@ var commentTest = []struct {
 		OutputStruct{
 			200,
 			nil,
-			[]byte(`{"comments":[{"userID":0,"pinID":1,"text":"Hello, my friends!!!"},` +
-				`{"userID":0,"pinID":1,"text":"Welcome to the club, buddy!!!"}]}`,
+			[]byte(`{"comments":[{"ID":1,"userID":0,"pinID":1,"text":"Hello, my friends!!!",` +
+				`"creationDate":"2021-12-01T10:00:00Z","repliesCount":1,` +
+				`"replies":[{"ID":3,"userID":1,"pinID":1,"text":"Thanks, @TestUsername!",` +
+				`"creationDate":"2021-12-01T10:02:00Z","parentID":1,"repliesCount":0,` +
+				`"username":"OtherUser","avatarLink":"avatars/2"}],` +
+				`"username":"TestUsername","avatarLink":"avatars/1"},` +
+				`{"ID":2,"userID":0,"pinID":1,"text":"Welcome to the club, buddy!!!",` +
+				`"creationDate":"2021-12-01T10:05:00Z","editDate":"2021-12-01T10:06:00Z","repliesCount":0,` +
+				`"username":"TestUsername","avatarLink":"avatars/1"}],"totalCount":3}`,
 			),
 		},
 		"Testing get comments by pinID",
 	},
+	{
+		InputStruct{
+			"/comments/1?sort=most-replied&limit=1",
+			"/comments/{id:[0-9]+}",
+			"GET",
+			nil,
+			nil,
+			testCommentInfo.HandleGetComments,
+			nil,
+		},
+
+		OutputStruct{
+			200,
+			nil,
+			[]byte(`{"comments":[{"ID":2,"userID":0,"pinID":1,"text":"Welcome to the club, buddy!!!",` +
+				`"creationDate":"2021-12-01T10:05:00Z","editDate":"2021-12-01T10:06:00Z","repliesCount":0,` +
+				`"username":"TestUsername","avatarLink":"avatars/1"}],"totalCount":3,"nextCursor":"Mjow"}`,
+			),
+		},
+		"Testing get first page of comments sorted by replies",
+	},
+	{
+		InputStruct{
+			"/comments/1?sort=most-replied&limit=1&cursor=Mjow",
+			"/comments/{id:[0-9]+}",
+			"GET",
+			nil,
+			nil,
+			testCommentInfo.HandleGetComments,
+			nil,
+		},
+
+		OutputStruct{
+			200,
+			nil,
+			[]byte(`{"comments":[],"totalCount":3}`),
+		},
+		"Testing get last page of comments sorted by replies",
+	},
+	{
+		InputStruct{
+			"/comments/1?sort=popular",
+			"/comments/{id:[0-9]+}",
+			"GET",
+			nil,
+			nil,
+			testCommentInfo.HandleGetComments,
+			nil,
+		},
+
+		OutputStruct{
+			400,
+			nil,
+			nil,
+		},
+		"Testing get comments with unknown sort order",
+	},
+	{
+		InputStruct{
+			"/comments/1?cursor=notacursor",
+			"/comments/{id:[0-9]+}",
+			"GET",
+			nil,
+			nil,
+			testCommentInfo.HandleGetComments,
+			nil,
+		},
+
+		OutputStruct{
+			400,
+			nil,
+			nil,
+		},
+		"Testing get comments with broken cursor",
+	},
 	{
 		InputStruct{
 			"/comments/2",
@@ -172,10 +308,157 @@ var commentTest = []struct {
 		OutputStruct{
 			200,
 			nil,
-			[]byte(`{"comments":[]}`),
+			[]byte(`{"comments":[],"totalCount":0}`),
 		},
 		"Testing get not existent comments by pinID",
 	},
+	{
+		InputStruct{
+			"/comments/4",
+			"/comments/{id:[0-9]+}",
+			"GET",
+			nil,
+			nil,
+			testCommentInfo.HandleGetComments,
+			nil,
+		},
+
+		OutputStruct{
+			404,
+			nil,
+			nil,
+		},
+		"Testing get comments of secret pin",
+	},
+	{
+		InputStruct{
+			"/comment/1/replies?limit=1",
+			"/comment/{id:[0-9]+}/replies",
+			"GET",
+			nil,
+			nil,
+			testCommentInfo.HandleGetReplies,
+			nil,
+		},
+
+		OutputStruct{
+			200,
+			nil,
+			[]byte(`{"comments":[{"ID":3,"userID":1,"pinID":1,"text":"Thanks, @TestUsername!",` +
+				`"creationDate":"2021-12-01T10:02:00Z","parentID":1,"repliesCount":0,` +
+				`"username":"OtherUser","avatarLink":"avatars/2"}],"totalCount":1,"nextCursor":"Mzow"}`,
+			),
+		},
+		"Testing get page of thread's replies",
+	},
+	{
+		InputStruct{
+			"/comment/3/replies",
+			"/comment/{id:[0-9]+}/replies",
+			"GET",
+			nil,
+			nil,
+			testCommentInfo.HandleGetReplies,
+			nil,
+		},
+
+		OutputStruct{
+			404,
+			nil,
+			nil,
+		},
+		"Testing get replies of reply",
+	},
+	{
+		InputStruct{
+			"/comment/2",
+			"/comment/{id:[0-9]+}",
+			"PUT",
+			nil,
+			[]byte(`{"text":"Welcome to the club!!!"}`),
+			testCommentInfo.HandleEditComment,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			204,
+			nil,
+			nil,
+		},
+		"Testing edit comment",
+	},
+	{
+		InputStruct{
+			"/comment/2",
+			"/comment/{id:[0-9]+}",
+			"PUT",
+			nil,
+			[]byte(`{"text":""}`),
+			testCommentInfo.HandleEditComment,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			400,
+			nil,
+			nil,
+		},
+		"Testing edit comment with empty text",
+	},
+	{
+		InputStruct{
+			"/comment/3",
+			"/comment/{id:[0-9]+}",
+			"PUT",
+			nil,
+			[]byte(`{"text":"Not my comment"}`),
+			testCommentInfo.HandleEditComment,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			403,
+			nil,
+			nil,
+		},
+		"Testing edit comment of other user",
+	},
+	{
+		InputStruct{
+			"/comment/1",
+			"/comment/{id:[0-9]+}",
+			"DELETE",
+			nil,
+			nil,
+			testCommentInfo.HandleDeleteComment,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			204,
+			nil,
+			nil,
+		},
+		"Testing delete comment",
+	},
+	{
+		InputStruct{
+			"/comment/4",
+			"/comment/{id:[0-9]+}",
+			"DELETE",
+			nil,
+			nil,
+			testCommentInfo.HandleDeleteComment,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			404,
+			nil,
+			nil,
+		},
+		"Testing delete not existent comment",
+	},
 }
 
 var successCookies []*http.Cookie
@@ -189,6 +472,8 @@ func TestComments(t *testing.T) {
 	mockCookieApp := mock_application.NewMockCookieAppInterface(mockCtrl)
 	mockPinApp := mock_application.NewMockPinAppInterface(mockCtrl)
 	mockCommentApp := mock_application.NewMockCommentAppInterface(mockCtrl)
+	mockFollowApp := mock_application.NewMockFollowAppInterface(mockCtrl)
+	mockNotificationApp := mock_application.NewMockNotificationAppInterface(mockCtrl)
 	mockWebsocketApp := mock_application.NewMockWebsocketAppInterface(mockCtrl)
 	testLogger := zaptest.NewLogger(t)
 
@@ -233,27 +518,122 @@ func TestComments(t *testing.T) {
 		Description: "exampleDescription",
 	}
 
+	reply := entity.Comment{
+		CommentID:    3,
+		UserID:       1,
+		PinID:        1,
+		PinComment:   "Thanks, @TestUsername!",
+		CreationDate: time.Date(2021, 12, 1, 10, 2, 0, 0, time.UTC),
+		ParentID:     1,
+		Username:     "OtherUser",
+		AvatarLink:   "avatars/2",
+	}
+
 	comment1 := entity.Comment{
-		UserID:     0,
-		PinID:      1,
-		PinComment: "Hello, my friends!!!",
+		CommentID:    1,
+		UserID:       0,
+		PinID:        1,
+		PinComment:   "Hello, my friends!!!",
+		CreationDate: time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC),
+		RepliesCount: 1,
+		Replies:      []entity.Comment{reply},
+		Username:     expectedUser.Username,
+		AvatarLink:   expectedUser.Avatar,
 	}
 
+	editDate := time.Date(2021, 12, 1, 10, 6, 0, 0, time.UTC)
 	comment2 := entity.Comment{
-		UserID:     0,
+		CommentID:    2,
+		UserID:       0,
+		PinID:        1,
+		PinComment:   "Welcome to the club, buddy!!!",
+		CreationDate: time.Date(2021, 12, 1, 10, 5, 0, 0, time.UTC),
+		EditDate:     &editDate,
+		Username:     expectedUser.Username,
+		AvatarLink:   expectedUser.Avatar,
+	}
+
+	expectedComments := entity.CommentsOutput{Comments: []entity.Comment{comment1, comment2}, TotalCount: 3}
+	defaultPage := entity.CommentsPageInput{Sort: string(entity.NewestCommentsSortKey), Limit: defaultCommentsLimit}
+	mostRepliedPage := entity.CommentsPageInput{Sort: string(entity.MostRepliedCommentsSortKey), Limit: 1}
+	nextMostRepliedPage := mostRepliedPage
+	nextMostRepliedPage.After = entity.CommentsCursor{CommentID: comment2.CommentID, RepliesCount: comment2.RepliesCount}
+
+	mockCommentApp.EXPECT().AddComment(gomock.Any()).Return(comment1.CommentID, nil).Times(1)
+	mockCommentApp.EXPECT().AddComment(gomock.Any()).Return(comment2.CommentID, nil).Times(1)
+
+	repliedComment := entity.Comment{
+		CommentID:  5,
+		UserID:     1,
 		PinID:      1,
-		PinComment: "Welcome to the club, buddy!!!",
+		PinComment: "Is anyone here?",
 	}
+	repliedUser := entity.User{UserID: 1, Username: "OtherUser"}
+	mentionedUser := entity.User{UserID: 2, Username: "ThirdUser"}
+	secretFan := entity.User{UserID: 3, Username: "SecretFan"} // Can't see pin, so is not notified
+
+	mockCommentApp.EXPECT().AddComment(gomock.Any()).Return(6, nil).Times(1)
+	mockUserApp.EXPECT().GetUser(expectedUser.UserID).Return(&expectedUser, nil).Times(1)
+	mockCommentApp.EXPECT().GetComment(repliedComment.CommentID).Return(&repliedComment, nil).Times(1)
+	mockUserApp.EXPECT().GetUserByUsername(mentionedUser.Username).Return(&mentionedUser, nil).Times(1)
+	mockUserApp.EXPECT().GetUserByUsername("UnknownUser").Return(nil, entity.UserNotFoundError).Times(1)
+	mockUserApp.EXPECT().GetUserByUsername(secretFan.Username).Return(&secretFan, nil).Times(1)
+	mockFollowApp.EXPECT().CheckIfBlocked(expectedUser.UserID, secretFan.UserID).Return(false, nil).Times(1)
+	mockFollowApp.EXPECT().CheckIfBlocked(expectedUser.UserID, repliedUser.UserID).Return(false, nil).Times(1)
+	mockFollowApp.EXPECT().CheckIfBlocked(expectedUser.UserID, mentionedUser.UserID).Return(false, nil).Times(1)
+	mockNotificationApp.EXPECT().AddNotification(gomock.Any()).Return(1, nil).Times(1)
+	mockNotificationApp.EXPECT().SendNotification(repliedUser.UserID, 1).Return(nil).Times(1)
+	mockNotificationApp.EXPECT().AddNotification(gomock.Any()).Return(2, nil).Times(1)
+	mockNotificationApp.EXPECT().SendNotification(mentionedUser.UserID, 2).Return(nil).Times(1)
+
+	mockCommentApp.EXPECT().AddComment(gomock.Any()).Return(-1, entity.ParentCommentNotFoundError).Times(1)
+
+	mockCommentApp.EXPECT().AddComment(gomock.Any()).Return(-1, entity.UserBlockedError).Times(1)
+
+	secretPin := entity.Pin{PinID: 4, UserID: 1, IsSecret: true}
+
+	mockPinApp.EXPECT().GetPin(3, expectedUser.UserID).Return(nil, entity.PinNotFoundError).Times(1)
+	mockPinApp.EXPECT().GetPin(expectedPinFirst.PinID, expectedUser.UserID).Return(&expectedPinFirst, nil).Times(8)
+	mockPinApp.EXPECT().GetPin(expectedPinSecond.PinID, expectedUser.UserID).Return(&expectedPinSecond, nil).Times(1)
+	mockPinApp.EXPECT().GetPin(secretPin.PinID, expectedUser.UserID).Return(nil, entity.PinNotFoundError).Times(1) // Pins service hides it from user
+	mockPinApp.EXPECT().CheckPinVisibility(repliedUser.UserID, &expectedPinFirst).Return(nil).Times(1)
+	mockPinApp.EXPECT().CheckPinVisibility(mentionedUser.UserID, &expectedPinFirst).Return(nil).Times(1)
+	mockPinApp.EXPECT().CheckPinVisibility(secretFan.UserID, &expectedPinFirst).Return(entity.PinNotFoundError).Times(1)
+	mockFollowApp.EXPECT().CheckProfileAccess(repliedUser.UserID, expectedPinFirst.UserID).Return(nil).Times(1)
+	mockFollowApp.EXPECT().CheckProfileAccess(mentionedUser.UserID, expectedPinFirst.UserID).Return(nil).Times(1)
+
+	mockCommentApp.EXPECT().GetComments(expectedPinFirst.PinID, &defaultPage).Return(&expectedComments, nil)
+
+	mockCommentApp.EXPECT().GetComments(expectedPinFirst.PinID, &mostRepliedPage).Return(&entity.CommentsOutput{
+		Comments:   []entity.Comment{comment2},
+		TotalCount: 3,
+		NextCursor: nextMostRepliedPage.After.String(),
+	}, nil).Times(1)
+
+	mockCommentApp.EXPECT().GetComments(expectedPinFirst.PinID, &nextMostRepliedPage).Return(&entity.CommentsOutput{
+		Comments:   []entity.Comment{},
+		TotalCount: 3,
+	}, nil).Times(1)
+
+	mockCommentApp.EXPECT().GetComments(expectedPinSecond.PinID, &defaultPage).Return(&entity.CommentsOutput{}, nil)
+
+	mockCommentApp.EXPECT().GetComment(comment1.CommentID).Return(&comment1, nil).Times(1)
+	mockCommentApp.EXPECT().GetReplies(comment1.CommentID, &entity.RepliesPageInput{Limit: 1}).Return(&entity.CommentsOutput{
+		Comments:   []entity.Comment{reply},
+		TotalCount: 1,
+		NextCursor: entity.CommentsCursor{CommentID: reply.CommentID}.String(),
+	}, nil).Times(1)
 
-	expectedComments := []entity.Comment{comment1, comment2}
+	mockCommentApp.EXPECT().GetComment(reply.CommentID).Return(&reply, nil).Times(1)
+	mockCommentApp.EXPECT().GetReplies(reply.CommentID, &entity.RepliesPageInput{Limit: defaultRepliesLimit}).Return(nil, entity.CommentNotFoundError).Times(1)
 
-	mockCommentApp.EXPECT().AddComment(gomock.Any()).Return(nil).Times(2)
+	mockCommentApp.EXPECT().EditComment(expectedUser.UserID, comment2.CommentID, "Welcome to the club!!!").Return(nil).Times(1)
 
-	mockCommentApp.EXPECT().GetComments(3).Return(nil, entity.PinNotFoundError).Times(1)
+	mockCommentApp.EXPECT().EditComment(expectedUser.UserID, 3, "Not my comment").Return(entity.CheckCommentOwnerError).Times(1)
 
-	mockCommentApp.EXPECT().GetComments(expectedPinFirst.PinID).Return(expectedComments, nil)
+	mockCommentApp.EXPECT().DeleteComment(expectedUser.UserID, comment1.CommentID).Return(nil).Times(1)
 
-	mockCommentApp.EXPECT().GetComments(expectedPinSecond.PinID).Return([]entity.Comment{}, nil)
+	mockCommentApp.EXPECT().DeleteComment(expectedUser.UserID, 4).Return(entity.CommentNotFoundError).Times(1)
 
 	testAuthInfo = *auth.NewAuthInfo(
 		mockUserApp,
@@ -262,12 +642,16 @@ func TestComments(t *testing.T) {
 		nil, // We don't need S3 or board in these tests
 		nil,
 		mockWebsocketApp,
+		nil, // Requests come straight from clients in these tests
 		testLogger)
 
 	testCommentInfo = CommentInfo{
-		pinApp:     mockPinApp,
-		commentApp: mockCommentApp,
-		logger:     testLogger,
+		pinApp:          mockPinApp,
+		commentApp:      mockCommentApp,
+		userApp:         mockUserApp,
+		followApp:       mockFollowApp,
+		notificationApp: mockNotificationApp,
+		logger:          testLogger,
 	}
 	for _, tt := range commentTest {
 		tt := tt
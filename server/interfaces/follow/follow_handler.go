@@ -70,41 +70,60 @@ func (followInfo *FollowInfo) HandleFollowProfile(w http.ResponseWriter, r *http
 	}
 
 	followedID := followedUser.UserID
-	err = followInfo.followApp.Follow(followerID, followedID)
+	isRequested, err := followInfo.followApp.Follow(followerID, followedID)
 	if err != nil {
 		followInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
 			zap.Int("for user", followerID), zap.String("method", r.Method))
-		if err == entity.FollowAlreadyExistsError {
+		switch err {
+		case entity.FollowAlreadyExistsError, entity.FollowRequestAlreadyExistsError:
 			w.WriteHeader(http.StatusConflict)
-			return
+		case entity.UserBlockedError:
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
 		}
-		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
 	followerUser, err := followInfo.userApp.GetUser(followerID)
 	if err == nil {
-		notificationID, err := followInfo.notificationApp.AddNotification(&entity.Notification{
+		notification := &entity.Notification{
 			UserID:   followedID,
 			Title:    "New follower!",
 			Category: "followers",
 			Text:     "You have received a new follower: " + followerUser.Username,
 			IsRead:   false,
-		})
-		if err == nil {
-			followInfo.notificationApp.SendNotification(followedID, notificationID) // It's alright if notification could not be sent
-		} else {
-			followInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
-				zap.Int("for user", followerID), zap.String("method", r.Method))
 		}
+		if isRequested {
+			notification.Title = "New follow request!"
+			notification.Text = "User " + followerUser.Username + " wants to follow you"
+		}
+
+		followInfo.notifyUser(r, followerID, notification)
 	} else {
 		followInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
 			zap.Int("for user", followerID), zap.String("method", r.Method))
 	}
 
+	if isRequested {
+		w.WriteHeader(http.StatusAccepted) // Follow will only happen after request is accepted
+		return
+	}
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// notifyUser adds notification and sends it to its user, logging errors on behalf of current user
+func (followInfo *FollowInfo) notifyUser(r *http.Request, currentUserID int, notification *entity.Notification) {
+	notificationID, err := followInfo.notificationApp.AddNotification(notification)
+	if err != nil {
+		followInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", currentUserID), zap.String("method", r.Method))
+		return
+	}
+
+	followInfo.notificationApp.SendNotification(notification.UserID, notificationID) // It's alright if notification could not be sent
+}
+
 func (followInfo *FollowInfo) HandleUnfollowProfile(w http.ResponseWriter, r *http.Request) {
 	var followedUser *entity.User = nil
 	var err error
@@ -181,11 +200,202 @@ func (followInfo *FollowInfo) HandleUnfollowProfile(w http.ResponseWriter, r *ht
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// HandleBlockProfile makes current user block user with passed ID
+func (followInfo *FollowInfo) HandleBlockProfile(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	blockedID, _ := strconv.Atoi(vars[string(entity.IDKey)])
+	blockerID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	err := followInfo.followApp.Block(blockerID, blockedID)
+	if err != nil {
+		followInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", blockerID), zap.String("method", r.Method))
+		switch err {
+		case entity.SelfBlockError:
+			w.WriteHeader(http.StatusBadRequest)
+		case entity.UserNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		case entity.BlockAlreadyExistsError:
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleUnblockProfile makes current user unblock user with passed ID
+func (followInfo *FollowInfo) HandleUnblockProfile(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	blockedID, _ := strconv.Atoi(vars[string(entity.IDKey)])
+	blockerID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	err := followInfo.followApp.Unblock(blockerID, blockedID)
+	if err != nil {
+		followInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", blockerID), zap.String("method", r.Method))
+		switch err {
+		case entity.SelfBlockError:
+			w.WriteHeader(http.StatusBadRequest)
+		case entity.BlockNotFoundError:
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleGetFollowRequests returns everyone who requested to follow current user
+func (followInfo *FollowInfo) HandleGetFollowRequests(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	requesters, err := followInfo.followApp.GetFollowRequests(userID)
+	if err != nil {
+		followInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	usersOutput := new(entity.UserListOutput)
+
+	for _, user := range requesters {
+		var userOutput entity.UserOutput
+		userOutput.FillFromUser(&user)
+		userOutput.Email = "" // Emails are private and should not be passed to unrelated users
+		usersOutput.Users = append(usersOutput.Users, userOutput)
+	}
+
+	if usersOutput.Users == nil {
+		usersOutput.Users = make([]entity.UserOutput, 0) // So that [] appears in json and not nil
+	}
+
+	responseBody, err := json.Marshal(usersOutput)
+	if err != nil {
+		followInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBody)
+}
+
+// HandleAcceptFollowRequest makes user with passed ID follow current user, if they requested it
+func (followInfo *FollowInfo) HandleAcceptFollowRequest(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	followerID, _ := strconv.Atoi(vars[string(entity.IDKey)])
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	err := followInfo.followApp.AcceptFollowRequest(userID, followerID)
+	if err != nil {
+		followInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		switch err {
+		case entity.FollowRequestNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		case entity.FollowAlreadyExistsError:
+			w.WriteHeader(http.StatusConflict)
+		case entity.UserBlockedError:
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	user, err := followInfo.userApp.GetUser(userID)
+	if err == nil {
+		followInfo.notifyUser(r, userID, &entity.Notification{
+			UserID:   followerID,
+			Title:    "Follow request accepted!",
+			Category: "followers",
+			Text:     "You are now following " + user.Username,
+			IsRead:   false,
+		})
+	} else {
+		followInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleRejectFollowRequest removes request of user with passed ID to follow current user
+func (followInfo *FollowInfo) HandleRejectFollowRequest(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	followerID, _ := strconv.Atoi(vars[string(entity.IDKey)])
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	err := followInfo.followApp.RejectFollowRequest(userID, followerID)
+	if err != nil {
+		followInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		switch err {
+		case entity.FollowRequestNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	user, err := followInfo.userApp.GetUser(userID)
+	if err == nil {
+		followInfo.notifyUser(r, userID, &entity.Notification{
+			UserID:   followerID,
+			Title:    "Follow request rejected",
+			Category: "followers",
+			Text:     user.Username + " has rejected your follow request",
+			IsRead:   false,
+		})
+	} else {
+		followInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// checkProfileAccess writes error status and returns false if current user (if any) can't see content of user with passed ID
+func (followInfo *FollowInfo) checkProfileAccess(w http.ResponseWriter, r *http.Request, ownerID int) bool {
+	viewerID := 0 // Anonymous users can only see content of public accounts
+	if cookieInfo, found := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo); found {
+		viewerID = cookieInfo.UserID
+	}
+
+	err := followInfo.followApp.CheckProfileAccess(viewerID, ownerID)
+	if err != nil {
+		followInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
+		switch err {
+		case entity.UserNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		case entity.PrivateAccountError:
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return false
+	}
+
+	return true
+}
+
 func (followInfo *FollowInfo) HandleGetFollowers(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	idStr := vars[string(entity.IDKey)]
 	id, _ := strconv.Atoi(idStr)
 
+	if !followInfo.checkProfileAccess(w, r, id) {
+		return
+	}
+
 	followers, err := followInfo.followApp.GetAllFollowers(id)
 	if err != nil && err != entity.UsersNotFoundError { // No followers is a normal situation
 		followInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
@@ -228,6 +438,10 @@ func (followInfo *FollowInfo) HandleGetFollowed(w http.ResponseWriter, r *http.R
 	idStr := vars[string(entity.IDKey)]
 	id, _ := strconv.Atoi(idStr)
 
+	if !followInfo.checkProfileAccess(w, r, id) {
+		return
+	}
+
 	followedUsers, err := followInfo.followApp.GetAllFollowed(id)
 	if err != nil && err != entity.UsersNotFoundError { // No followed users is a normal situation
 		followInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
@@ -215,6 +215,159 @@ var followTestSuccess = []struct {
 		},
 		"Testing getting list of followers",
 	},
+	{
+		followInputStruct{
+			"/block/1",
+			"/block/{id:[0-9]+}",
+			"POST",
+			nil,
+			nil,
+			testFollowInfo.HandleBlockProfile,
+			middleware.AuthMid,
+		},
+
+		followOutputStruct{
+			204,
+			nil,
+			nil,
+		},
+		"Testing blocking other profile",
+	},
+	{
+		followInputStruct{
+			"/follow/1",
+			"/follow/{id:[0-9]+}",
+			"POST",
+			nil,
+			nil,
+			testFollowInfo.HandleFollowProfile,
+			middleware.AuthMid,
+		},
+
+		followOutputStruct{
+			403,
+			nil,
+			nil,
+		},
+		"Testing following blocked profile",
+	},
+	{
+		followInputStruct{
+			"/block/1",
+			"/block/{id:[0-9]+}",
+			"DELETE",
+			nil,
+			nil,
+			testFollowInfo.HandleUnblockProfile,
+			middleware.AuthMid,
+		},
+
+		followOutputStruct{
+			204,
+			nil,
+			nil,
+		},
+		"Testing unblocking other profile",
+	},
+	{
+		followInputStruct{
+			"/block/0",
+			"/block/{id:[0-9]+}",
+			"POST",
+			nil,
+			nil,
+			testFollowInfo.HandleBlockProfile,
+			middleware.AuthMid,
+		},
+
+		followOutputStruct{
+			400,
+			nil,
+			nil,
+		},
+		"Testing blocking own profile",
+	},
+	{
+		followInputStruct{
+			"/follow/1",
+			"/follow/{id:[0-9]+}",
+			"POST",
+			nil,
+			nil,
+			testFollowInfo.HandleFollowProfile,
+			middleware.AuthMid,
+		},
+
+		followOutputStruct{
+			202,
+			nil,
+			nil,
+		},
+		"Testing requesting to follow private profile",
+	},
+	{
+		followInputStruct{
+			"/follow/requests",
+			"/follow/requests",
+			"GET",
+			nil,
+			nil,
+			testFollowInfo.HandleGetFollowRequests,
+			middleware.AuthMid,
+		},
+
+		followOutputStruct{
+			200,
+			nil,
+			[]byte(`{"profiles":[{"ID":1,` +
+				`"username":"OtherUsername",` +
+				`"firstName":"Other first name",` +
+				`"lastName":"Other last name",` +
+				`"avatarLink":"avatars/someotherpath",` +
+				`"following":0,` +
+				`"followers":0,` +
+				`"boardsCount":0,` +
+				`"pinsCount":0}]}`,
+			),
+		},
+		"Testing getting list of follow requests",
+	},
+	{
+		followInputStruct{
+			"/follow/requests/1",
+			"/follow/requests/{id:[0-9]+}",
+			"PUT",
+			nil,
+			nil,
+			testFollowInfo.HandleAcceptFollowRequest,
+			middleware.AuthMid,
+		},
+
+		followOutputStruct{
+			204,
+			nil,
+			nil,
+		},
+		"Testing accepting follow request",
+	},
+	{
+		followInputStruct{
+			"/follow/requests/1",
+			"/follow/requests/{id:[0-9]+}",
+			"DELETE",
+			nil,
+			nil,
+			testFollowInfo.HandleRejectFollowRequest,
+			middleware.AuthMid,
+		},
+
+		followOutputStruct{
+			404,
+			nil,
+			nil,
+		},
+		"Testing rejecting follow request that was already accepted",
+	},
 }
 
 var successCookies []*http.Cookie
@@ -274,7 +427,7 @@ func TestFollowSuccess(t *testing.T) {
 	notificationID := 0
 
 	mockUserApp.EXPECT().GetUser(expectedSecondUser.UserID).Return(&expectedSecondUser, nil).Times(1) // HandleFollowProfile checks if followed profile exists
-	mockFollowApp.EXPECT().Follow(expectedUser.UserID, expectedSecondUser.UserID).Return(nil).Times(1)
+	mockFollowApp.EXPECT().Follow(expectedUser.UserID, expectedSecondUser.UserID).Return(false, nil).Times(1)
 	mockUserApp.EXPECT().GetUser(expectedUser.UserID).Return(&expectedUser, nil).Times(1) // HandleFollowProfile requests current user's username
 	mockNotificationApp.EXPECT().AddNotification(gomock.Any()).Return(notificationID, nil).Times(1)
 	mockNotificationApp.EXPECT().SendNotification(expectedSecondUser.UserID, notificationID).Return(nil).Times(1)
@@ -286,7 +439,7 @@ func TestFollowSuccess(t *testing.T) {
 	mockNotificationApp.EXPECT().SendNotification(expectedSecondUser.UserID, notificationID).Return(nil).Times(1)
 
 	mockUserApp.EXPECT().GetUserByUsername(expectedSecondUser.Username).Return(&expectedSecondUser, nil).Times(1) // HandleFollowProfile checks if followed profile exists
-	mockFollowApp.EXPECT().Follow(expectedUser.UserID, expectedSecondUser.UserID).Return(nil).Times(1)
+	mockFollowApp.EXPECT().Follow(expectedUser.UserID, expectedSecondUser.UserID).Return(false, nil).Times(1)
 	mockUserApp.EXPECT().GetUser(expectedUser.UserID).Return(&expectedUser, nil).Times(1) // HandleFollowProfile requests current user's username
 	mockNotificationApp.EXPECT().AddNotification(gomock.Any()).Return(0, nil).Times(1)
 	mockNotificationApp.EXPECT().SendNotification(expectedSecondUser.UserID, notificationID).Return(nil).Times(1)
@@ -297,10 +450,36 @@ func TestFollowSuccess(t *testing.T) {
 	mockNotificationApp.EXPECT().AddNotification(gomock.Any()).Return(notificationID, nil).Times(1)
 	mockNotificationApp.EXPECT().SendNotification(expectedSecondUser.UserID, notificationID).Return(nil).Times(1)
 
+	mockFollowApp.EXPECT().CheckProfileAccess(expectedUser.UserID, expectedUser.UserID).Return(nil).Times(2)
+
 	mockFollowApp.EXPECT().GetAllFollowed(expectedUser.UserID).Return(expectedUsers, nil)
 
 	mockFollowApp.EXPECT().GetAllFollowers(expectedUser.UserID).Return(expectedUsers, nil)
 
+	mockFollowApp.EXPECT().Block(expectedUser.UserID, expectedSecondUser.UserID).Return(nil).Times(1)
+
+	mockUserApp.EXPECT().GetUser(expectedSecondUser.UserID).Return(&expectedSecondUser, nil).Times(1) // HandleFollowProfile checks if followed profile exists
+	mockFollowApp.EXPECT().Follow(expectedUser.UserID, expectedSecondUser.UserID).Return(false, entity.UserBlockedError).Times(1)
+
+	mockFollowApp.EXPECT().Unblock(expectedUser.UserID, expectedSecondUser.UserID).Return(nil).Times(1)
+
+	mockFollowApp.EXPECT().Block(expectedUser.UserID, expectedUser.UserID).Return(entity.SelfBlockError).Times(1)
+
+	mockUserApp.EXPECT().GetUser(expectedSecondUser.UserID).Return(&expectedSecondUser, nil).Times(1)        // HandleFollowProfile checks if followed profile exists
+	mockFollowApp.EXPECT().Follow(expectedUser.UserID, expectedSecondUser.UserID).Return(true, nil).Times(1) // Second user's account is private
+	mockUserApp.EXPECT().GetUser(expectedUser.UserID).Return(&expectedUser, nil).Times(1)                    // HandleFollowProfile requests current user's username
+	mockNotificationApp.EXPECT().AddNotification(gomock.Any()).Return(notificationID, nil).Times(1)
+	mockNotificationApp.EXPECT().SendNotification(expectedSecondUser.UserID, notificationID).Return(nil).Times(1)
+
+	mockFollowApp.EXPECT().GetFollowRequests(expectedUser.UserID).Return([]entity.User{expectedSecondUser}, nil).Times(1)
+
+	mockFollowApp.EXPECT().AcceptFollowRequest(expectedUser.UserID, expectedSecondUser.UserID).Return(nil).Times(1)
+	mockUserApp.EXPECT().GetUser(expectedUser.UserID).Return(&expectedUser, nil).Times(1) // HandleAcceptFollowRequest requests current user's username
+	mockNotificationApp.EXPECT().AddNotification(gomock.Any()).Return(notificationID, nil).Times(1)
+	mockNotificationApp.EXPECT().SendNotification(expectedSecondUser.UserID, notificationID).Return(nil).Times(1)
+
+	mockFollowApp.EXPECT().RejectFollowRequest(expectedUser.UserID, expectedSecondUser.UserID).Return(entity.FollowRequestNotFoundError).Times(1)
+
 	testAuthInfo = *auth.NewAuthInfo(
 		mockUserApp,
 		mockAuthApp,
@@ -308,6 +487,7 @@ func TestFollowSuccess(t *testing.T) {
 		nil,
 		nil,
 		mockWebsocketApp,
+		nil, // Requests come straight from clients in these tests
 		testLogger,
 	)
 
@@ -435,6 +615,24 @@ var followTestFailure = []struct {
 		},
 		"Testing getting empty list of followers of unexistant profile",
 	},
+	{
+		followInputStruct{
+			"/followers/2",
+			"/followers/{id:[0-9]+}",
+			"GET",
+			nil,
+			nil,
+			testFollowInfo.HandleGetFollowers,
+			nil,
+		},
+
+		followOutputStruct{
+			403,
+			nil,
+			nil,
+		},
+		"Testing getting followers of private profile by anonymous user",
+	},
 }
 
 var failureCookies []*http.Cookie
@@ -462,14 +660,20 @@ func TestFollowFailure(t *testing.T) {
 		Salt:      "",
 	}
 
+	mockFollowApp.EXPECT().CheckProfileAccess(0, expectedUser.UserID).Return(nil).Times(2)
+
 	mockFollowApp.EXPECT().GetAllFollowed(expectedUser.UserID).Return(nil, entity.UsersNotFoundError)
 
 	mockFollowApp.EXPECT().GetAllFollowers(expectedUser.UserID).Return(nil, entity.UsersNotFoundError)
 
+	mockFollowApp.EXPECT().CheckProfileAccess(0, 1234).Return(nil).Times(2) // Users are checked for existance when their followers are fetched
+
 	mockFollowApp.EXPECT().GetAllFollowed(1234).Return(nil, entity.UserNotFoundError)
 
 	mockFollowApp.EXPECT().GetAllFollowers(1234).Return(nil, entity.UserNotFoundError)
 
+	mockFollowApp.EXPECT().CheckProfileAccess(0, 2).Return(entity.PrivateAccountError).Times(1)
+
 	testAuthInfo = *auth.NewAuthInfo(
 		mockUserApp,
 		mockAuthApp,
@@ -477,6 +681,7 @@ func TestFollowFailure(t *testing.T) {
 		nil,
 		nil,
 		mockWebsocketApp,
+		nil, // Requests come straight from clients in these tests
 		testLogger,
 	)
 
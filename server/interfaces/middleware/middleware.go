@@ -33,6 +33,19 @@ func AuthMid(next http.HandlerFunc, cookieApp application.AuthAppInterface) http
 	})
 }
 
+// OptionalAuthMid passes cookie info to handler if user is logged in, and lets anonymous users through as well
+func OptionalAuthMid(next http.HandlerFunc, cookieApp application.AuthAppInterface) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cookie, found := CheckCookies(r, cookieApp)
+		if found {
+			ctx := context.WithValue(r.Context(), entity.CookieInfoKey, cookie)
+			r = r.Clone(ctx)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
 func NoAuthMid(next http.HandlerFunc, cookieApp application.AuthAppInterface) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		_, found := CheckCookies(r, cookieApp)
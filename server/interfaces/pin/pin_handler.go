@@ -3,6 +3,7 @@ package pin
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"path/filepath"
@@ -82,6 +83,23 @@ func (pinInfo *PinInfo) HandleAddPin(w http.ResponseWriter, r *http.Request) {
 	}
 	currPin.UserID = userID
 
+	if currPin.BoardID != 0 { // Pin can be created on someone else's board only by its editors
+		err = pinInfo.boardApp.CheckBoard(userID, currPin.BoardID, string(entity.EditorBoardRoleKey))
+		if err != nil {
+			pinInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+				zap.Int("for user", userID), zap.String("method", r.Method))
+			switch err {
+			case entity.CheckBoardOwnerError:
+				w.WriteHeader(http.StatusForbidden)
+			case entity.BoardNotFoundError:
+				w.WriteHeader(http.StatusNotFound)
+			default:
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+			return
+		}
+	}
+
 	file, header, err := r.FormFile(string(entity.PinImageLabelKey))
 	if err != nil {
 		pinInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
@@ -99,6 +117,8 @@ func (pinInfo *PinInfo) HandleAddPin(w http.ResponseWriter, r *http.Request) {
 		switch err {
 		case entity.BoardNotFoundError:
 			w.WriteHeader(http.StatusNotFound)
+		case entity.BoardArchivedError:
+			w.WriteHeader(http.StatusConflict)
 		default:
 			w.WriteHeader(http.StatusInternalServerError)
 		}
@@ -108,18 +128,20 @@ func (pinInfo *PinInfo) HandleAddPin(w http.ResponseWriter, r *http.Request) {
 	user, err := pinInfo.userApp.GetUser(userID)
 	if err != nil {
 		pinInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
-		pinInfo.pinApp.DeletePin(currPin.PinID)
+		pinInfo.pinApp.DeletePin(userID, currPin.PinID)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
-	go pinInfo.sendNotificationsAndEmails(user, currPin)
+	if !currPin.IsSecret { // Followers are not notified about pins on secret boards
+		go pinInfo.sendNotificationsAndEmails(user, currPin, true)
+	}
 
 	pinIDOutput := entity.PinID{PinID: currPin.PinID}
 	body, err := json.Marshal(pinIDOutput)
 	if err != nil {
 		pinInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
-		pinInfo.pinApp.DeletePin(currPin.PinID)
+		pinInfo.pinApp.DeletePin(userID, currPin.PinID)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
@@ -129,43 +151,215 @@ func (pinInfo *PinInfo) HandleAddPin(w http.ResponseWriter, r *http.Request) {
 	w.Write(body)
 }
 
-func (pinInfo *PinInfo) sendNotificationsAndEmails(sender *entity.User, pin entity.Pin) {
-	var usersWithNotifications []entity.UserNotificationInfo
-	var err error
+// HandleEditPin changes title and description of user's pin
+// Pin's image is replaced too if new one was passed
+func (pinInfo *PinInfo) HandleEditPin(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	pinID, err := strconv.Atoi(vars[string(entity.IDKey)])
+	if err != nil {
+		pinInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	if r.ContentLength > int64(maxPostPictureBodySize) { // Picture is too large
+		pinInfo.logger.Info(entity.TooLargePicture.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	r.ParseMultipartForm(int64(maxPostPictureBodySize))
+	jsonData := r.FormValue(string(entity.PinInfoLabelKey))
+	pinInput := entity.PinEditInput{}
+	err = json.Unmarshal([]byte(jsonData), &pinInput)
+	if err != nil {
+		pinInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	valid, _ := pinInput.Validate()
+	if !valid {
+		pinInfo.logger.Info(entity.ValidationError.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	currPin, err := pinInfo.pinApp.GetPin(pinID, userID)
+	if err != nil {
+		pinInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		switch err {
+		case entity.PinNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	if currPin.UserID != userID {
+		pinInfo.logger.Info(entity.CheckPinOwnerError.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
 
-	followers, err := pinInfo.followApp.GetAllFollowers(sender.UserID)
+	currPin.Title = pinInput.Title
+	currPin.Description = pinInput.Description
+
+	var file io.Reader // Stays nil if image is not changed
+	extension := ""
+	formFile, header, err := r.FormFile(string(entity.PinImageLabelKey))
+	switch err {
+	case nil:
+		defer formFile.Close()
+		file = formFile
+		extension = filepath.Ext(header.Filename)
+	case http.ErrMissingFile, http.ErrNotMultipart:
+	default:
+		pinInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = pinInfo.pinApp.UpdatePin(currPin, file, extension)
 	if err != nil {
+		pinInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		switch err {
+		case entity.PinNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
 		return
 	}
 
-	for _, user := range followers {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// sendNotificationsAndEmails notifies followers of pin's board and, if pin is new, sender's followers about pin
+// Users who follow both sender and board are notified once
+func (pinInfo *PinInfo) sendNotificationsAndEmails(sender *entity.User, pin entity.Pin, isNewPin bool) {
+	var usersWithNotifications []entity.UserNotificationInfo
+	notifiedUsers := make(map[int]bool)
+
+	notify := func(userID int, title string, text string) {
 		notification := entity.Notification{
-			UserID:   user.UserID,
-			Title:    "New Pin from people you've subscribed to!",
+			UserID:   userID,
+			Title:    title,
 			Category: "subscribed pins",
-			Text: fmt.Sprintf(`%s! You have a new pin from user %s: "%s"`,
-				user.Username, sender.Username, pin.Title),
-			IsRead: false,
+			Text:     text,
+			IsRead:   false,
 		}
-		notification.NotificationID, err = pinInfo.notificationApp.AddNotification(&notification)
 
+		var err error
+		notification.NotificationID, err = pinInfo.notificationApp.AddNotification(&notification)
 		switch err {
 		case nil:
 			usersWithNotifications = append(usersWithNotifications, entity.UserNotificationInfo{
-				UserID:         user.UserID,
+				UserID:         userID,
 				NotificationID: notification.NotificationID,
 			})
 		default:
 			pinInfo.logger.Info(err.Error(), zap.String("function", "PinInfo.sendNotificationsAndEmails"),
-				zap.Int("for user", user.UserID))
+				zap.Int("for user", userID))
+		}
+		notifiedUsers[userID] = true
+	}
+
+	if isNewPin { // Saving pins to own boards should not spam sender's followers
+		followers, err := pinInfo.followApp.GetAllFollowers(sender.UserID)
+		if err != nil && err != entity.UsersNotFoundError { // Board followers are notified even if sender has no followers
+			return
+		}
+
+		for _, user := range followers {
+			notify(user.UserID, "New Pin from people you've subscribed to!",
+				fmt.Sprintf(`%s! You have a new pin from user %s: "%s"`, user.Username, sender.Username, pin.Title))
 		}
 	}
 
+	if pin.BoardID != 0 {
+		pinInfo.notifyBoardFollowers(sender, pin, notifiedUsers, notify)
+	}
+
 	go pinInfo.notificationApp.SendNotificationsToUsers(usersWithNotifications)
 
 	go pinInfo.sendEmails(usersWithNotifications, pin.PinID)
 }
 
+// notifyBoardFollowers calls notify for every follower of pin's board who was not notified yet, except sender
+// Followers of secret boards are not notified, same as followers of private board owners whom they don't follow
+func (pinInfo *PinInfo) notifyBoardFollowers(sender *entity.User, pin entity.Pin, notifiedUsers map[int]bool,
+	notify func(userID int, title string, text string)) {
+	board, err := pinInfo.boardApp.GetBoard(pin.BoardID, sender.UserID)
+	if err != nil {
+		pinInfo.logger.Info(err.Error(), zap.String("function", "PinInfo.notifyBoardFollowers"),
+			zap.Int("for user", sender.UserID))
+		return
+	}
+	if board.IsSecret {
+		return
+	}
+
+	followerIDs, err := pinInfo.boardApp.GetBoardFollowers(pin.BoardID)
+	if err != nil {
+		pinInfo.logger.Info(err.Error(), zap.String("function", "PinInfo.notifyBoardFollowers"),
+			zap.Int("for user", sender.UserID))
+		return
+	}
+
+	for _, followerID := range followerIDs {
+		if followerID == sender.UserID || notifiedUsers[followerID] {
+			continue
+		}
+
+		err = pinInfo.followApp.CheckProfileAccess(followerID, board.UserID)
+		if err != nil { // Owner made account private after user followed the board
+			continue
+		}
+
+		follower, err := pinInfo.userApp.GetUser(followerID)
+		if err != nil {
+			pinInfo.logger.Info(err.Error(), zap.String("function", "PinInfo.notifyBoardFollowers"),
+				zap.Int("for user", followerID))
+			continue
+		}
+
+		notify(followerID, "New Pin on board you've subscribed to!",
+			fmt.Sprintf(`%s! User %s added new pin to board "%s": "%s"`,
+				follower.Username, sender.Username, board.Title, pin.Title))
+	}
+}
+
+// sendAddedPinNotifications notifies followers of the board about existing pin that user added to it
+func (pinInfo *PinInfo) sendAddedPinNotifications(userID int, boardID int, pinID int) {
+	sender, err := pinInfo.userApp.GetUser(userID)
+	if err != nil {
+		pinInfo.logger.Info(err.Error(), zap.String("function", "PinInfo.sendAddedPinNotifications"),
+			zap.Int("for user", userID))
+		return
+	}
+
+	pin, err := pinInfo.pinApp.GetPin(pinID, userID)
+	if err != nil {
+		pinInfo.logger.Info(err.Error(), zap.String("function", "PinInfo.sendAddedPinNotifications"),
+			zap.Int("for user", userID))
+		return
+	}
+
+	pin.BoardID = boardID
+	pinInfo.sendNotificationsAndEmails(sender, *pin, false)
+}
+
 func (pinInfo *PinInfo) sendEmails(usersAndNotifications []entity.UserNotificationInfo, pinID int) {
 	for _, pair := range usersAndNotifications {
 		user, err := pinInfo.userApp.GetUser(pair.UserID)
@@ -219,7 +413,7 @@ func (pinInfo *PinInfo) HandleAddPinToBoard(w http.ResponseWriter, r *http.Reque
 
 	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
 
-	err = pinInfo.boardApp.CheckBoard(userID, boardID)
+	err = pinInfo.boardApp.CheckBoard(userID, boardID, string(entity.EditorBoardRoleKey))
 	if err != nil {
 		pinInfo.logger.Info(
 			err.Error(), zap.String("url", r.RequestURI),
@@ -242,15 +436,22 @@ func (pinInfo *PinInfo) HandleAddPinToBoard(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	err = pinInfo.pinApp.AddPin(boardID, pinID)
+	err = pinInfo.pinApp.AddPin(userID, boardID, pinID)
 	if err != nil {
 		pinInfo.logger.Info(
 			err.Error(), zap.String("url", r.RequestURI),
 			zap.Int("for user", userID), zap.String("method", r.Method))
-		w.WriteHeader(http.StatusNotFound)
+		switch err {
+		case entity.BoardArchivedError:
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
 		return
 	}
 
+	go pinInfo.sendAddedPinNotifications(userID, boardID, pinID)
+
 	w.WriteHeader(http.StatusCreated)
 }
 
@@ -274,6 +475,16 @@ func (pinInfo *PinInfo) HandleSavePin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	go func() {
+		initBoardID, err := pinInfo.boardApp.GetInitUserBoard(userID)
+		if err != nil {
+			pinInfo.logger.Info(err.Error(), zap.String("function", "PinInfo.HandleSavePin"),
+				zap.Int("for user", userID))
+			return
+		}
+		pinInfo.sendAddedPinNotifications(userID, initBoardID, pinID)
+	}()
+
 	w.WriteHeader(http.StatusCreated)
 }
 
@@ -287,7 +498,7 @@ func (pinInfo *PinInfo) HandleDelPinByID(w http.ResponseWriter, r *http.Request)
 	}
 
 	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
-	err = pinInfo.boardApp.CheckBoard(userID, boardID)
+	err = pinInfo.boardApp.CheckBoard(userID, boardID, string(entity.EditorBoardRoleKey))
 	if err != nil {
 		pinInfo.logger.Info(
 			err.Error(), zap.String("url", r.RequestURI),
@@ -310,7 +521,7 @@ func (pinInfo *PinInfo) HandleDelPinByID(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	err = pinInfo.pinApp.RemovePin(boardID, pinID)
+	err = pinInfo.pinApp.RemovePin(userID, boardID, pinID)
 	if err != nil {
 		pinInfo.logger.Info(
 			err.Error(), zap.String("url", r.RequestURI),
@@ -332,7 +543,12 @@ func (pinInfo *PinInfo) HandleGetPinByID(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	resultPin, err := pinInfo.pinApp.GetPin(pinID)
+	viewerID := 0 // Anonymous users can't see pins on secret boards
+	if cookieInfo, found := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo); found {
+		viewerID = cookieInfo.UserID
+	}
+
+	resultPin, err := pinInfo.pinApp.GetPin(pinID, viewerID)
 	if err != nil {
 		pinInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
 		switch err {
@@ -365,7 +581,36 @@ func (pinInfo *PinInfo) HandleGetPinsByBoardID(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	boardPins, err := pinInfo.pinApp.GetPins(boardID)
+	viewerID := 0 // Anonymous users can only see pins of public accounts
+	if cookieInfo, found := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo); found {
+		viewerID = cookieInfo.UserID
+	}
+
+	board, err := pinInfo.boardApp.GetBoard(boardID, viewerID)
+	if err != nil {
+		pinInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
+		switch err {
+		case entity.BoardNotFoundError:
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	err = pinInfo.followApp.CheckProfileAccess(viewerID, board.UserID)
+	if err != nil {
+		pinInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
+		switch err {
+		case entity.PrivateAccountError:
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	boardPins, err := pinInfo.pinApp.GetPins(boardID, viewerID)
 	if err != nil && err != entity.PinsNotFoundError {
 		pinInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
 		switch err {
@@ -505,7 +750,12 @@ func (pinInfo *PinInfo) HandleSearchPins(w http.ResponseWriter, r *http.Request)
 
 	keyWords = strings.NewReplacer("+", " ").Replace(keyWords)
 
-	resultPins, err := pinInfo.pinApp.SearchPins(strings.ToLower(keyWords), interval)
+	requesterID := 0 // Anonymous users see pins of everyone
+	if cookieInfo, found := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo); found {
+		requesterID = cookieInfo.UserID
+	}
+
+	resultPins, err := pinInfo.pinApp.SearchPins(strings.ToLower(keyWords), interval, requesterID)
 	if err != nil && err != entity.PinsNotFoundError {
 		pinInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
 		w.WriteHeader(http.StatusInternalServerError)
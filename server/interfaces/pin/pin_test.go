@@ -196,11 +196,30 @@ var pinTest = []struct {
 				`"imageAvgColor":"FFFFFF",` +
 				`"description":"exampleDescription",` +
 				`"creationDate":"0001-01-01T00:00:00Z",` +
-				`"reportsCount":0}`,
+				`"reportsCount":0,` +
+				`"isSecret":false}`,
 			),
 		},
 		"Testing get pin by id",
 	},
+	{
+		InputStruct{
+			"/pin/7",
+			"/pin/{id:[0-9]+}",
+			"GET",
+			nil,
+			nil,
+			testPinInfo.HandleGetPinByID,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			404,
+			nil,
+			nil,
+		},
+		"Testing get pin which is only on other user's secret board",
+	},
 	{
 		InputStruct{
 			"/pins/0",
@@ -465,6 +484,60 @@ var pinTest = []struct {
 		},
 		"Testing adding second report for same pin",
 	},
+	{
+		InputStruct{
+			"/pin/1",
+			"/pin/{id:[0-9]+}",
+			"PUT",
+			map[string][]string{"Content-Type": {"application/x-www-form-urlencoded"}}, // Image is not changed
+			[]byte("pinInfo=" + url.QueryEscape(`{"title":"newtitle","description":"newDescription"}`)),
+			testPinInfo.HandleEditPin,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			204,
+			nil,
+			nil,
+		},
+		"Testing editing pin",
+	},
+	{
+		InputStruct{
+			"/pin/1",
+			"/pin/{id:[0-9]+}",
+			"PUT",
+			map[string][]string{"Content-Type": {"application/x-www-form-urlencoded"}}, // Image is not changed
+			[]byte("pinInfo=" + url.QueryEscape(`{"title":"","description":"newDescription"}`)),
+			testPinInfo.HandleEditPin,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			400,
+			nil,
+			nil,
+		},
+		"Testing editing pin with empty title",
+	},
+	{
+		InputStruct{
+			"/pin/5",
+			"/pin/{id:[0-9]+}",
+			"PUT",
+			map[string][]string{"Content-Type": {"application/x-www-form-urlencoded"}}, // Image is not changed
+			[]byte("pinInfo=" + url.QueryEscape(`{"title":"newtitle","description":"newDescription"}`)),
+			testPinInfo.HandleEditPin,
+			middleware.AuthMid,
+		},
+
+		OutputStruct{
+			403,
+			nil,
+			nil,
+		},
+		"Testing editing other user's pin",
+	},
 }
 
 var successCookies []*http.Cookie
@@ -599,24 +672,32 @@ func TestPins(t *testing.T) {
 
 	mockBoardApp.EXPECT().CreateBoard(expectedBoardFirst).Return(expectedBoardFirst.BoardID, nil).Times(1)
 
-	mockPinApp.EXPECT().GetPin(expectedPinSecond.PinID).Return(expectedPinSecond, nil).Times(1)
+	mockPinApp.EXPECT().GetPin(expectedPinSecond.PinID, expectedUser.UserID).Return(expectedPinSecond, nil).Times(1)
+
+	secretPin := *expectedPinSecond
+	secretPin.PinID = 7
+	secretPin.UserID = expectedFollower.UserID
+	secretPin.IsSecret = true
+	mockPinApp.EXPECT().GetPin(secretPin.PinID, expectedUser.UserID).Return(nil, entity.PinNotFoundError).Times(1) // Pins service hides it from user
 
-	mockPinApp.EXPECT().GetPins(gomock.Any()).Return(expectedPinsInBoard, nil).Times(1)
+	mockBoardApp.EXPECT().GetBoard(expectedBoardFirst.BoardID, expectedUser.UserID).Return(expectedBoardFirst, nil).Times(1)
+	mockFollowApp.EXPECT().CheckProfileAccess(expectedUser.UserID, expectedBoardFirst.UserID).Return(nil).Times(1)
+	mockPinApp.EXPECT().GetPins(expectedBoardFirst.BoardID, expectedUser.UserID).Return(expectedPinsInBoard, nil).Times(1)
 
-	mockPinApp.EXPECT().SearchPins("exp", "week").Return(expectedPinsInBoard, nil).Times(1)
+	mockPinApp.EXPECT().SearchPins("exp", "week", expectedUser.UserID).Return(expectedPinsInBoard, nil).Times(1)
 
 	mockPinApp.EXPECT().GetPinsWithOffset(0, 10).Return(expectedPinsInBoard, nil).Times(1)
 
 	mockPinApp.EXPECT().SavePin(expectedUser.UserID, expectedPinSecond.PinID).Return(nil).Times(1)
 
-	mockBoardApp.EXPECT().CheckBoard(0, 0).Return(nil).Times(3)
-	mockPinApp.EXPECT().AddPin(expectedBoardFirst.BoardID, expectedPinFirst.PinID).Return(nil).Times(1)
+	mockBoardApp.EXPECT().CheckBoard(0, 0, string(entity.EditorBoardRoleKey)).Return(nil).Times(3)
+	mockPinApp.EXPECT().AddPin(expectedUser.UserID, expectedBoardFirst.BoardID, expectedPinFirst.PinID).Return(nil).Times(1)
 
-	mockPinApp.EXPECT().RemovePin(expectedBoardFirst.BoardID, expectedPinFirst.PinID).Return(nil).Times(1)
+	mockPinApp.EXPECT().RemovePin(expectedUser.UserID, expectedBoardFirst.BoardID, expectedPinFirst.PinID).Return(nil).Times(1)
 
-	mockPinApp.EXPECT().GetPin(3).Return(nil, entity.PinNotFoundError).Times(1)
+	mockPinApp.EXPECT().GetPin(3, expectedUser.UserID).Return(nil, entity.PinNotFoundError).Times(1)
 
-	mockPinApp.EXPECT().RemovePin(expectedBoardFirst.BoardID, expectedPinFirst.PinID).Return(entity.PinNotFoundError).Times(1)
+	mockPinApp.EXPECT().RemovePin(expectedUser.UserID, expectedBoardFirst.BoardID, expectedPinFirst.PinID).Return(entity.PinNotFoundError).Times(1)
 
 	mockPinApp.EXPECT().CreateReport(gomock.Any()).Return(0, nil).Times(1)
 
@@ -624,6 +705,26 @@ func TestPins(t *testing.T) {
 
 	mockPinApp.EXPECT().CreateReport(gomock.Any()).Return(-1, entity.DuplicateReportError).Times(1)
 
+	expectedEditedPin := *expectedPinSecond
+	expectedEditedPin.Title = "newtitle"
+	expectedEditedPin.Description = "newDescription"
+	mockPinApp.EXPECT().GetPin(expectedPinSecond.PinID, expectedUser.UserID).Return(expectedPinSecond, nil).Times(1)
+	mockPinApp.EXPECT().UpdatePin(&expectedEditedPin, gomock.Nil(), "").Return(nil).Times(1)
+
+	otherUserPin := *expectedPinSecond
+	otherUserPin.PinID = 5
+	otherUserPin.UserID = expectedFollower.UserID
+	mockPinApp.EXPECT().GetPin(otherUserPin.PinID, expectedUser.UserID).Return(&otherUserPin, nil).Times(1)
+
+	// Followers of boards are notified about added and saved pins in background, test board has no followers
+	mockBoardApp.EXPECT().GetInitUserBoard(expectedUser.UserID).Return(expectedBoardFirst.BoardID, nil).AnyTimes()
+	mockUserApp.EXPECT().GetUser(expectedUser.UserID).Return(expectedUser, nil).AnyTimes()
+	mockPinApp.EXPECT().GetPin(expectedPinFirst.PinID, expectedUser.UserID).Return(expectedPinFirst, nil).AnyTimes()
+	mockPinApp.EXPECT().GetPin(expectedPinSecond.PinID, expectedUser.UserID).Return(expectedPinSecond, nil).AnyTimes()
+	mockBoardApp.EXPECT().GetBoard(expectedBoardFirst.BoardID, expectedUser.UserID).Return(expectedBoardFirst, nil).AnyTimes()
+	mockBoardApp.EXPECT().GetBoardFollowers(expectedBoardFirst.BoardID).Return([]int{}, nil).AnyTimes()
+	mockNotificationApp.EXPECT().SendNotificationsToUsers(gomock.Any()).Return().AnyTimes()
+
 	testAuthInfo = *auth.NewAuthInfo(
 		mockUserApp,
 		mockAuthApp,
@@ -631,9 +732,10 @@ func TestPins(t *testing.T) {
 		nil, // We don't need S3 or board in these tests
 		nil,
 		mockWebsocketApp,
+		nil, // Requests come straight from clients in these tests
 		testLogger)
 
-	testBoardInfo = *board.NewBoardInfo(mockBoardApp, testLogger)
+	testBoardInfo = *board.NewBoardInfo(mockBoardApp, mockFollowApp, mockUserApp, mockNotificationApp, testLogger)
 
 	testPinInfo = PinInfo{
 		pinApp:          mockPinApp,
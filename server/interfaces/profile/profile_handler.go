@@ -25,12 +25,13 @@ type ProfileInfo struct {
 	followApp       application.FollowAppInterface
 	s3App           application.S3AppInterface
 	notificationApp application.NotificationAppInterface
+	websocketApp    application.WebsocketAppInterface // For disconnecting real-time clients of deleted profile
 	logger          *zap.Logger
 }
 
 func NewProfileInfo(userApp application.UserAppInterface, authApp application.AuthAppInterface, cookieApp application.CookieAppInterface,
 	followApp application.FollowAppInterface, s3App application.S3AppInterface, notificationApp application.NotificationAppInterface,
-	logger *zap.Logger) *ProfileInfo {
+	websocketApp application.WebsocketAppInterface, logger *zap.Logger) *ProfileInfo {
 	return &ProfileInfo{
 		userApp:         userApp,
 		authApp:         authApp,
@@ -38,6 +39,7 @@ func NewProfileInfo(userApp application.UserAppInterface, authApp application.Au
 		followApp:       followApp,
 		s3App:           s3App,
 		notificationApp: notificationApp,
+		websocketApp:    websocketApp,
 		logger:          logger,
 	}
 }
@@ -153,6 +155,8 @@ func (profileInfo *ProfileInfo) HandleDeleteProfile(w http.ResponseWriter, r *ht
 		return
 	}
 
+	profileInfo.websocketApp.EndSessions(userCookie.UserID) // Disconnecting real-time clients of ended sessions
+
 	userCookie.Cookie.Expires = time.Now().AddDate(0, 0, -1) // Making cookie expire
 	http.SetCookie(w, userCookie.Cookie)
 
@@ -262,6 +266,23 @@ func (profileInfo *ProfileInfo) HandleGetProfile(w http.ResponseWriter, r *http.
 	currentUserID := cookie.UserID
 	otherUserID := user.UserID
 	if currentUserID != otherUserID {
+		blockStatus, err := profileInfo.followApp.GetBlockStatus(currentUserID, otherUserID)
+		if err != nil {
+			profileInfo.logger.Info(err.Error(),
+				zap.String("url", r.RequestURI),
+				zap.String("method", r.Method))
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if blockStatus.IsBlockedBy { // Users who were blocked can't see blocker's profile
+			profileInfo.logger.Info(entity.UserBlockedError.Error(),
+				zap.String("url", r.RequestURI),
+				zap.String("method", r.Method))
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		userOutput.Blocked = &blockStatus.IsBlocked
+
 		userOutput.Email = ""
 		followed, err := profileInfo.followApp.CheckIfFollowed(currentUserID, otherUserID)
 		if err != nil {
@@ -334,7 +355,12 @@ func (profileInfo *ProfileInfo) HandleGetProfilesByKeyWords(w http.ResponseWrite
 	keyString := mux.Vars(r)[string(entity.SearchKeyQuery)]
 
 	keyString = strings.NewReplacer("+", " ").Replace(keyString)
-	users, err := profileInfo.userApp.SearchUsers(strings.ToLower(keyString))
+	requesterID := 0 // Anonymous users see everyone
+	if cookieInfo, found := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo); found {
+		requesterID = cookieInfo.UserID
+	}
+
+	users, err := profileInfo.userApp.SearchUsers(strings.ToLower(keyString), requesterID)
 	if err != nil {
 		profileInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
 		w.WriteHeader(http.StatusBadRequest)
@@ -365,3 +391,52 @@ func (profileInfo *ProfileInfo) HandleGetProfilesByKeyWords(w http.ResponseWrite
 	w.WriteHeader(http.StatusOK)
 	w.Write(responseBody)
 }
+
+// HandleGetPrivacySettings returns privacy settings of current user
+func (profileInfo *ProfileInfo) HandleGetPrivacySettings(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	settings, err := profileInfo.userApp.GetPrivacySettings(userID)
+	if err != nil {
+		profileInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	responseBody, err := json.Marshal(settings)
+	if err != nil {
+		profileInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(responseBody)
+}
+
+// HandleEditPrivacySettings replaces privacy settings of current user
+func (profileInfo *ProfileInfo) HandleEditPrivacySettings(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(entity.CookieInfoKey).(*entity.CookieInfo).UserID
+
+	settings := new(entity.PrivacySettings)
+	err := json.NewDecoder(r.Body).Decode(settings)
+	if err != nil {
+		profileInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	settings.UserID = userID
+	err = profileInfo.userApp.SavePrivacySettings(settings)
+	if err != nil {
+		profileInfo.logger.Info(err.Error(), zap.String("url", r.RequestURI),
+			zap.Int("for user", userID), zap.String("method", r.Method))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
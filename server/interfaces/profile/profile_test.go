@@ -268,6 +268,42 @@ var profileTestSuccess = []struct {
 		},
 		"Testing avatar change",
 	},
+	{
+		profileInputStruct{
+			"/profile/privacy",
+			"/profile/privacy",
+			"PUT",
+			nil,
+			[]byte(`{"hidePresence":true}`),
+			testProfileInfo.HandleEditPrivacySettings,
+			middleware.AuthMid,
+		},
+
+		profileOutputStruct{
+			204,
+			nil,
+			nil,
+		},
+		"Testing privacy settings change",
+	},
+	{
+		profileInputStruct{
+			"/profile/privacy",
+			"/profile/privacy",
+			"GET",
+			nil,
+			nil,
+			testProfileInfo.HandleGetPrivacySettings,
+			middleware.AuthMid,
+		},
+
+		profileOutputStruct{
+			200,
+			nil,
+			[]byte(`{"hidePresence":true,"isPrivate":false}`),
+		},
+		"Testing privacy settings output",
+	},
 	{
 		profileInputStruct{
 			"/profile/delete",
@@ -334,7 +370,7 @@ func TestProfileSuccess(t *testing.T) {
 
 	expectedUsers := []entity.User{expectedUser}
 
-	mockUserApp.EXPECT().SearchUsers("test").Return(expectedUsers, nil).Times(1)
+	mockUserApp.EXPECT().SearchUsers("test", 0).Return(expectedUsers, nil).Times(1)
 
 	mockUserApp.EXPECT().GetUser(expectedUser.UserID).Return(&expectedUser, nil).Times(1) // Before changing password, handler requests user data
 	expectedUser.Password = "New Password"
@@ -359,7 +395,12 @@ func TestProfileSuccess(t *testing.T) {
 
 	mockUserApp.EXPECT().UpdateAvatar(expectedUser.UserID, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
+	expectedSettings := entity.PrivacySettings{UserID: expectedUser.UserID, HidePresence: true}
+	mockUserApp.EXPECT().SavePrivacySettings(&expectedSettings).Return(nil).Times(1)
+	mockUserApp.EXPECT().GetPrivacySettings(expectedUser.UserID).Return(&expectedSettings, nil).Times(1)
+
 	mockAuthApp.EXPECT().LogoutUser(expectedUser.UserID).Return(nil).Times(1)
+	mockWebsocketApp.EXPECT().EndSessions(expectedUser.UserID).Return(nil).Times(1) // Disconnecting clients of deleted profile
 	mockUserApp.EXPECT().DeleteUser(expectedUserEdited.UserID).Return(nil).Times(1)
 
 	testAuthInfo = *auth.NewAuthInfo(
@@ -369,6 +410,7 @@ func TestProfileSuccess(t *testing.T) {
 		nil,
 		nil,
 		mockWebsocketApp,
+		nil, // Requests come straight from clients in these tests
 		testLogger,
 	)
 
@@ -378,6 +420,7 @@ func TestProfileSuccess(t *testing.T) {
 		followApp:       mockFollowApp,
 		s3App:           mockS3App,
 		notificationApp: mockNotificationApp,
+		websocketApp:    mockWebsocketApp,
 		logger:          testLogger,
 	}
 	for _, tt := range profileTestSuccess {
@@ -675,6 +718,7 @@ func TestProfileFailure(t *testing.T) {
 		nil, // We don't need S3 bucket in these tests
 		nil, // We don't really care about boards in these tests
 		mockWebsocketApp,
+		nil, // Requests come straight from clients in these tests
 		testLogger,
 	)
 	testProfileInfo = ProfileInfo{
@@ -683,6 +727,7 @@ func TestProfileFailure(t *testing.T) {
 		followApp:       mockFollowApp,
 		s3App:           mockS3App,
 		notificationApp: mockNotificationApp,
+		websocketApp:    mockWebsocketApp,
 		logger:          testLogger,
 	}
 
@@ -44,6 +44,9 @@ func CreateRouter(authApp *application.AuthApp, boardInfo *board.BoardInfo, auth
 	r.HandleFunc("/api/auth/login", mid.NoAuthMid(authInfo.HandleLoginUser, authApp)).Methods("POST")
 	r.HandleFunc("/api/auth/logout", mid.AuthMid(authInfo.HandleLogoutUser, authApp)).Methods("POST")
 	r.HandleFunc("/api/auth/check", authInfo.HandleCheckUser).Methods("GET")
+	r.HandleFunc("/api/auth/sessions", mid.AuthMid(authInfo.HandleGetSessions, authApp)).Methods("GET")
+	r.HandleFunc("/api/auth/sessions", mid.AuthMid(authInfo.HandleLogoutOtherSessions, authApp)).Methods("DELETE")
+	r.HandleFunc("/api/auth/sessions/{id:[0-9]+}", mid.AuthMid(authInfo.HandleLogoutSession, authApp)).Methods("DELETE")
 	r.HandleFunc("/api/vk_token/signup", mid.NoAuthMid(authInfo.HandleCreateUserWithVK, authApp)).Methods("POST")
 	r.HandleFunc("/api/vk_token/login", mid.NoAuthMid(authInfo.HandleCheckVkToken, authApp)).Methods("POST")
 	r.HandleFunc("/api/vk_token/add", mid.AuthMid(authInfo.HandleAddVkToken, authApp)).Methods("POST")
@@ -51,43 +54,80 @@ func CreateRouter(authApp *application.AuthApp, boardInfo *board.BoardInfo, auth
 	r.HandleFunc("/api/profile/password", mid.AuthMid(profileInfo.HandleChangePassword, authApp)).Methods("PUT")
 	r.HandleFunc("/api/profile/edit", mid.AuthMid(profileInfo.HandleEditProfile, authApp)).Methods("PUT")
 	r.HandleFunc("/api/profile/delete", mid.AuthMid(profileInfo.HandleDeleteProfile, authApp)).Methods("DELETE")
+	r.HandleFunc("/api/profile/privacy", mid.AuthMid(profileInfo.HandleGetPrivacySettings, authApp)).Methods("GET")
+	r.HandleFunc("/api/profile/privacy", mid.AuthMid(profileInfo.HandleEditPrivacySettings, authApp)).Methods("PUT")
 	r.HandleFunc("/api/profile/{id:[0-9]+}", profileInfo.HandleGetProfile).Methods("GET") // Is preferred over next one
 	r.HandleFunc("/api/profile/{username}", profileInfo.HandleGetProfile).Methods("GET")
 	r.HandleFunc("/api/profile", mid.AuthMid(profileInfo.HandleGetProfile, authApp)).Methods("GET")
 	r.HandleFunc("/api/profile/avatar", mid.AuthMid(profileInfo.HandlePostAvatar, authApp)).Methods("PUT")
-	r.HandleFunc("/api/profiles/search/{searchKey}", profileInfo.HandleGetProfilesByKeyWords).Methods("GET")
+	r.HandleFunc("/api/profiles/search/{searchKey}", mid.OptionalAuthMid(profileInfo.HandleGetProfilesByKeyWords, authApp)).Methods("GET")
 
 	r.HandleFunc("/api/follow/{id:[0-9]+}", mid.AuthMid(followInfo.HandleFollowProfile, authApp)).Methods("POST") // Is preferred over next one
 	r.HandleFunc("/api/follow/{username}", mid.AuthMid(followInfo.HandleFollowProfile, authApp)).Methods("POST")
 	r.HandleFunc("/api/follow/{id:[0-9]+}", mid.AuthMid(followInfo.HandleUnfollowProfile, authApp)).Methods("DELETE") // Is preferred over next one
 	r.HandleFunc("/api/follow/{username}", mid.AuthMid(followInfo.HandleUnfollowProfile, authApp)).Methods("DELETE")
-	r.HandleFunc("/api/followers/{id:[0-9]+}", followInfo.HandleGetFollowers).Methods("GET")
-	r.HandleFunc("/api/following/{id:[0-9]+}", followInfo.HandleGetFollowed).Methods("GET")
+	r.HandleFunc("/api/block/{id:[0-9]+}", mid.AuthMid(followInfo.HandleBlockProfile, authApp)).Methods("POST")
+	r.HandleFunc("/api/block/{id:[0-9]+}", mid.AuthMid(followInfo.HandleUnblockProfile, authApp)).Methods("DELETE")
+	r.HandleFunc("/api/follow/requests", mid.AuthMid(followInfo.HandleGetFollowRequests, authApp)).Methods("GET")
+	r.HandleFunc("/api/follow/requests/{id:[0-9]+}", mid.AuthMid(followInfo.HandleAcceptFollowRequest, authApp)).Methods("PUT")
+	r.HandleFunc("/api/follow/requests/{id:[0-9]+}", mid.AuthMid(followInfo.HandleRejectFollowRequest, authApp)).Methods("DELETE")
+	r.HandleFunc("/api/followers/{id:[0-9]+}", mid.OptionalAuthMid(followInfo.HandleGetFollowers, authApp)).Methods("GET")
+	r.HandleFunc("/api/following/{id:[0-9]+}", mid.OptionalAuthMid(followInfo.HandleGetFollowed, authApp)).Methods("GET")
 	r.HandleFunc("/api/pins/followed", mid.AuthMid(followInfo.HandleGetFollowedPinsList, authApp)).Methods("GET")
 
 	r.HandleFunc("/api/pin", mid.AuthMid(pinInfo.HandleAddPin, authApp)).Methods("POST")
-	r.HandleFunc("/api/pin/{id:[0-9]+}", pinInfo.HandleGetPinByID).Methods("GET")
-	r.HandleFunc("/api/pins/{id:[0-9]+}", pinInfo.HandleGetPinsByBoardID).Methods("GET")
+	r.HandleFunc("/api/pin/{id:[0-9]+}", mid.OptionalAuthMid(pinInfo.HandleGetPinByID, authApp)).Methods("GET")
+	r.HandleFunc("/api/pin/{id:[0-9]+}", mid.AuthMid(pinInfo.HandleEditPin, authApp)).Methods("PUT")
+	r.HandleFunc("/api/pins/{id:[0-9]+}", mid.OptionalAuthMid(pinInfo.HandleGetPinsByBoardID, authApp)).Methods("GET")
 	r.HandleFunc("/api/pin/add/{id:[0-9]+}", mid.AuthMid(pinInfo.HandleSavePin, authApp)).Methods("POST")
 	r.HandleFunc("/api/pins/feed", pinInfo.HandlePinsFeed).Methods("GET")
-	r.HandleFunc("/api/pins/search", pinInfo.HandleSearchPins).Methods("GET")
+	r.HandleFunc("/api/pins/search", mid.OptionalAuthMid(pinInfo.HandleSearchPins, authApp)).Methods("GET")
 	r.HandleFunc("/api/pin/report", mid.AuthMid(pinInfo.HandleCreateReport, authApp)).Methods("POST")
 
 	r.HandleFunc("/api/board", mid.AuthMid(boardInfo.HandleCreateBoard, authApp)).Methods("POST")
-	r.HandleFunc("/api/board/{id:[0-9]+}", boardInfo.HandleGetBoardByID).Methods("GET")
-	r.HandleFunc("/api/boards/{id:[0-9]+}", boardInfo.HandleGetBoardsByUserID).Methods("GET")
+	r.HandleFunc("/api/board/{id:[0-9]+}", mid.OptionalAuthMid(boardInfo.HandleGetBoardByID, authApp)).Methods("GET")
+	r.HandleFunc("/api/boards/{id:[0-9]+}", mid.OptionalAuthMid(boardInfo.HandleGetBoardsByUserID, authApp)).Methods("GET")
+	r.HandleFunc("/api/board/{id:[0-9]+}", mid.AuthMid(boardInfo.HandleEditBoard, authApp)).Methods("PUT")
 	r.HandleFunc("/api/board/{id:[0-9]+}", mid.AuthMid(boardInfo.HandleDelBoardByID, authApp)).Methods("DELETE")
 	r.HandleFunc("/api/board/{id:[0-9]+}/add/{pinID:[0-9]+}", mid.AuthMid(pinInfo.HandleAddPinToBoard, authApp)).Methods("POST")
 	r.HandleFunc("/api/board/{id:[0-9]+}/{pinID:[0-9]+}", mid.AuthMid(pinInfo.HandleDelPinByID, authApp)).Methods("DELETE")
+	r.HandleFunc("/api/board/{id:[0-9]+}/collaborators", mid.AuthMid(boardInfo.HandleInviteCollaborator, authApp)).Methods("POST")
+	r.HandleFunc("/api/board/{id:[0-9]+}/collaborators/{memberID:[0-9]+}", mid.AuthMid(boardInfo.HandleRemoveCollaborator, authApp)).Methods("DELETE")
+	r.HandleFunc("/api/board/{id:[0-9]+}/invitation", mid.AuthMid(boardInfo.HandleAcceptInvitation, authApp)).Methods("PUT")
+	r.HandleFunc("/api/boards/invitations", mid.AuthMid(boardInfo.HandleGetInvitations, authApp)).Methods("GET")
+	r.HandleFunc("/api/board/{id:[0-9]+}/sections", mid.AuthMid(boardInfo.HandleCreateSection, authApp)).Methods("POST")
+	r.HandleFunc("/api/board/{id:[0-9]+}/sections/{sectionID:[0-9]+}", mid.AuthMid(boardInfo.HandleRenameSection, authApp)).Methods("PUT")
+	r.HandleFunc("/api/board/{id:[0-9]+}/sections/{sectionID:[0-9]+}", mid.AuthMid(boardInfo.HandleDeleteSection, authApp)).Methods("DELETE")
+	r.HandleFunc("/api/board/{id:[0-9]+}/move/{pinID:[0-9]+}", mid.AuthMid(boardInfo.HandleMovePin, authApp)).Methods("PUT")
+	r.HandleFunc("/api/board/{id:[0-9]+}/order", mid.AuthMid(boardInfo.HandleReorderBoard, authApp)).Methods("PUT")
+	r.HandleFunc("/api/board/{id:[0-9]+}/follow", mid.AuthMid(boardInfo.HandleFollowBoard, authApp)).Methods("POST")
+	r.HandleFunc("/api/board/{id:[0-9]+}/follow", mid.AuthMid(boardInfo.HandleUnfollowBoard, authApp)).Methods("DELETE")
 
 	r.HandleFunc("/api/comment/{id:[0-9]+}", mid.AuthMid(commentsInfo.HandleAddComment, authApp)).Methods("POST")
-	r.HandleFunc("/api/comments/{id:[0-9]+}", commentsInfo.HandleGetComments).Methods("GET")
+	r.HandleFunc("/api/comment/{id:[0-9]+}", mid.AuthMid(commentsInfo.HandleEditComment, authApp)).Methods("PUT") // Comment's ID is passed here, unlike POST, where it is pin's ID
+	r.HandleFunc("/api/comment/{id:[0-9]+}", mid.AuthMid(commentsInfo.HandleDeleteComment, authApp)).Methods("DELETE")
+	r.HandleFunc("/api/comments/{id:[0-9]+}", mid.OptionalAuthMid(commentsInfo.HandleGetComments, authApp)).Methods("GET")
+	r.HandleFunc("/api/comment/{id:[0-9]+}/replies", mid.OptionalAuthMid(commentsInfo.HandleGetReplies, authApp)).Methods("GET")
 
 	r.HandleFunc("/socket", websocketInfo.HandleConnect)
 	r.HandleFunc("/api/notifications/read/{id:[0-9]+}", mid.AuthMid(notificationInfo.HandleReadNotification, authApp)).Methods("PUT")
 	r.HandleFunc("/api/message/{id:[0-9]+}", mid.AuthMid(chatInfo.HandleAddMessage, authApp)).Methods("POST")
 	r.HandleFunc("/api/message/{username}", mid.AuthMid(chatInfo.HandleAddMessage, authApp)).Methods("POST")
 	r.HandleFunc("/api/chats/read/{id:[0-9]+}", mid.AuthMid(chatInfo.HandleReadChat, authApp)).Methods("PUT")
+	r.HandleFunc("/api/chats", mid.AuthMid(chatInfo.HandleGetChats, authApp)).Methods("GET")
+	r.HandleFunc("/api/chats", mid.AuthMid(chatInfo.HandleCreateChat, authApp)).Methods("POST")
+	r.HandleFunc("/api/chats/search", mid.AuthMid(chatInfo.HandleSearchMessages, authApp)).Methods("GET")
+	r.HandleFunc("/api/chats/{id:[0-9]+}", mid.AuthMid(chatInfo.HandleUpdateChat, authApp)).Methods("PUT")
+	r.HandleFunc("/api/chats/{id:[0-9]+}/avatar", mid.AuthMid(chatInfo.HandleUpdateChatAvatar, authApp)).Methods("PUT")
+	r.HandleFunc("/api/chats/{id:[0-9]+}/messages", mid.AuthMid(chatInfo.HandleGetMessages, authApp)).Methods("GET")
+	r.HandleFunc("/api/chats/{id:[0-9]+}/messages", mid.AuthMid(chatInfo.HandleAddChatMessage, authApp)).Methods("POST")
+	r.HandleFunc("/api/chats/{id:[0-9]+}/members", mid.AuthMid(chatInfo.HandleAddChatMember, authApp)).Methods("POST")
+	r.HandleFunc("/api/chats/{id:[0-9]+}/members/{memberID:[0-9]+}", mid.AuthMid(chatInfo.HandleSetChatMemberRole, authApp)).Methods("PUT")
+	r.HandleFunc("/api/chats/{id:[0-9]+}/members/{memberID:[0-9]+}", mid.AuthMid(chatInfo.HandleRemoveChatMember, authApp)).Methods("DELETE")
+	r.HandleFunc("/api/chats/{id:[0-9]+}/leave", mid.AuthMid(chatInfo.HandleLeaveChat, authApp)).Methods("POST")
+	r.HandleFunc("/api/chats/messages/{id:[0-9]+}", mid.AuthMid(chatInfo.HandleEditMessage, authApp)).Methods("PUT")
+	r.HandleFunc("/api/chats/messages/{id:[0-9]+}", mid.AuthMid(chatInfo.HandleDeleteMessage, authApp)).Methods("DELETE")
+	r.HandleFunc("/api/presence/{id:[0-9]+}", mid.AuthMid(chatInfo.HandleGetPresence, authApp)).Methods("GET")
 
 	if csrfOn {
 		r.HandleFunc("/api/csrf", func(w http.ResponseWriter, r *http.Request) { // Is used only for getting csrf key
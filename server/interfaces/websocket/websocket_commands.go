@@ -0,0 +1,202 @@
+package websocket
+
+import (
+	"encoding/json"
+	"pinterest/domain/entity"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+// handleCommand executes command sent by client, answering with ack or error
+func (websocketInfo *WebsocketInfo) handleCommand(userID int, ws *websocket.Conn, commandBytes []byte) {
+	var command entity.WebsocketCommand
+	err := json.Unmarshal(commandBytes, &command)
+	if err != nil {
+		websocketInfo.sendCommandError(userID, ws, command, err)
+		return
+	}
+
+	createdID := 0
+	switch command.Type {
+	case entity.SendMessageCommandKey:
+		createdID, err = websocketInfo.handleSendMessage(userID, commandBytes)
+	case entity.ReadChatCommandKey:
+		err = websocketInfo.handleReadChat(userID, commandBytes)
+	case entity.EditMessageCommandKey:
+		err = websocketInfo.handleEditMessage(userID, commandBytes)
+	case entity.DeleteMessageCommandKey:
+		err = websocketInfo.handleDeleteMessage(userID, commandBytes)
+	case entity.DeliverMessagesCommandKey:
+		err = websocketInfo.handleDeliverMessages(userID, commandBytes)
+	case entity.TypingCommandKey:
+		err = websocketInfo.handleTyping(userID, commandBytes)
+	case entity.GetPresenceCommandKey:
+		err = websocketInfo.handleGetPresence(userID, ws, commandBytes)
+	case entity.ReadNotificationCommandKey:
+		err = websocketInfo.handleReadNotification(userID, commandBytes)
+	case entity.SubscribeCommandKey:
+		err = websocketInfo.handleSubscribe(userID, ws, commandBytes)
+	default:
+		err = entity.UnknownCommandError
+	}
+
+	if err != nil {
+		websocketInfo.logger.Info(err.Error(), zap.Int("from user", userID), zap.String("command", string(command.Type)))
+		websocketInfo.sendCommandError(userID, ws, command, err)
+		return
+	}
+
+	websocketInfo.sendCommandAck(userID, ws, command, createdID)
+}
+
+func (websocketInfo *WebsocketInfo) handleSendMessage(userID int, commandBytes []byte) (int, error) {
+	var command entity.SendMessageCommand
+	err := json.Unmarshal(commandBytes, &command)
+	if err != nil {
+		return 0, err
+	}
+
+	if command.ChatID != 0 {
+		return websocketInfo.chatApp.PostChatMessage(userID, command.ChatID, command.MessageText, command.Attachments)
+	}
+
+	return websocketInfo.chatApp.PostMessage(userID, command.TargetID, command.MessageText, command.Attachments)
+}
+
+func (websocketInfo *WebsocketInfo) handleReadChat(userID int, commandBytes []byte) error {
+	var command entity.ReadChatCommand
+	err := json.Unmarshal(commandBytes, &command)
+	if err != nil {
+		return err
+	}
+
+	if command.MessageID != 0 {
+		return websocketInfo.chatApp.ReadMessages(userID, command.ChatID, command.MessageID)
+	}
+
+	return websocketInfo.chatApp.ReadChat(command.ChatID, userID)
+}
+
+func (websocketInfo *WebsocketInfo) handleEditMessage(userID int, commandBytes []byte) error {
+	var command entity.EditMessageCommand
+	err := json.Unmarshal(commandBytes, &command)
+	if err != nil {
+		return err
+	}
+
+	return websocketInfo.chatApp.EditMessage(userID, command.MessageID, command.MessageText)
+}
+
+func (websocketInfo *WebsocketInfo) handleDeleteMessage(userID int, commandBytes []byte) error {
+	var command entity.DeleteMessageCommand
+	err := json.Unmarshal(commandBytes, &command)
+	if err != nil {
+		return err
+	}
+
+	return websocketInfo.chatApp.DeleteMessage(userID, command.MessageID)
+}
+
+func (websocketInfo *WebsocketInfo) handleDeliverMessages(userID int, commandBytes []byte) error {
+	var command entity.DeliverMessagesCommand
+	err := json.Unmarshal(commandBytes, &command)
+	if err != nil {
+		return err
+	}
+
+	return websocketInfo.chatApp.DeliverMessages(userID, command.ChatID, command.MessageID)
+}
+
+func (websocketInfo *WebsocketInfo) handleTyping(userID int, commandBytes []byte) error {
+	var command entity.TypingCommand
+	err := json.Unmarshal(commandBytes, &command)
+	if err != nil {
+		return err
+	}
+
+	return websocketInfo.chatApp.SendTyping(userID, command.ChatID)
+}
+
+// handleGetPresence sends requested user's presence to the client that asked for it
+func (websocketInfo *WebsocketInfo) handleGetPresence(userID int, ws *websocket.Conn, commandBytes []byte) error {
+	var command entity.GetPresenceCommand
+	err := json.Unmarshal(commandBytes, &command)
+	if err != nil {
+		return err
+	}
+
+	presence, err := websocketInfo.chatApp.GetPresence(userID, command.UserID)
+	if err != nil {
+		return err
+	}
+
+	result, err := json.Marshal(entity.PresenceOutput{Type: entity.PresenceTypeKey, Presence: *presence})
+	if err != nil {
+		return entity.JsonMarshallError
+	}
+
+	return websocketInfo.websocketApp.SendMessageToClient(userID, ws, result)
+}
+
+func (websocketInfo *WebsocketInfo) handleReadNotification(userID int, commandBytes []byte) error {
+	var command entity.ReadNotificationCommand
+	err := json.Unmarshal(commandBytes, &command)
+	if err != nil {
+		return err
+	}
+
+	return websocketInfo.notificationApp.ReadNotification(userID, command.NotificationID)
+}
+
+// handleSubscribe sends current state of requested topic to client which subscribed, further updates are pushed as they happen
+func (websocketInfo *WebsocketInfo) handleSubscribe(userID int, ws *websocket.Conn, commandBytes []byte) error {
+	var command entity.SubscribeCommand
+	err := json.Unmarshal(commandBytes, &command)
+	if err != nil {
+		return err
+	}
+
+	switch command.Topic {
+	case entity.NotificationsTopicKey:
+		return websocketInfo.notificationApp.SendAllNotifications(userID, ws)
+	case entity.ChatsTopicKey:
+		return websocketInfo.chatApp.SendAllChats(userID, ws)
+	default:
+		return entity.UnknownTopicError
+	}
+}
+
+func (websocketInfo *WebsocketInfo) sendCommandAck(userID int, ws *websocket.Conn, command entity.WebsocketCommand, createdID int) {
+	ack := entity.CommandAckOutput{
+		Type:      entity.CommandAckTypeKey,
+		RequestID: command.RequestID,
+		Command:   command.Type,
+		ID:        createdID,
+	}
+
+	result, err := json.Marshal(ack)
+	if err != nil {
+		websocketInfo.logger.Info(entity.JsonMarshallError.Error(), zap.Int("for user", userID))
+		return
+	}
+
+	websocketInfo.websocketApp.SendMessageToClient(userID, ws, result)
+}
+
+func (websocketInfo *WebsocketInfo) sendCommandError(userID int, ws *websocket.Conn, command entity.WebsocketCommand, commandErr error) {
+	errorOutput := entity.CommandErrorOutput{
+		Type:      entity.CommandErrorTypeKey,
+		RequestID: command.RequestID,
+		Command:   command.Type,
+		Error:     commandErr.Error(),
+	}
+
+	result, err := json.Marshal(errorOutput)
+	if err != nil {
+		websocketInfo.logger.Info(entity.JsonMarshallError.Error(), zap.Int("for user", userID))
+		return
+	}
+
+	websocketInfo.websocketApp.SendMessageToClient(userID, ws, result)
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"pinterest/domain/entity"
+	"pinterest/interfaces/middleware"
+	"time"
 
 	"pinterest/application"
 
@@ -15,29 +17,48 @@ type WebsocketInfo struct {
 	notificationApp application.NotificationAppInterface
 	chatApp         application.ChatAppInterface
 	websocketApp    application.WebsocketAppInterface
+	authApp         application.AuthAppInterface
 	csrfOn          bool
+	allowedOrigins  []string // Pages which may open websocket, so that other sites could not connect with user's cookie
 	logger          *zap.Logger
 }
 
 func NewWebsocketInfo(notificationApp application.NotificationAppInterface, chatApp application.ChatAppInterface,
-	websocketApp application.WebsocketAppInterface,
-	csrfOn bool, logger *zap.Logger) *WebsocketInfo {
+	websocketApp application.WebsocketAppInterface, authApp application.AuthAppInterface,
+	csrfOn bool, allowedOrigins []string, logger *zap.Logger) *WebsocketInfo {
 	return &WebsocketInfo{
 		notificationApp: notificationApp,
 		chatApp:         chatApp,
 		websocketApp:    websocketApp,
+		authApp:         authApp,
 		csrfOn:          csrfOn,
+		allowedOrigins:  allowedOrigins,
 		logger:          logger,
 	}
 }
 
+const (
+	pongWait             = 60 * time.Second    // Client is considered dead if it sent nothing (including pongs) for this long
+	pingPeriod           = (pongWait * 9) / 10 // Pings are sent more often than pongWait, so that live clients could answer in time
+	pingWait             = 10 * time.Second    // Time allowed to write ping to client
+	sessionCheckInterval = 30 * time.Second    // How often connected client's session is checked for expiration
+	maxCommandSize       = 64 * 1024           // Maximum size of message client can send
+)
+
+// HandleConnect upgrades connection to websocket, binding it to the user session cookie belongs to
 func (websocketInfo *WebsocketInfo) HandleConnect(w http.ResponseWriter, r *http.Request) {
+	cookieInfo, found := middleware.CheckCookies(r, websocketInfo.authApp)
+	if !found {
+		websocketInfo.logger.Info(entity.UserNotLoggedInError.Error(), zap.String("url", r.RequestURI))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	userID := cookieInfo.UserID
+
 	var upgrader = websocket.Upgrader{
 		ReadBufferSize:  1024 * 1024,
 		WriteBufferSize: 1024 * 1024,
-		CheckOrigin: func(r *http.Request) bool {
-			return true
-		},
+		CheckOrigin:     websocketInfo.checkOrigin,
 	}
 	ws, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
@@ -45,6 +66,12 @@ func (websocketInfo *WebsocketInfo) HandleConnect(w http.ResponseWriter, r *http
 		return
 	}
 
+	ws.SetReadLimit(maxCommandSize)
+	ws.SetReadDeadline(time.Now().Add(pongWait))
+	ws.SetPongHandler(func(string) error {
+		return ws.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
 	_, initialMessageBytes, err := ws.ReadMessage()
 	if err != nil {
 		websocketInfo.logger.Info(err.Error())
@@ -59,33 +86,134 @@ func (websocketInfo *WebsocketInfo) HandleConnect(w http.ResponseWriter, r *http
 		return
 	}
 
+	if initialMessage.UserID != 0 && initialMessage.UserID != userID { // Client may still send ID, but it has to be their own
+		websocketInfo.logger.Info(entity.UnauthorizedError.Error(), zap.Int("from user", userID),
+			zap.Int("requested user", initialMessage.UserID))
+		closeWithError(ws, websocket.ClosePolicyViolation, entity.UnauthorizedError)
+		return
+	}
+
 	if websocketInfo.csrfOn {
-		err = websocketInfo.websocketApp.CheckToken(initialMessage.UserID, initialMessage.CSRFToken)
+		err = websocketInfo.websocketApp.CheckToken(userID, initialMessage.CSRFToken)
 		if err != nil {
-			websocketInfo.logger.Info(err.Error(), zap.Int("from user", initialMessage.UserID))
+			websocketInfo.logger.Info(err.Error(), zap.Int("from user", userID))
 			ws.Close()
 			return
 		}
 	}
 
-	err = websocketInfo.websocketApp.ChangeClient(initialMessage.UserID, ws)
+	err = websocketInfo.websocketApp.AddClient(userID, cookieInfo.Cookie, initialMessage.CSRFToken, ws)
 	if err != nil {
-		websocketInfo.logger.Info(err.Error(), zap.Int("from user", initialMessage.UserID))
+		websocketInfo.logger.Info(err.Error(), zap.Int("from user", userID))
 		ws.Close()
 		return
 	}
 
-	err = websocketInfo.notificationApp.SendAllNotifications(initialMessage.UserID)
+	err = websocketInfo.sendMissedMessages(userID, ws, initialMessage.LastSequence)
 	if err != nil {
-		websocketInfo.logger.Info(err.Error(), zap.Int("from user", initialMessage.UserID))
-		ws.Close()
+		websocketInfo.logger.Info(err.Error(), zap.Int("from user", userID))
+		websocketInfo.websocketApp.RemoveClient(userID, ws)
 		return
 	}
 
-	err = websocketInfo.chatApp.SendAllChats(initialMessage.UserID)
+	done := make(chan struct{})
+	go websocketInfo.keepAlive(userID, ws, done)
+	websocketInfo.readCommands(userID, ws)
+	close(done)
+
+	websocketInfo.websocketApp.RemoveClient(userID, ws)
+}
+
+// checkOrigin allows connections from frontend pages and from non-browser clients, which do not send Origin
+// Session cookie is sent with requests from any site, so without this check other sites could connect as current user
+func (websocketInfo *WebsocketInfo) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	for _, allowedOrigin := range websocketInfo.allowedOrigins {
+		if origin == allowedOrigin {
+			return true
+		}
+	}
+
+	websocketInfo.logger.Info("Websocket origin is not allowed", zap.String("origin", origin))
+	return false
+}
+
+// sendMissedMessages replays messages client missed since lastSequence
+// If client is new or too much time has passed, client is told to resync and gets all notifications and chats instead
+func (websocketInfo *WebsocketInfo) sendMissedMessages(userID int, ws *websocket.Conn, lastSequence int) error {
+	if lastSequence > 0 {
+		err := websocketInfo.websocketApp.ReplayMessages(userID, ws, lastSequence)
+		if err != entity.EventsExpiredError {
+			return err
+		}
+	}
+
+	sequence, err := websocketInfo.websocketApp.GetLastSequence(userID) // Read before lists are built, so that no later message is skipped
 	if err != nil {
-		websocketInfo.logger.Info(err.Error(), zap.Int("from user", initialMessage.UserID))
-		ws.Close()
-		return
+		return err
+	}
+
+	result, err := json.Marshal(entity.ResyncOutput{Type: entity.ResyncTypeKey, Sequence: sequence})
+	if err != nil {
+		return entity.JsonMarshallError
+	}
+
+	err = websocketInfo.websocketApp.SendMessageToClient(userID, ws, result)
+	if err != nil {
+		return err
+	}
+
+	err = websocketInfo.notificationApp.SendAllNotifications(userID, ws)
+	if err != nil {
+		return err
+	}
+
+	return websocketInfo.chatApp.SendAllChats(userID, ws)
+}
+
+// readCommands reads and executes client's commands until connection is closed or times out
+func (websocketInfo *WebsocketInfo) readCommands(userID int, ws *websocket.Conn) {
+	for {
+		_, commandBytes, err := ws.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				websocketInfo.logger.Info(err.Error(), zap.Int("from user", userID))
+			}
+			return
+		}
+
+		websocketInfo.handleCommand(userID, ws, commandBytes)
 	}
 }
+
+// keepAlive pings client and periodically checks if its own session is still active, until done is closed
+func (websocketInfo *WebsocketInfo) keepAlive(userID int, ws *websocket.Conn, done <-chan struct{}) {
+	pingTicker := time.NewTicker(pingPeriod)
+	defer pingTicker.Stop()
+	sessionTicker := time.NewTicker(sessionCheckInterval)
+	defer sessionTicker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-pingTicker.C:
+			err := ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(pingWait))
+			if err != nil { // Read loop will notice closed connection and finish
+				ws.Close()
+				return
+			}
+		case <-sessionTicker.C:
+			websocketInfo.websocketApp.CheckClientSession(userID, ws) // Other clients check their own sessions
+		}
+	}
+}
+
+func closeWithError(ws *websocket.Conn, code int, err error) {
+	ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, err.Error()), time.Now().Add(time.Second))
+	ws.Close()
+}
@@ -7,6 +7,8 @@ import (
 	"os"
 	"pinterest/application"
 	"pinterest/domain/entity"
+	"pinterest/domain/repository"
+	"pinterest/infrastructure/broadcast"
 	"pinterest/infrastructure/persistance"
 	"pinterest/interfaces/auth"
 	"pinterest/interfaces/board"
@@ -19,9 +21,11 @@ import (
 	"pinterest/interfaces/routing"
 	"pinterest/interfaces/websocket"
 	protoAuth "pinterest/services/auth/proto"
+	protoChat "pinterest/services/chat/proto"
 	protoComments "pinterest/services/comments/proto"
 	protoPins "pinterest/services/pins/proto"
 	protoUser "pinterest/services/user/proto"
+	"strings"
 	"text/template"
 	"time"
 
@@ -80,6 +84,18 @@ func runServer(addr string) {
 	fmt.Println("Successfully connected to tarantool database")
 	defer tarantoolConn.Close()
 
+	var broadcaster repository.BroadcasterInterface
+	switch os.Getenv("BROADCASTER") {
+	case "memory":
+		broadcaster = broadcast.NewMemoryBroadcaster()
+	default:
+		broadcaster, err = broadcast.NewTarantoolBroadcaster(tarantoolConn)
+		if err != nil {
+			sugarLogger.Fatal("Could not create tarantool broadcaster", zap.String("error", err.Error()))
+		}
+	}
+	defer broadcaster.Close()
+
 	sess := entity.ConnectAws()
 	// TODO divide file
 
@@ -107,6 +123,12 @@ func runServer(addr string) {
 	}
 	defer sessionComments.Close()
 
+	sessionChat, err := grpc.Dial(os.Getenv(dockerStatus+"_CHAT_PREFIX")+":8086", grpc.WithInsecure())
+	if err != nil {
+		sugarLogger.Fatal("Can not create session for Chat service")
+	}
+	defer sessionChat.Close()
+
 	pinEmailTemplteBytes, err := ioutil.ReadFile(string(entity.EmailTemplateFilenameKey))
 	if err != nil {
 		sugarLogger.Fatal("Could not find template for pin emails")
@@ -122,7 +144,9 @@ func runServer(addr string) {
 	repoPins := protoPins.NewPinsClient(sessionPins)
 	repoComments := protoComments.NewCommentsClient(sessionComments)
 	repoNotification := persistance.NewNotificationRepository(tarantoolConn)
-	repoChat := persistance.NewChatRepository(tarantoolConn)
+	repoChat := protoChat.NewChatsClient(sessionChat)
+	repoOutbox := persistance.NewOutboxRepository(tarantoolConn)
+	repoPresence := persistance.NewPresenceRepository(tarantoolConn)
 	cookieApp := application.NewCookieApp(repoAuth, 40, 10*time.Hour)
 	boardApp := application.NewBoardApp(repoPins)
 	s3App := application.NewS3App(sess, os.Getenv("BUCKET_NAME"))
@@ -131,25 +155,25 @@ func runServer(addr string) {
 		os.Getenv("VK_CLIENT_ID"), os.Getenv("VK_CLIENT_SECRET"))
 	pinApp := application.NewPinApp(repoPins, boardApp)
 	followApp := application.NewFollowApp(repoUser, pinApp)
-	commentApp := application.NewCommentApp(repoComments)
-	websocketApp := application.NewWebsocketApp(userApp)
+	commentApp := application.NewCommentApp(repoComments, pinApp, followApp)
+	websocketApp := application.NewWebsocketApp(userApp, authApp, broadcaster, repoOutbox, repoPresence)
 	notificationApp := application.NewNotificationApp(repoNotification, userApp, websocketApp)
-	chatApp := application.NewChatApp(repoChat, userApp, websocketApp)
+	chatApp := application.NewChatApp(repoChat, userApp, followApp, pinApp, boardApp, s3App, websocketApp)
+
+	boardInfo := board.NewBoardInfo(boardApp, followApp, userApp, notificationApp, logger)
+	trustedProxies := make([]string, 0)
+	if os.Getenv("TRUSTED_PROXIES") != "" {
+		for _, proxy := range strings.Split(os.Getenv("TRUSTED_PROXIES"), ",") {
+			trustedProxies = append(trustedProxies, strings.TrimSpace(proxy))
+		}
+	}
 
-	boardInfo := board.NewBoardInfo(boardApp, logger)
-	authInfo := auth.NewAuthInfo(userApp, authApp, cookieApp, s3App, boardApp, websocketApp, logger)
-	profileInfo := profile.NewProfileInfo(userApp, authApp, cookieApp, followApp, s3App, notificationApp, logger)
+	authInfo := auth.NewAuthInfo(userApp, authApp, cookieApp, s3App, boardApp, websocketApp, trustedProxies, logger)
+	profileInfo := profile.NewProfileInfo(userApp, authApp, cookieApp, followApp, s3App, notificationApp, websocketApp, logger)
 	followInfo := follow.NewFollowInfo(userApp, followApp, notificationApp, logger)
 	pinInfo := pin.NewPinInfo(pinApp, followApp, notificationApp, userApp, boardApp, s3App, logger,
 		pinEmailTemplate, os.Getenv("EMAIL_USERNAME"), os.Getenv("EMAIL_PASSWORD"))
-	commentsInfo := comment.NewCommentInfo(commentApp, pinApp, logger)
-	websocketInfo := websocket.NewWebsocketInfo(notificationApp, chatApp, websocketApp, os.Getenv("CSRF_ON") == "true", logger)
-	notificationInfo := notification.NewNotificationInfo(notificationApp, logger)
-	chatInfo := chat.NewChatnfo(chatApp, userApp, logger)
-	// TODO divide file
-
-	r := routing.CreateRouter(authApp, boardInfo, authInfo, profileInfo, followInfo, pinInfo, commentsInfo,
-		websocketInfo, notificationInfo, chatInfo, os.Getenv("CSRF_ON") == "true", os.Getenv("HTTPS_ON") == "true")
+	commentsInfo := comment.NewCommentInfo(commentApp, pinApp, userApp, followApp, notificationApp, logger)
 
 	allowedOrigins := make([]string, 0)
 	switch os.Getenv("HTTPS_ON") {
@@ -163,6 +187,15 @@ func runServer(addr string) {
 		sugarLogger.Fatal("HTTPS_ON variable is not set")
 	}
 
+	websocketInfo := websocket.NewWebsocketInfo(notificationApp, chatApp, websocketApp, authApp, os.Getenv("CSRF_ON") == "true",
+		allowedOrigins, logger)
+	notificationInfo := notification.NewNotificationInfo(notificationApp, logger)
+	chatInfo := chat.NewChatnfo(chatApp, userApp, logger)
+	// TODO divide file
+
+	r := routing.CreateRouter(authApp, boardInfo, authInfo, profileInfo, followInfo, pinInfo, commentsInfo,
+		websocketInfo, notificationInfo, chatInfo, os.Getenv("CSRF_ON") == "true", os.Getenv("HTTPS_ON") == "true")
+
 	c := cors.New(cors.Options{
 		AllowedOrigins:   allowedOrigins,
 		AllowCredentials: true,
@@ -25,7 +25,8 @@ func NewService(postgresDB *pgxpool.Pool, tarantoolDB *tarantool.Connection) *se
 	}
 }
 
-const GetUserPasswordQuery = "SELECT passwordhash FROM Users WHERE username=$1;"
+const GetUserPasswordQuery = "SELECT passwordhash, salt FROM Users WHERE username=$1;"
+const rehashUserPasswordQuery = "UPDATE Users SET passwordhash=$1, salt=$2 WHERE username=$3;"
 
 func (s *service) CheckUserCredentials(ctx context.Context, userCredentials *UserAuth) (*Error, error) {
 	tx, err := s.postgresDB.Begin(context.Background())
@@ -34,10 +35,10 @@ func (s *service) CheckUserCredentials(ctx context.Context, userCredentials *Use
 	}
 	defer tx.Rollback(context.Background())
 
-	var password string
+	var passwordHash, salt string
 
 	row := tx.QueryRow(context.Background(), GetUserPasswordQuery, userCredentials.Username)
-	err = row.Scan(&password)
+	err = row.Scan(&passwordHash, &salt)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return &Error{}, entity.UserNotFoundError
@@ -46,33 +47,60 @@ func (s *service) CheckUserCredentials(ctx context.Context, userCredentials *Use
 		return &Error{}, err
 	}
 
+	matches, needsRehash := entity.CheckPassword(userCredentials.Password, passwordHash, salt)
+	if !matches {
+		return &Error{}, entity.IncorrectPasswordError
+	}
+
+	if needsRehash { // Old or plain text passwords are replaced on successful login
+		passwordHash, salt, err = entity.HashPassword(userCredentials.Password)
+		if err != nil {
+			return &Error{}, err
+		}
+
+		_, err = tx.Exec(context.Background(), rehashUserPasswordQuery, passwordHash, salt, userCredentials.Username)
+		if err != nil {
+			return &Error{}, entity.UserSavingError
+		}
+	}
+
 	err = tx.Commit(context.Background())
 	if err != nil {
 		return &Error{}, entity.TransactionCommitError
 	}
-	if password != userCredentials.Password {
-		return &Error{}, entity.IncorrectPasswordError
-	}
 	return &Error{}, nil
 }
 
 func (s *service) AddCookieInfo(ctx context.Context, cookieInfo *CookieInfo) (*Error, error) {
 	cookieAsInterface := cookieInfoToInterfaces(cookieInfo)
-	resp, err := s.tarantoolDB.Replace("sessions", cookieAsInterface) // If session already exists, we update it
+	cookieAsInterface[0] = nil // Because we don't know session's ID
+	resp, err := s.tarantoolDB.Insert("sessions", cookieAsInterface)
 	if err != nil {
+		if resp == nil {
+			return &Error{}, err
+		}
+
 		switch resp.Code {
 		case tarantool.ErrTupleFound:
 			return &Error{}, entity.CookieFoundError
+		default:
+			return &Error{}, err
 		}
 	}
 
 	return &Error{}, nil
 }
 
+const lastSeenUpdateInterval = time.Minute // Last seen time is not updated on every request
+
 func (s *service) SearchByValue(ctx context.Context, cookieVal *CookieValue) (*CookieInfo, error) {
 	resp, err := s.tarantoolDB.Select("sessions", "secondary", 0, 1, tarantool.IterEq, []interface{}{cookieVal.CookieValue})
 
 	if err != nil {
+		if resp == nil {
+			return nil, err
+		}
+
 		switch resp.Code {
 		case tarantool.ErrTupleNotFound:
 			return nil, entity.CookieNotFoundError
@@ -92,38 +120,81 @@ func (s *service) SearchByValue(ctx context.Context, cookieVal *CookieValue) (*C
 		return nil, entity.CookieNotFoundError
 	}
 
+	if time.Since(cookieInfo.Session.LastSeen.AsTime()) > lastSeenUpdateInterval {
+		lastSeen := time.Now()
+		_, err = s.tarantoolDB.Update("sessions", "primary", []interface{}{uint(cookieInfo.Session.SessionID)},
+			[]interface{}{[]interface{}{"=", 8, uint(timeToUnixTimestamp(lastSeen))}})
+		if err == nil {
+			cookieInfo.Session.LastSeen = timestamppb.New(lastSeen)
+		}
+	}
+
 	return cookieInfo, nil
 }
 
-func (s *service) SearchByUserID(ctx context.Context, userID *UserID) (*CookieInfo, error) {
-	resp, err := s.tarantoolDB.Select("sessions", "primary", 0, 1, tarantool.IterEq, []interface{}{userID.Uid})
-
+func (s *service) GetSessions(ctx context.Context, userID *UserID) (*CookieInfoList, error) {
+	const MaxUint32 = ^uint32(0) // So that upper limit for select is practically "infinity"
+	resp, err := s.tarantoolDB.Select("sessions", "by_user", 0, MaxUint32, tarantool.IterEq, []interface{}{uint(userID.Uid)})
 	if err != nil {
-		switch resp.Code {
-		case tarantool.ErrTupleNotFound:
-			return nil, entity.CookieNotFoundError
-		default:
-			return nil, err
+		return nil, err
+	}
+
+	sessions := make([]*CookieInfo, 0, len(resp.Tuples()))
+	for _, tuple := range resp.Tuples() {
+		cookieInfo := interfacesToCookieInfo(tuple)
+		if cookieInfo.Cookie.Expires.AsTime().Before(time.Now()) { // Expired sessions are cleaned up along the way
+			s.RemoveCookie(ctx, cookieInfo)
+			continue
 		}
+
+		sessions = append(sessions, cookieInfo)
+	}
+
+	return &CookieInfoList{Cookies: sessions}, nil
+}
+
+func (s *service) RemoveCookie(ctx context.Context, cookieInfo *CookieInfo) (*Error, error) {
+	_, err := s.tarantoolDB.Delete("sessions", "secondary", []interface{}{cookieInfo.Cookie.Value})
+	return &Error{}, err
+}
+
+func (s *service) RemoveSession(ctx context.Context, sessionID *SessionID) (*Error, error) {
+	resp, err := s.tarantoolDB.Select("sessions", "primary", 0, 1, tarantool.IterEq, []interface{}{uint(sessionID.SessionID)})
+	if err != nil {
+		return &Error{}, err
 	}
 
 	if len(resp.Tuples()) != 1 {
-		return nil, entity.CookieNotFoundError
+		return &Error{}, entity.SessionNotFoundError
 	}
 
 	cookieInfo := interfacesToCookieInfo(resp.Tuples()[0])
-
-	if cookieInfo.Cookie.Expires.AsTime().Before(time.Now()) { // We check if cookie is not past it's expiration date
-		s.RemoveCookie(ctx, cookieInfo)
-		return nil, entity.CookieNotFoundError
+	if cookieInfo.UserID != sessionID.UserID { // Users can't see or remove other users' sessions
+		return &Error{}, entity.SessionNotFoundError
 	}
 
-	return cookieInfo, nil
+	_, err = s.tarantoolDB.Delete("sessions", "primary", []interface{}{uint(sessionID.SessionID)})
+	return &Error{}, err
 }
 
-func (s *service) RemoveCookie(ctx context.Context, cookieInfo *CookieInfo) (*Error, error) {
-	_, err := s.tarantoolDB.Delete("sessions", "primary", []interface{}{cookieInfo.UserID})
-	return &Error{}, err
+func (s *service) RemoveAllSessions(ctx context.Context, sessionsRemoval *SessionsRemoval) (*Error, error) {
+	sessions, err := s.GetSessions(ctx, &UserID{Uid: sessionsRemoval.UserID})
+	if err != nil {
+		return &Error{}, err
+	}
+
+	for _, cookieInfo := range sessions.Cookies {
+		if cookieInfo.Cookie.Value == sessionsRemoval.ExceptCookieValue {
+			continue
+		}
+
+		_, err = s.RemoveCookie(ctx, cookieInfo)
+		if err != nil {
+			return &Error{}, err
+		}
+	}
+
+	return &Error{}, nil
 }
 
 const getVkIDByUserIDQuery = "SELECT userID FROM Users WHERE vk_id=$1;"
@@ -179,21 +250,41 @@ func (s *service) AddVkID(ctx context.Context, ids *VkAndUserIDInfo) (*Error, er
 }
 
 func cookieInfoToInterfaces(cookieInfo *CookieInfo) []interface{} {
-	cookieAsInterfaces := make([]interface{}, 3)
-	cookieAsInterfaces[0] = uint(cookieInfo.UserID)
-	cookieAsInterfaces[1] = cookieInfo.Cookie.Value
-	cookieAsInterfaces[2] = uint(timeToUnixTimestamp(cookieInfo.Cookie.Expires.AsTime()))
+	session := cookieInfo.Session
+	if session == nil {
+		session = &Session{}
+	}
+
+	cookieAsInterfaces := make([]interface{}, 9)
+	cookieAsInterfaces[0] = uint(session.SessionID)
+	cookieAsInterfaces[1] = uint(cookieInfo.UserID)
+	cookieAsInterfaces[2] = cookieInfo.Cookie.Value
+	cookieAsInterfaces[3] = uint(timeToUnixTimestamp(cookieInfo.Cookie.Expires.AsTime()))
+	cookieAsInterfaces[4] = session.Device
+	cookieAsInterfaces[5] = session.IP
+	cookieAsInterfaces[6] = session.UserAgent
+	cookieAsInterfaces[7] = uint(timeToUnixTimestamp(session.Created.AsTime()))
+	cookieAsInterfaces[8] = uint(timeToUnixTimestamp(session.LastSeen.AsTime()))
 	return cookieAsInterfaces
 }
 
 func interfacesToCookieInfo(interfaces []interface{}) *CookieInfo {
 	cookie := new(Cookie)
-	cookie.Value = interfaces[1].(string)
-	cookie.Expires = timestamppb.New(unixTimestampToTime(int64(interfaces[2].(uint64))))
+	cookie.Value = interfaces[2].(string)
+	cookie.Expires = timestamppb.New(unixTimestampToTime(int64(interfaces[3].(uint64))))
+
+	session := new(Session)
+	session.SessionID = int64(interfaces[0].(uint64))
+	session.Device = interfaces[4].(string)
+	session.IP = interfaces[5].(string)
+	session.UserAgent = interfaces[6].(string)
+	session.Created = timestamppb.New(unixTimestampToTime(int64(interfaces[7].(uint64))))
+	session.LastSeen = timestamppb.New(unixTimestampToTime(int64(interfaces[8].(uint64))))
 
 	cookieInfo := new(CookieInfo)
-	cookieInfo.UserID = int64(interfaces[0].(uint64))
+	cookieInfo.UserID = int64(interfaces[1].(uint64))
 	cookieInfo.Cookie = cookie
+	cookieInfo.Session = session
 	return cookieInfo
 }
 
@@ -331,19 +331,107 @@ func (x *Cookie) GetExpires() *timestamp.Timestamp {
 	return nil
 }
 
+type Session struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID int64                `protobuf:"varint,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Device    string               `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	IP        string               `protobuf:"bytes,3,opt,name=IP,proto3" json:"IP,omitempty"`
+	UserAgent string               `protobuf:"bytes,4,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+	Created   *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
+	LastSeen  *timestamp.Timestamp `protobuf:"bytes,6,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Session) GetSessionID() int64 {
+	if x != nil {
+		return x.SessionID
+	}
+	return 0
+}
+
+func (x *Session) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *Session) GetIP() string {
+	if x != nil {
+		return x.IP
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetCreated() *timestamp.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Session) GetLastSeen() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
 type CookieInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID int64   `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Cookie *Cookie `protobuf:"bytes,2,opt,name=cookie,proto3" json:"cookie,omitempty"`
+	UserID  int64    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Cookie  *Cookie  `protobuf:"bytes,2,opt,name=cookie,proto3" json:"cookie,omitempty"`
+	Session *Session `protobuf:"bytes,3,opt,name=session,proto3" json:"session,omitempty"`
 }
 
 func (x *CookieInfo) Reset() {
 	*x = CookieInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_proto_msgTypes[6]
+		mi := &file_auth_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -356,7 +444,7 @@ func (x *CookieInfo) String() string {
 func (*CookieInfo) ProtoMessage() {}
 
 func (x *CookieInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[6]
+	mi := &file_auth_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -369,7 +457,7 @@ func (x *CookieInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CookieInfo.ProtoReflect.Descriptor instead.
 func (*CookieInfo) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{6}
+	return file_auth_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CookieInfo) GetUserID() int64 {
@@ -386,6 +474,170 @@ func (x *CookieInfo) GetCookie() *Cookie {
 	return nil
 }
 
+func (x *CookieInfo) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type CookieInfoList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cookies []*CookieInfo `protobuf:"bytes,1,rep,name=cookies,proto3" json:"cookies,omitempty"`
+}
+
+func (x *CookieInfoList) Reset() {
+	*x = CookieInfoList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CookieInfoList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CookieInfoList) ProtoMessage() {}
+
+func (x *CookieInfoList) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CookieInfoList.ProtoReflect.Descriptor instead.
+func (*CookieInfoList) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CookieInfoList) GetCookies() []*CookieInfo {
+	if x != nil {
+		return x.Cookies
+	}
+	return nil
+}
+
+type SessionID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID    int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	SessionID int64 `protobuf:"varint,2,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *SessionID) Reset() {
+	*x = SessionID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionID) ProtoMessage() {}
+
+func (x *SessionID) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionID.ProtoReflect.Descriptor instead.
+func (*SessionID) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SessionID) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SessionID) GetSessionID() int64 {
+	if x != nil {
+		return x.SessionID
+	}
+	return 0
+}
+
+type SessionsRemoval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID            int64  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ExceptCookieValue string `protobuf:"bytes,2,opt,name=exceptCookieValue,proto3" json:"exceptCookieValue,omitempty"` // Session with this cookie value is kept, if not empty
+}
+
+func (x *SessionsRemoval) Reset() {
+	*x = SessionsRemoval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionsRemoval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionsRemoval) ProtoMessage() {}
+
+func (x *SessionsRemoval) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionsRemoval.ProtoReflect.Descriptor instead.
+func (*SessionsRemoval) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SessionsRemoval) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SessionsRemoval) GetExceptCookieValue() string {
+	if x != nil {
+		return x.ExceptCookieValue
+	}
+	return ""
+}
+
 type Error struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -395,7 +647,7 @@ type Error struct {
 func (x *Error) Reset() {
 	*x = Error{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_auth_proto_msgTypes[7]
+		mi := &file_auth_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -408,7 +660,7 @@ func (x *Error) String() string {
 func (*Error) ProtoMessage() {}
 
 func (x *Error) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[7]
+	mi := &file_auth_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -421,7 +673,7 @@ func (x *Error) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Error.ProtoReflect.Descriptor instead.
 func (*Error) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{7}
+	return file_auth_proto_rawDescGZIP(), []int{11}
 }
 
 var File_auth_proto protoreflect.FileDescriptor
@@ -450,36 +702,73 @@ var file_auth_proto_rawDesc = []byte{
 	0x6c, 0x75, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x07, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x22, 0x4a, 0x0a, 0x0a, 0x43, 0x6f, 0x6f,
-	0x6b, 0x69, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x24, 0x0a, 0x06, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x52, 0x06, 0x63,
-	0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xee,
-	0x02, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x35, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12,
-	0x0e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x1a,
-	0x0b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x30,
-	0x0a, 0x0d, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x52, 0x07, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x22, 0xdb, 0x01, 0x0a, 0x07, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x50, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x50, 0x12, 0x1c, 0x0a, 0x09, 0x75,
+	0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x36, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x22, 0x73, 0x0a, 0x0a, 0x43, 0x6f, 0x6f, 0x6b, 0x69,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x24, 0x0a,
+	0x06, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x6f,
+	0x6b, 0x69, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x0e,
+	0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a,
+	0x0a, 0x07, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x10, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x1a, 0x0b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00,
-	0x12, 0x36, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x79, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x11, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x1a, 0x10, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6f, 0x6b,
-	0x69, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0c, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
-	0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0c,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x12, 0x10, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0b,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x2f, 0x0a,
-	0x0d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x56, 0x6b, 0x49, 0x44, 0x12, 0x0e,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x56, 0x6b, 0x49, 0x44, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0c,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x00, 0x12, 0x2f,
-	0x0a, 0x07, 0x41, 0x64, 0x64, 0x56, 0x6b, 0x49, 0x44, 0x12, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x2e, 0x56, 0x6b, 0x41, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x49, 0x6e, 0x66, 0x6f,
-	0x1a, 0x0b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x42,
-	0x04, 0x5a, 0x02, 0x2e, 0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x52, 0x07, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x09, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x57, 0x0a,
+	0x0f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x65,
+	0x70, 0x74, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x43, 0x6f, 0x6f, 0x6b, 0x69,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x32,
+	0xdb, 0x03, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x35, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x12, 0x0e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68,
+	0x1a, 0x0b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12,
+	0x30, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x10, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x1a, 0x0b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x00, 0x12, 0x36, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x79, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x10, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6f,
+	0x6b, 0x69, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x14, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f,
+	0x6f, 0x6b, 0x69, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x2f,
+	0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x12, 0x10,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x1a, 0x0b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12,
+	0x2f, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x0f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x1a, 0x0b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00,
+	0x12, 0x39, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x1a, 0x0b, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x56, 0x6b, 0x49, 0x44, 0x12, 0x0e, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x56, 0x6b, 0x49, 0x44, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0c, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x07,
+	0x41, 0x64, 0x64, 0x56, 0x6b, 0x49, 0x44, 0x12, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x56,
+	0x6b, 0x41, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0b,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x42, 0x04, 0x5a,
+	0x02, 0x2e, 0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -494,7 +783,7 @@ func file_auth_proto_rawDescGZIP() []byte {
 	return file_auth_proto_rawDescData
 }
 
-var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_auth_proto_goTypes = []interface{}{
 	(*UserAuth)(nil),            // 0: auth.UserAuth
 	(*VkIDInfo)(nil),            // 1: auth.VkIDInfo
@@ -502,32 +791,44 @@ var file_auth_proto_goTypes = []interface{}{
 	(*CookieValue)(nil),         // 3: auth.CookieValue
 	(*UserID)(nil),              // 4: auth.UserID
 	(*Cookie)(nil),              // 5: auth.Cookie
-	(*CookieInfo)(nil),          // 6: auth.CookieInfo
-	(*Error)(nil),               // 7: auth.Error
-	(*timestamp.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*Session)(nil),             // 6: auth.Session
+	(*CookieInfo)(nil),          // 7: auth.CookieInfo
+	(*CookieInfoList)(nil),      // 8: auth.CookieInfoList
+	(*SessionID)(nil),           // 9: auth.SessionID
+	(*SessionsRemoval)(nil),     // 10: auth.SessionsRemoval
+	(*Error)(nil),               // 11: auth.Error
+	(*timestamp.Timestamp)(nil), // 12: google.protobuf.Timestamp
 }
 var file_auth_proto_depIdxs = []int32{
-	8, // 0: auth.Cookie.Expires:type_name -> google.protobuf.Timestamp
-	5, // 1: auth.CookieInfo.cookie:type_name -> auth.Cookie
-	0, // 2: auth.Auth.CheckUserCredentials:input_type -> auth.UserAuth
-	6, // 3: auth.Auth.AddCookieInfo:input_type -> auth.CookieInfo
-	3, // 4: auth.Auth.SearchByValue:input_type -> auth.CookieValue
-	4, // 5: auth.Auth.SearchByUserID:input_type -> auth.UserID
-	6, // 6: auth.Auth.RemoveCookie:input_type -> auth.CookieInfo
-	1, // 7: auth.Auth.GetUserByVkID:input_type -> auth.VkIDInfo
-	2, // 8: auth.Auth.AddVkID:input_type -> auth.VkAndUserIDInfo
-	7, // 9: auth.Auth.CheckUserCredentials:output_type -> auth.Error
-	7, // 10: auth.Auth.AddCookieInfo:output_type -> auth.Error
-	6, // 11: auth.Auth.SearchByValue:output_type -> auth.CookieInfo
-	6, // 12: auth.Auth.SearchByUserID:output_type -> auth.CookieInfo
-	7, // 13: auth.Auth.RemoveCookie:output_type -> auth.Error
-	4, // 14: auth.Auth.GetUserByVkID:output_type -> auth.UserID
-	7, // 15: auth.Auth.AddVkID:output_type -> auth.Error
-	9, // [9:16] is the sub-list for method output_type
-	2, // [2:9] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	12, // 0: auth.Cookie.Expires:type_name -> google.protobuf.Timestamp
+	12, // 1: auth.Session.created:type_name -> google.protobuf.Timestamp
+	12, // 2: auth.Session.lastSeen:type_name -> google.protobuf.Timestamp
+	5,  // 3: auth.CookieInfo.cookie:type_name -> auth.Cookie
+	6,  // 4: auth.CookieInfo.session:type_name -> auth.Session
+	7,  // 5: auth.CookieInfoList.cookies:type_name -> auth.CookieInfo
+	0,  // 6: auth.Auth.CheckUserCredentials:input_type -> auth.UserAuth
+	7,  // 7: auth.Auth.AddCookieInfo:input_type -> auth.CookieInfo
+	3,  // 8: auth.Auth.SearchByValue:input_type -> auth.CookieValue
+	4,  // 9: auth.Auth.GetSessions:input_type -> auth.UserID
+	7,  // 10: auth.Auth.RemoveCookie:input_type -> auth.CookieInfo
+	9,  // 11: auth.Auth.RemoveSession:input_type -> auth.SessionID
+	10, // 12: auth.Auth.RemoveAllSessions:input_type -> auth.SessionsRemoval
+	1,  // 13: auth.Auth.GetUserByVkID:input_type -> auth.VkIDInfo
+	2,  // 14: auth.Auth.AddVkID:input_type -> auth.VkAndUserIDInfo
+	11, // 15: auth.Auth.CheckUserCredentials:output_type -> auth.Error
+	11, // 16: auth.Auth.AddCookieInfo:output_type -> auth.Error
+	7,  // 17: auth.Auth.SearchByValue:output_type -> auth.CookieInfo
+	8,  // 18: auth.Auth.GetSessions:output_type -> auth.CookieInfoList
+	11, // 19: auth.Auth.RemoveCookie:output_type -> auth.Error
+	11, // 20: auth.Auth.RemoveSession:output_type -> auth.Error
+	11, // 21: auth.Auth.RemoveAllSessions:output_type -> auth.Error
+	4,  // 22: auth.Auth.GetUserByVkID:output_type -> auth.UserID
+	11, // 23: auth.Auth.AddVkID:output_type -> auth.Error
+	15, // [15:24] is the sub-list for method output_type
+	6,  // [6:15] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_auth_proto_init() }
@@ -609,7 +910,7 @@ func file_auth_proto_init() {
 			}
 		}
 		file_auth_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CookieInfo); i {
+			switch v := v.(*Session); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -621,6 +922,54 @@ func file_auth_proto_init() {
 			}
 		}
 		file_auth_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CookieInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CookieInfoList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionsRemoval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Error); i {
 			case 0:
 				return &v.state
@@ -639,7 +988,7 @@ func file_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_auth_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -668,8 +1017,10 @@ type AuthClient interface {
 	CheckUserCredentials(ctx context.Context, in *UserAuth, opts ...grpc.CallOption) (*Error, error)
 	AddCookieInfo(ctx context.Context, in *CookieInfo, opts ...grpc.CallOption) (*Error, error)
 	SearchByValue(ctx context.Context, in *CookieValue, opts ...grpc.CallOption) (*CookieInfo, error)
-	SearchByUserID(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*CookieInfo, error)
+	GetSessions(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*CookieInfoList, error)
 	RemoveCookie(ctx context.Context, in *CookieInfo, opts ...grpc.CallOption) (*Error, error)
+	RemoveSession(ctx context.Context, in *SessionID, opts ...grpc.CallOption) (*Error, error)
+	RemoveAllSessions(ctx context.Context, in *SessionsRemoval, opts ...grpc.CallOption) (*Error, error)
 	GetUserByVkID(ctx context.Context, in *VkIDInfo, opts ...grpc.CallOption) (*UserID, error)
 	AddVkID(ctx context.Context, in *VkAndUserIDInfo, opts ...grpc.CallOption) (*Error, error)
 }
@@ -709,9 +1060,9 @@ func (c *authClient) SearchByValue(ctx context.Context, in *CookieValue, opts ..
 	return out, nil
 }
 
-func (c *authClient) SearchByUserID(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*CookieInfo, error) {
-	out := new(CookieInfo)
-	err := c.cc.Invoke(ctx, "/auth.Auth/SearchByUserID", in, out, opts...)
+func (c *authClient) GetSessions(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*CookieInfoList, error) {
+	out := new(CookieInfoList)
+	err := c.cc.Invoke(ctx, "/auth.Auth/GetSessions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -727,6 +1078,24 @@ func (c *authClient) RemoveCookie(ctx context.Context, in *CookieInfo, opts ...g
 	return out, nil
 }
 
+func (c *authClient) RemoveSession(ctx context.Context, in *SessionID, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/auth.Auth/RemoveSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) RemoveAllSessions(ctx context.Context, in *SessionsRemoval, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/auth.Auth/RemoveAllSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authClient) GetUserByVkID(ctx context.Context, in *VkIDInfo, opts ...grpc.CallOption) (*UserID, error) {
 	out := new(UserID)
 	err := c.cc.Invoke(ctx, "/auth.Auth/GetUserByVkID", in, out, opts...)
@@ -750,8 +1119,10 @@ type AuthServer interface {
 	CheckUserCredentials(context.Context, *UserAuth) (*Error, error)
 	AddCookieInfo(context.Context, *CookieInfo) (*Error, error)
 	SearchByValue(context.Context, *CookieValue) (*CookieInfo, error)
-	SearchByUserID(context.Context, *UserID) (*CookieInfo, error)
+	GetSessions(context.Context, *UserID) (*CookieInfoList, error)
 	RemoveCookie(context.Context, *CookieInfo) (*Error, error)
+	RemoveSession(context.Context, *SessionID) (*Error, error)
+	RemoveAllSessions(context.Context, *SessionsRemoval) (*Error, error)
 	GetUserByVkID(context.Context, *VkIDInfo) (*UserID, error)
 	AddVkID(context.Context, *VkAndUserIDInfo) (*Error, error)
 }
@@ -769,12 +1140,18 @@ func (*UnimplementedAuthServer) AddCookieInfo(context.Context, *CookieInfo) (*Er
 func (*UnimplementedAuthServer) SearchByValue(context.Context, *CookieValue) (*CookieInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchByValue not implemented")
 }
-func (*UnimplementedAuthServer) SearchByUserID(context.Context, *UserID) (*CookieInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchByUserID not implemented")
+func (*UnimplementedAuthServer) GetSessions(context.Context, *UserID) (*CookieInfoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessions not implemented")
 }
 func (*UnimplementedAuthServer) RemoveCookie(context.Context, *CookieInfo) (*Error, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveCookie not implemented")
 }
+func (*UnimplementedAuthServer) RemoveSession(context.Context, *SessionID) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSession not implemented")
+}
+func (*UnimplementedAuthServer) RemoveAllSessions(context.Context, *SessionsRemoval) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllSessions not implemented")
+}
 func (*UnimplementedAuthServer) GetUserByVkID(context.Context, *VkIDInfo) (*UserID, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserByVkID not implemented")
 }
@@ -840,20 +1217,20 @@ func _Auth_SearchByValue_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Auth_SearchByUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Auth_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServer).SearchByUserID(ctx, in)
+		return srv.(AuthServer).GetSessions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/auth.Auth/SearchByUserID",
+		FullMethod: "/auth.Auth/GetSessions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServer).SearchByUserID(ctx, req.(*UserID))
+		return srv.(AuthServer).GetSessions(ctx, req.(*UserID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -876,6 +1253,42 @@ func _Auth_RemoveCookie_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Auth_RemoveSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).RemoveSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth.Auth/RemoveSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).RemoveSession(ctx, req.(*SessionID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_RemoveAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionsRemoval)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).RemoveAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth.Auth/RemoveAllSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).RemoveAllSessions(ctx, req.(*SessionsRemoval))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Auth_GetUserByVkID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VkIDInfo)
 	if err := dec(in); err != nil {
@@ -929,13 +1342,21 @@ var _Auth_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Auth_SearchByValue_Handler,
 		},
 		{
-			MethodName: "SearchByUserID",
-			Handler:    _Auth_SearchByUserID_Handler,
+			MethodName: "GetSessions",
+			Handler:    _Auth_GetSessions_Handler,
 		},
 		{
 			MethodName: "RemoveCookie",
 			Handler:    _Auth_RemoveCookie_Handler,
 		},
+		{
+			MethodName: "RemoveSession",
+			Handler:    _Auth_RemoveSession_Handler,
+		},
+		{
+			MethodName: "RemoveAllSessions",
+			Handler:    _Auth_RemoveAllSessions_Handler,
+		},
 		{
 			MethodName: "GetUserByVkID",
 			Handler:    _Auth_GetUserByVkID_Handler,
@@ -0,0 +1,577 @@
+package chat
+
+import (
+	"context"
+	"pinterest/domain/entity"
+	. "pinterest/services/chat/proto"
+	"time"
+
+	"github.com/tarantool/go-tarantool"
+	_ "google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type service struct {
+	tarantoolDB *tarantool.Connection
+}
+
+func NewService(tarantoolDB *tarantool.Connection) *service {
+	return &service{tarantoolDB}
+}
+
+const MaxUint32 = ^uint32(0) // So that upper limit for select is practically "infinity"
+
+// CreateChat creates direct chat between two users
+// It returns chat's ID on success, ChatAlreadyExistsError if users already have a chat
+func (s *service) CreateChat(ctx context.Context, users *ChatUsers) (*ChatID, error) {
+	_, err := s.GetChatIDByUsers(ctx, users)
+	if err != entity.ChatNotFoundError {
+		if err == nil {
+			return &ChatID{}, entity.ChatAlreadyExistsError
+		}
+		return &ChatID{}, err
+	}
+
+	resp, err := s.tarantoolDB.Call17("create_direct_chat", []interface{}{uint(users.FirstUserID), uint(users.SecondUserID)})
+	if err != nil {
+		if resp != nil && resp.Code == tarantool.ErrTupleFound { // Chat was created by concurrent request after the check
+			return &ChatID{}, entity.ChatAlreadyExistsError
+		}
+		return &ChatID{}, err
+	}
+
+	if len(resp.Data) != 1 {
+		return &ChatID{}, entity.ChatCreationError
+	}
+
+	return &ChatID{ChatID: int64(entity.InterfaceToUint64(resp.Data[0]))}, nil
+}
+
+// CreateGroupChat creates group chat with passed title, avatar and members
+// It returns chat's ID on success
+func (s *service) CreateGroupChat(ctx context.Context, chat *Chat) (*ChatID, error) {
+	members := make([]interface{}, 0, len(chat.Members))
+	for _, member := range chat.Members {
+		members = append(members, []interface{}{uint(member.UserID), member.Role})
+	}
+
+	resp, err := s.tarantoolDB.Call17("create_group_chat", []interface{}{chat.Title, chat.AvatarLink, members})
+	if err != nil {
+		return &ChatID{}, err
+	}
+
+	if len(resp.Data) != 1 {
+		return &ChatID{}, entity.ChatCreationError
+	}
+
+	return &ChatID{ChatID: int64(entity.InterfaceToUint64(resp.Data[0]))}, nil
+}
+
+// GetChat returns chat with all of its members
+func (s *service) GetChat(ctx context.Context, chatID *ChatID) (*Chat, error) {
+	resp, err := s.tarantoolDB.Select("chats", "primary", 0, 1, tarantool.IterEq, []interface{}{uint(chatID.ChatID)})
+	if err != nil {
+		if resp == nil {
+			return &Chat{}, err
+		}
+
+		switch resp.Code {
+		case tarantool.ErrTupleNotFound:
+			return &Chat{}, entity.ChatNotFoundError
+		default:
+			return &Chat{}, err
+		}
+	}
+
+	if len(resp.Tuples()) != 1 {
+		return &Chat{}, entity.ChatNotFoundError
+	}
+
+	chat := interfacesToChat(resp.Tuples()[0])
+
+	resp, err = s.tarantoolDB.Select("chat_members", "primary", 0, MaxUint32, tarantool.IterEq, []interface{}{uint(chatID.ChatID)})
+	if err != nil {
+		return &Chat{}, err
+	}
+
+	chat.Members = make([]*ChatMember, 0, len(resp.Tuples()))
+	for _, tuple := range resp.Tuples() {
+		chat.Members = append(chat.Members, interfacesToChatMember(tuple))
+	}
+
+	return chat, nil
+}
+
+// GetAllChats returns all chats user is member of
+// It returns ChatsNotFoundError if there are none
+func (s *service) GetAllChats(ctx context.Context, userID *UserID) (*ChatsList, error) {
+	resp, err := s.tarantoolDB.Select("chat_members", "by_user", 0, MaxUint32, tarantool.IterEq, []interface{}{uint(userID.Uid)})
+	if err != nil {
+		return &ChatsList{}, err
+	}
+
+	if len(resp.Tuples()) == 0 {
+		return &ChatsList{}, entity.ChatsNotFoundError
+	}
+
+	chats := make([]*Chat, 0, len(resp.Tuples()))
+	for _, tuple := range resp.Tuples() {
+		member := interfacesToChatMember(tuple)
+		chat, err := s.GetChat(ctx, &ChatID{ChatID: member.ChatID})
+		if err != nil {
+			return &ChatsList{}, err
+		}
+		chats = append(chats, chat)
+	}
+
+	return &ChatsList{Chats: chats}, nil
+}
+
+const maxUnreadCount = 1000 // Unread messages are not counted further, client shows such count as "999+"
+
+// getChatSummary adds last message and user's unread messages count to chat
+func (s *service) getChatSummary(ctx context.Context, chat *Chat, userID int64) (*ChatSummary, error) {
+	var member *ChatMember
+	for _, chatMember := range chat.Members {
+		if chatMember.UserID == userID {
+			member = chatMember
+		}
+	}
+	if member == nil {
+		return &ChatSummary{}, entity.UserNotInChatError
+	}
+
+	summary := ChatSummary{Chat: chat}
+
+	lastMessages, err := s.GetMessagesPage(ctx, &MessagesPage{ChatID: chat.ChatID, Limit: 1})
+	if err != nil {
+		return &ChatSummary{}, err
+	}
+	if len(lastMessages.Messages) == 0 {
+		return &summary, nil
+	}
+	summary.LastMessage = lastMessages.Messages[0]
+
+	resp, err := s.tarantoolDB.Call17("count_unread_messages",
+		[]interface{}{uint(chat.ChatID), uint(member.LastReadMessageID), maxUnreadCount})
+	if err != nil {
+		return &ChatSummary{}, err
+	}
+	if len(resp.Data) == 1 {
+		summary.UnreadCount = int64(entity.InterfaceToUint64(resp.Data[0]))
+	}
+
+	return &summary, nil
+}
+
+// GetChatSummaries returns all of user's chats with their last messages and unread counts, but without history
+// It returns ChatsNotFoundError if user has no chats
+func (s *service) GetChatSummaries(ctx context.Context, userID *UserID) (*ChatSummariesList, error) {
+	chats, err := s.GetAllChats(ctx, userID)
+	if err != nil {
+		return &ChatSummariesList{}, err
+	}
+
+	summaries := make([]*ChatSummary, 0, len(chats.Chats))
+	for _, chat := range chats.Chats {
+		summary, err := s.getChatSummary(ctx, chat, userID.Uid)
+		if err != nil {
+			return &ChatSummariesList{}, err
+		}
+		summaries = append(summaries, summary)
+	}
+
+	return &ChatSummariesList{Summaries: summaries}, nil
+}
+
+// GetChatSummary returns one chat with its last message and unread count of specified member
+func (s *service) GetChatSummary(ctx context.Context, member *ChatMember) (*ChatSummary, error) {
+	chat, err := s.GetChat(ctx, &ChatID{ChatID: member.ChatID})
+	if err != nil {
+		return &ChatSummary{}, err
+	}
+
+	return s.getChatSummary(ctx, chat, member.UserID)
+}
+
+// SaveChat saves chat's title and avatar
+func (s *service) SaveChat(ctx context.Context, chat *Chat) (*Error, error) {
+	updateCommand := []interface{}{[]interface{}{"=", 2, chat.Title}, []interface{}{"=", 3, chat.AvatarLink}}
+	_, err := s.tarantoolDB.Update("chats", "primary", []interface{}{uint(chat.ChatID)}, updateCommand)
+	return &Error{}, err
+}
+
+// DeleteChat deletes chat along with its members and messages
+func (s *service) DeleteChat(ctx context.Context, chatID *ChatID) (*Error, error) {
+	_, err := s.tarantoolDB.Call17("delete_chat", []interface{}{uint(chatID.ChatID)})
+	return &Error{}, err
+}
+
+// GetChatIDByUsers finds direct chat between two users
+func (s *service) GetChatIDByUsers(ctx context.Context, users *ChatUsers) (*ChatID, error) {
+	firstUserID, secondUserID := users.FirstUserID, users.SecondUserID
+	if firstUserID > secondUserID { // Pairs are stored with smaller ID first
+		firstUserID, secondUserID = secondUserID, firstUserID
+	}
+
+	resp, err := s.tarantoolDB.Select("direct_chats", "primary", 0, 1, tarantool.IterEq, []interface{}{uint(firstUserID), uint(secondUserID)})
+	if err != nil {
+		return &ChatID{}, err
+	}
+
+	if len(resp.Tuples()) != 1 {
+		return &ChatID{}, entity.ChatNotFoundError
+	}
+
+	return &ChatID{ChatID: int64(entity.InterfaceToUint64(resp.Tuples()[0][2]))}, nil
+}
+
+// AddChatMember adds user to chat with specified role
+// It returns UserAlreadyInChatError if user is in chat already
+func (s *service) AddChatMember(ctx context.Context, member *ChatMember) (*Error, error) {
+	resp, err := s.tarantoolDB.Insert("chat_members", chatMemberToInterfaces(member))
+	if err != nil {
+		if resp != nil && resp.Code == tarantool.ErrTupleFound {
+			return &Error{}, entity.UserAlreadyInChatError
+		}
+		return &Error{}, err
+	}
+
+	return &Error{}, nil
+}
+
+// SaveChatMember saves member's role
+func (s *service) SaveChatMember(ctx context.Context, member *ChatMember) (*Error, error) {
+	updateCommand := []interface{}{[]interface{}{"=", 2, member.Role}} // Receipts are changed only by MoveChatMemberReceipts
+	resp, err := s.tarantoolDB.Update("chat_members", "primary", []interface{}{uint(member.ChatID), uint(member.UserID)}, updateCommand)
+	if err != nil {
+		return &Error{}, err
+	}
+
+	if len(resp.Tuples()) != 1 {
+		return &Error{}, entity.UserNotInChatError
+	}
+
+	return &Error{}, nil
+}
+
+// MoveChatMemberReceipts moves member's delivered and read pointers forward, pointers which are already further stay as they are
+// It returns updated member on success, UserNotInChatError if user is not in chat
+func (s *service) MoveChatMemberReceipts(ctx context.Context, member *ChatMember) (*ChatMember, error) {
+	resp, err := s.tarantoolDB.Call17("move_chat_member_receipts", []interface{}{uint(member.ChatID), uint(member.UserID),
+		uint(member.LastDeliveredMessageID), uint(member.LastReadMessageID)})
+	if err != nil {
+		return &ChatMember{}, err
+	}
+
+	if len(resp.Data) != 1 {
+		return &ChatMember{}, entity.UserNotInChatError
+	}
+
+	tuple, ok := resp.Data[0].([]interface{})
+	if !ok {
+		return &ChatMember{}, entity.UserNotInChatError
+	}
+
+	return interfacesToChatMember(tuple), nil
+}
+
+// RemoveChatMember removes user from chat
+func (s *service) RemoveChatMember(ctx context.Context, member *ChatMember) (*Error, error) {
+	resp, err := s.tarantoolDB.Delete("chat_members", "primary", []interface{}{uint(member.ChatID), uint(member.UserID)})
+	if err != nil {
+		return &Error{}, err
+	}
+
+	if len(resp.Tuples()) != 1 {
+		return &Error{}, entity.UserNotInChatError
+	}
+
+	return &Error{}, nil
+}
+
+// AddMessage saves message, returning its ID
+func (s *service) AddMessage(ctx context.Context, message *Message) (*MessageID, error) {
+	messageInterfaces := messageToInterfaces(message)
+	messageInterfaces[0] = nil // Because we don't know message's ID
+	resp, err := s.tarantoolDB.Insert("messages", messageInterfaces)
+	if err != nil {
+		return &MessageID{}, err
+	}
+
+	if len(resp.Tuples()) != 1 {
+		return &MessageID{}, entity.MessageAddingError
+	}
+
+	return &MessageID{MessageID: int64(entity.InterfaceToUint64(resp.Tuples()[0][0]))}, nil
+}
+
+// SaveMessage saves message's text, edit time, deletion flag and attachments
+func (s *service) SaveMessage(ctx context.Context, message *Message) (*Error, error) {
+	messageInterfaces := messageToInterfaces(message)
+	updateCommand := []interface{}{[]interface{}{"=", 3, messageInterfaces[3]}, []interface{}{"=", 6, messageInterfaces[6]},
+		[]interface{}{"=", 7, messageInterfaces[7]}, []interface{}{"=", 8, messageInterfaces[8]}}
+	resp, err := s.tarantoolDB.Update("messages", "primary", []interface{}{uint(message.MessageID)}, updateCommand)
+	if err != nil {
+		return &Error{}, err
+	}
+
+	if len(resp.Tuples()) != 1 {
+		return &Error{}, entity.MessageNotFoundError
+	}
+
+	return &Error{}, nil
+}
+
+func (s *service) GetMessage(ctx context.Context, messageID *MessageID) (*Message, error) {
+	resp, err := s.tarantoolDB.Select("messages", "primary", 0, 1, tarantool.IterEq, []interface{}{uint(messageID.MessageID)})
+	if err != nil {
+		if resp == nil {
+			return &Message{}, err
+		}
+
+		switch resp.Code {
+		case tarantool.ErrTupleNotFound:
+			return &Message{}, entity.MessageNotFoundError
+		default:
+			return &Message{}, err
+		}
+	}
+
+	if len(resp.Tuples()) != 1 {
+		return &Message{}, entity.MessageNotFoundError
+	}
+
+	return interfacesToMessage(resp.Tuples()[0]), nil
+}
+
+// GetMessages returns all messages of chat, oldest first
+// It returns MessagesNotFoundError if there are none
+func (s *service) GetMessages(ctx context.Context, chatID *ChatID) (*MessagesList, error) {
+	resp, err := s.tarantoolDB.Select("messages", "secondary", 0, MaxUint32, tarantool.IterEq, []interface{}{uint(chatID.ChatID)})
+	if err != nil {
+		if resp == nil {
+			return &MessagesList{}, err
+		}
+
+		switch resp.Code {
+		case tarantool.ErrTupleNotFound:
+			return &MessagesList{}, entity.MessagesNotFoundError
+		default:
+			return &MessagesList{}, err
+		}
+	}
+
+	if len(resp.Tuples()) == 0 {
+		return &MessagesList{}, entity.MessagesNotFoundError
+	}
+
+	messages := make([]*Message, 0, len(resp.Tuples()))
+	for _, tuple := range resp.Tuples() {
+		messages = append(messages, interfacesToMessage(tuple))
+	}
+
+	return &MessagesList{Messages: messages}, nil
+}
+
+// GetMessagesPage returns up to page.Limit messages of chat that were sent before page.BeforeMessageID, oldest first
+// It uses (chat_id, message_id) index, so only requested messages are read
+func (s *service) GetMessagesPage(ctx context.Context, page *MessagesPage) (*MessagesList, error) {
+	if page.Limit <= 0 {
+		return &MessagesList{Messages: make([]*Message, 0)}, nil
+	}
+
+	key := []interface{}{uint(page.ChatID), uint(page.BeforeMessageID)}
+	iterator := tarantool.IterLt
+	if page.BeforeMessageID == 0 {
+		key = []interface{}{uint(page.ChatID)}
+		iterator = tarantool.IterLe
+	}
+
+	resp, err := s.tarantoolDB.Select("messages", "secondary", 0, uint32(page.Limit), iterator, key)
+	if err != nil {
+		return &MessagesList{}, err
+	}
+
+	messages := make([]*Message, 0, len(resp.Tuples()))
+	for _, tuple := range resp.Tuples() {
+		message := interfacesToMessage(tuple)
+		if message.ChatID != page.ChatID { // Iterator went on to previous chat's messages
+			break
+		}
+		messages = append(messages, message)
+	}
+
+	for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 { // Messages were selected newest first
+		messages[i], messages[j] = messages[j], messages[i]
+	}
+
+	return &MessagesList{Messages: messages}, nil
+}
+
+// getMessagesAfter returns up to limit messages of chat that were sent after specified one, oldest first
+func (s *service) getMessagesAfter(chatID int64, afterMessageID int64, limit int64) ([]*Message, error) {
+	resp, err := s.tarantoolDB.Select("messages", "secondary", 0, uint32(limit), tarantool.IterGt,
+		[]interface{}{uint(chatID), uint(afterMessageID)})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*Message, 0, len(resp.Tuples()))
+	for _, tuple := range resp.Tuples() {
+		message := interfacesToMessage(tuple)
+		if message.ChatID != chatID { // Iterator went on to next chat's messages
+			break
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+// SearchMessages finds messages of user's chats that have words starting with every word of query, newest first
+// Every found message comes with query.ContextSize messages sent right before and after it
+func (s *service) SearchMessages(ctx context.Context, query *SearchQuery) (*SearchResultsList, error) {
+	resp, err := s.tarantoolDB.Select("chat_members", "by_user", 0, MaxUint32, tarantool.IterEq, []interface{}{uint(query.UserID)})
+	if err != nil {
+		return &SearchResultsList{}, err
+	}
+
+	chatIDs := make([]interface{}, 0, len(resp.Tuples()))
+	for _, tuple := range resp.Tuples() {
+		chatIDs = append(chatIDs, tuple[0])
+	}
+	if len(chatIDs) == 0 || query.Limit <= 0 {
+		return &SearchResultsList{Results: make([]*SearchResult, 0)}, nil
+	}
+
+	resp, err = s.tarantoolDB.Call17("search_messages",
+		[]interface{}{chatIDs, query.Query, uint(query.BeforeMessageID), uint(query.Limit)})
+	if err != nil {
+		return &SearchResultsList{}, err
+	}
+
+	var messageIDs []interface{}
+	if len(resp.Data) == 1 {
+		messageIDs, _ = resp.Data[0].([]interface{})
+	}
+
+	results := make([]*SearchResult, 0, len(messageIDs))
+	for _, messageID := range messageIDs {
+		message, err := s.GetMessage(ctx, &MessageID{MessageID: int64(entity.InterfaceToUint64(messageID))})
+		if err != nil {
+			return &SearchResultsList{}, err
+		}
+
+		result := SearchResult{Message: message}
+		if query.ContextSize > 0 {
+			before, err := s.GetMessagesPage(ctx, &MessagesPage{
+				ChatID:          message.ChatID,
+				BeforeMessageID: message.MessageID,
+				Limit:           query.ContextSize,
+			})
+			if err != nil {
+				return &SearchResultsList{}, err
+			}
+			result.Before = before.Messages
+
+			result.After, err = s.getMessagesAfter(message.ChatID, message.MessageID, query.ContextSize)
+			if err != nil {
+				return &SearchResultsList{}, err
+			}
+		}
+
+		results = append(results, &result)
+	}
+
+	return &SearchResultsList{Results: results}, nil
+}
+
+func interfacesToChat(interfaces []interface{}) *Chat {
+	return &Chat{
+		ChatID:     int64(entity.InterfaceToUint64(interfaces[0])),
+		Type:       interfaces[1].(string),
+		Title:      interfaces[2].(string),
+		AvatarLink: interfaces[3].(string),
+	}
+}
+
+func chatMemberToInterfaces(member *ChatMember) []interface{} {
+	return []interface{}{uint(member.ChatID), uint(member.UserID), member.Role, uint(member.LastReadMessageID),
+		uint(member.LastDeliveredMessageID)}
+}
+
+func interfacesToChatMember(interfaces []interface{}) *ChatMember {
+	member := &ChatMember{
+		ChatID:            int64(entity.InterfaceToUint64(interfaces[0])),
+		UserID:            int64(entity.InterfaceToUint64(interfaces[1])),
+		Role:              interfaces[2].(string),
+		LastReadMessageID: int64(entity.InterfaceToUint64(interfaces[3])),
+	}
+	member.LastDeliveredMessageID = member.LastReadMessageID // Members saved before delivery was tracked have no such field
+	if len(interfaces) > 4 && interfaces[4] != nil {
+		member.LastDeliveredMessageID = int64(entity.InterfaceToUint64(interfaces[4]))
+	}
+	return member
+}
+
+func messageToInterfaces(message *Message) []interface{} {
+	messageAsInterfaces := make([]interface{}, 9)
+	messageAsInterfaces[0] = uint(message.MessageID)
+	messageAsInterfaces[1] = uint(message.ChatID)
+	messageAsInterfaces[2] = uint(message.AuthorID)
+	messageAsInterfaces[3] = message.Text
+	messageAsInterfaces[4] = message.TimeOfCreation
+	messageAsInterfaces[5] = uint(message.CreationDate.AsTime().Unix())
+	messageAsInterfaces[6] = uint(0)
+	if message.EditDate != nil {
+		messageAsInterfaces[6] = uint(message.EditDate.AsTime().Unix())
+	}
+	messageAsInterfaces[7] = message.IsDeleted
+	attachments := make([]interface{}, 0, len(message.Attachments))
+	for _, attachment := range message.Attachments {
+		attachments = append(attachments, []interface{}{attachment.Type, uint(attachment.ID)})
+	}
+	messageAsInterfaces[8] = attachments
+	return messageAsInterfaces
+}
+
+func interfacesToMessage(interfaces []interface{}) *Message {
+	message := &Message{
+		MessageID:      int64(entity.InterfaceToUint64(interfaces[0])),
+		ChatID:         int64(entity.InterfaceToUint64(interfaces[1])),
+		AuthorID:       int64(entity.InterfaceToUint64(interfaces[2])),
+		Text:           interfaces[3].(string),
+		TimeOfCreation: interfaces[4].(string),
+		CreationDate:   timestamppb.New(time.Unix(0, 0)), // Messages saved before dates were tracked can not be changed
+	}
+
+	if len(interfaces) > 7 { // Older messages have no such fields
+		if interfaces[5] != nil {
+			message.CreationDate = timestamppb.New(time.Unix(int64(entity.InterfaceToUint64(interfaces[5])), 0))
+		}
+		if interfaces[6] != nil {
+			if editDate := int64(entity.InterfaceToUint64(interfaces[6])); editDate != 0 {
+				message.EditDate = timestamppb.New(time.Unix(editDate, 0))
+			}
+		}
+		if isDeleted, ok := interfaces[7].(bool); ok {
+			message.IsDeleted = isDeleted
+		}
+	}
+
+	if len(interfaces) > 8 { // Messages saved before attachments were introduced have none
+		attachments, _ := interfaces[8].([]interface{})
+		for _, attachmentInterface := range attachments {
+			attachment, ok := attachmentInterface.([]interface{})
+			if !ok || len(attachment) != 2 {
+				continue
+			}
+			attachmentType, _ := attachment[0].(string)
+			message.Attachments = append(message.Attachments,
+				&Attachment{Type: attachmentType, ID: int64(entity.InterfaceToUint64(attachment[1]))})
+		}
+	}
+
+	return message
+}
@@ -0,0 +1,83 @@
+package chat
+
+import (
+	. "pinterest/services/chat/proto"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Tarantool connector decodes numbers into the smallest fitting type, so the same field may come as any of them
+var interfacesToChatMemberTest = []struct {
+	name           string
+	interfaces     []interface{}
+	expectedMember *ChatMember
+}{
+	{
+		"Testing member with uint64 fields",
+		[]interface{}{uint64(1), uint64(2), "owner", uint64(300), uint64(400)},
+		&ChatMember{ChatID: 1, UserID: 2, Role: "owner", LastReadMessageID: 300, LastDeliveredMessageID: 400},
+	},
+	{
+		"Testing member with small integer fields",
+		[]interface{}{uint8(1), int8(2), "admin", uint16(300), int64(400)},
+		&ChatMember{ChatID: 1, UserID: 2, Role: "admin", LastReadMessageID: 300, LastDeliveredMessageID: 400},
+	},
+	{
+		"Testing member saved before delivery was tracked",
+		[]interface{}{uint32(1), int(2), "member", int32(300)},
+		&ChatMember{ChatID: 1, UserID: 2, Role: "member", LastReadMessageID: 300, LastDeliveredMessageID: 300},
+	},
+}
+
+func TestInterfacesToChatMember(t *testing.T) {
+	for _, tt := range interfacesToChatMemberTest {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.True(t, proto.Equal(tt.expectedMember, interfacesToChatMember(tt.interfaces)))
+		})
+	}
+}
+
+func TestInterfacesToChat(t *testing.T) {
+	chat := interfacesToChat([]interface{}{int16(5), "group", "Title", "avatar.jpg"})
+	require.True(t, proto.Equal(&Chat{ChatID: 5, Type: "group", Title: "Title", AvatarLink: "avatar.jpg"}, chat))
+}
+
+var interfacesToMessageTest = []struct {
+	name            string
+	interfaces      []interface{}
+	expectedMessage *Message
+}{
+	{
+		"Testing message with mixed integer types",
+		[]interface{}{uint8(11), int64(7), uint16(3), "Hi", "creation time", uint32(1622548800), uint8(0), false,
+			[]interface{}{[]interface{}{"pin", int8(5)}}},
+		&Message{MessageID: 11, ChatID: 7, AuthorID: 3, Text: "Hi", TimeOfCreation: "creation time",
+			CreationDate: timestamppb.New(time.Unix(1622548800, 0)), Attachments: []*Attachment{{Type: "pin", ID: 5}}},
+	},
+	{
+		"Testing edited message",
+		[]interface{}{uint64(11), uint64(7), uint64(3), "Hi", "creation time", uint64(1622548800), uint64(1622548900), false},
+		&Message{MessageID: 11, ChatID: 7, AuthorID: 3, Text: "Hi", TimeOfCreation: "creation time",
+			CreationDate: timestamppb.New(time.Unix(1622548800, 0)), EditDate: timestamppb.New(time.Unix(1622548900, 0))},
+	},
+	{
+		"Testing message saved before dates were tracked",
+		[]interface{}{int32(11), uint32(7), int(3), "Hi", "creation time"},
+		&Message{MessageID: 11, ChatID: 7, AuthorID: 3, Text: "Hi", TimeOfCreation: "creation time",
+			CreationDate: timestamppb.New(time.Unix(0, 0))},
+	},
+}
+
+func TestInterfacesToMessage(t *testing.T) {
+	for _, tt := range interfacesToMessageTest {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.True(t, proto.Equal(tt.expectedMessage, interfacesToMessage(tt.interfaces)))
+		})
+	}
+}
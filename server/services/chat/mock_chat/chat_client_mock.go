@@ -0,0 +1,417 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pinterest/services/chat/proto (interfaces: ChatsClient)
+
+// Package mock_chat is a generated GoMock package.
+package mock_chat
+
+import (
+	context "context"
+	__ "pinterest/services/chat/proto"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockChatsClient is a mock of ChatsClient interface.
+type MockChatsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockChatsClientMockRecorder
+}
+
+// MockChatsClientMockRecorder is the mock recorder for MockChatsClient.
+type MockChatsClientMockRecorder struct {
+	mock *MockChatsClient
+}
+
+// NewMockChatsClient creates a new mock instance.
+func NewMockChatsClient(ctrl *gomock.Controller) *MockChatsClient {
+	mock := &MockChatsClient{ctrl: ctrl}
+	mock.recorder = &MockChatsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChatsClient) EXPECT() *MockChatsClientMockRecorder {
+	return m.recorder
+}
+
+// AddChatMember mocks base method.
+func (m *MockChatsClient) AddChatMember(arg0 context.Context, arg1 *__.ChatMember, arg2 ...grpc.CallOption) (*__.Error, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddChatMember", varargs...)
+	ret0, _ := ret[0].(*__.Error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddChatMember indicates an expected call of AddChatMember.
+func (mr *MockChatsClientMockRecorder) AddChatMember(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChatMember", reflect.TypeOf((*MockChatsClient)(nil).AddChatMember), varargs...)
+}
+
+// AddMessage mocks base method.
+func (m *MockChatsClient) AddMessage(arg0 context.Context, arg1 *__.Message, arg2 ...grpc.CallOption) (*__.MessageID, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddMessage", varargs...)
+	ret0, _ := ret[0].(*__.MessageID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMessage indicates an expected call of AddMessage.
+func (mr *MockChatsClientMockRecorder) AddMessage(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMessage", reflect.TypeOf((*MockChatsClient)(nil).AddMessage), varargs...)
+}
+
+// CreateChat mocks base method.
+func (m *MockChatsClient) CreateChat(arg0 context.Context, arg1 *__.ChatUsers, arg2 ...grpc.CallOption) (*__.ChatID, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateChat", varargs...)
+	ret0, _ := ret[0].(*__.ChatID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChat indicates an expected call of CreateChat.
+func (mr *MockChatsClientMockRecorder) CreateChat(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChat", reflect.TypeOf((*MockChatsClient)(nil).CreateChat), varargs...)
+}
+
+// CreateGroupChat mocks base method.
+func (m *MockChatsClient) CreateGroupChat(arg0 context.Context, arg1 *__.Chat, arg2 ...grpc.CallOption) (*__.ChatID, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateGroupChat", varargs...)
+	ret0, _ := ret[0].(*__.ChatID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroupChat indicates an expected call of CreateGroupChat.
+func (mr *MockChatsClientMockRecorder) CreateGroupChat(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupChat", reflect.TypeOf((*MockChatsClient)(nil).CreateGroupChat), varargs...)
+}
+
+// DeleteChat mocks base method.
+func (m *MockChatsClient) DeleteChat(arg0 context.Context, arg1 *__.ChatID, arg2 ...grpc.CallOption) (*__.Error, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteChat", varargs...)
+	ret0, _ := ret[0].(*__.Error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteChat indicates an expected call of DeleteChat.
+func (mr *MockChatsClientMockRecorder) DeleteChat(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChat", reflect.TypeOf((*MockChatsClient)(nil).DeleteChat), varargs...)
+}
+
+// GetAllChats mocks base method.
+func (m *MockChatsClient) GetAllChats(arg0 context.Context, arg1 *__.UserID, arg2 ...grpc.CallOption) (*__.ChatsList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAllChats", varargs...)
+	ret0, _ := ret[0].(*__.ChatsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllChats indicates an expected call of GetAllChats.
+func (mr *MockChatsClientMockRecorder) GetAllChats(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllChats", reflect.TypeOf((*MockChatsClient)(nil).GetAllChats), varargs...)
+}
+
+// GetChat mocks base method.
+func (m *MockChatsClient) GetChat(arg0 context.Context, arg1 *__.ChatID, arg2 ...grpc.CallOption) (*__.Chat, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChat", varargs...)
+	ret0, _ := ret[0].(*__.Chat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChat indicates an expected call of GetChat.
+func (mr *MockChatsClientMockRecorder) GetChat(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChat", reflect.TypeOf((*MockChatsClient)(nil).GetChat), varargs...)
+}
+
+// GetChatIDByUsers mocks base method.
+func (m *MockChatsClient) GetChatIDByUsers(arg0 context.Context, arg1 *__.ChatUsers, arg2 ...grpc.CallOption) (*__.ChatID, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChatIDByUsers", varargs...)
+	ret0, _ := ret[0].(*__.ChatID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatIDByUsers indicates an expected call of GetChatIDByUsers.
+func (mr *MockChatsClientMockRecorder) GetChatIDByUsers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatIDByUsers", reflect.TypeOf((*MockChatsClient)(nil).GetChatIDByUsers), varargs...)
+}
+
+// GetChatSummaries mocks base method.
+func (m *MockChatsClient) GetChatSummaries(arg0 context.Context, arg1 *__.UserID, arg2 ...grpc.CallOption) (*__.ChatSummariesList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChatSummaries", varargs...)
+	ret0, _ := ret[0].(*__.ChatSummariesList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatSummaries indicates an expected call of GetChatSummaries.
+func (mr *MockChatsClientMockRecorder) GetChatSummaries(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatSummaries", reflect.TypeOf((*MockChatsClient)(nil).GetChatSummaries), varargs...)
+}
+
+// GetChatSummary mocks base method.
+func (m *MockChatsClient) GetChatSummary(arg0 context.Context, arg1 *__.ChatMember, arg2 ...grpc.CallOption) (*__.ChatSummary, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChatSummary", varargs...)
+	ret0, _ := ret[0].(*__.ChatSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatSummary indicates an expected call of GetChatSummary.
+func (mr *MockChatsClientMockRecorder) GetChatSummary(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatSummary", reflect.TypeOf((*MockChatsClient)(nil).GetChatSummary), varargs...)
+}
+
+// GetMessage mocks base method.
+func (m *MockChatsClient) GetMessage(arg0 context.Context, arg1 *__.MessageID, arg2 ...grpc.CallOption) (*__.Message, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMessage", varargs...)
+	ret0, _ := ret[0].(*__.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessage indicates an expected call of GetMessage.
+func (mr *MockChatsClientMockRecorder) GetMessage(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessage", reflect.TypeOf((*MockChatsClient)(nil).GetMessage), varargs...)
+}
+
+// GetMessages mocks base method.
+func (m *MockChatsClient) GetMessages(arg0 context.Context, arg1 *__.ChatID, arg2 ...grpc.CallOption) (*__.MessagesList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMessages", varargs...)
+	ret0, _ := ret[0].(*__.MessagesList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessages indicates an expected call of GetMessages.
+func (mr *MockChatsClientMockRecorder) GetMessages(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessages", reflect.TypeOf((*MockChatsClient)(nil).GetMessages), varargs...)
+}
+
+// GetMessagesPage mocks base method.
+func (m *MockChatsClient) GetMessagesPage(arg0 context.Context, arg1 *__.MessagesPage, arg2 ...grpc.CallOption) (*__.MessagesList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMessagesPage", varargs...)
+	ret0, _ := ret[0].(*__.MessagesList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessagesPage indicates an expected call of GetMessagesPage.
+func (mr *MockChatsClientMockRecorder) GetMessagesPage(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesPage", reflect.TypeOf((*MockChatsClient)(nil).GetMessagesPage), varargs...)
+}
+
+// MoveChatMemberReceipts mocks base method.
+func (m *MockChatsClient) MoveChatMemberReceipts(arg0 context.Context, arg1 *__.ChatMember, arg2 ...grpc.CallOption) (*__.ChatMember, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveChatMemberReceipts", varargs...)
+	ret0, _ := ret[0].(*__.ChatMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveChatMemberReceipts indicates an expected call of MoveChatMemberReceipts.
+func (mr *MockChatsClientMockRecorder) MoveChatMemberReceipts(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveChatMemberReceipts", reflect.TypeOf((*MockChatsClient)(nil).MoveChatMemberReceipts), varargs...)
+}
+
+// RemoveChatMember mocks base method.
+func (m *MockChatsClient) RemoveChatMember(arg0 context.Context, arg1 *__.ChatMember, arg2 ...grpc.CallOption) (*__.Error, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveChatMember", varargs...)
+	ret0, _ := ret[0].(*__.Error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveChatMember indicates an expected call of RemoveChatMember.
+func (mr *MockChatsClientMockRecorder) RemoveChatMember(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveChatMember", reflect.TypeOf((*MockChatsClient)(nil).RemoveChatMember), varargs...)
+}
+
+// SaveChat mocks base method.
+func (m *MockChatsClient) SaveChat(arg0 context.Context, arg1 *__.Chat, arg2 ...grpc.CallOption) (*__.Error, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveChat", varargs...)
+	ret0, _ := ret[0].(*__.Error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveChat indicates an expected call of SaveChat.
+func (mr *MockChatsClientMockRecorder) SaveChat(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveChat", reflect.TypeOf((*MockChatsClient)(nil).SaveChat), varargs...)
+}
+
+// SaveChatMember mocks base method.
+func (m *MockChatsClient) SaveChatMember(arg0 context.Context, arg1 *__.ChatMember, arg2 ...grpc.CallOption) (*__.Error, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveChatMember", varargs...)
+	ret0, _ := ret[0].(*__.Error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveChatMember indicates an expected call of SaveChatMember.
+func (mr *MockChatsClientMockRecorder) SaveChatMember(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveChatMember", reflect.TypeOf((*MockChatsClient)(nil).SaveChatMember), varargs...)
+}
+
+// SaveMessage mocks base method.
+func (m *MockChatsClient) SaveMessage(arg0 context.Context, arg1 *__.Message, arg2 ...grpc.CallOption) (*__.Error, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveMessage", varargs...)
+	ret0, _ := ret[0].(*__.Error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveMessage indicates an expected call of SaveMessage.
+func (mr *MockChatsClientMockRecorder) SaveMessage(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMessage", reflect.TypeOf((*MockChatsClient)(nil).SaveMessage), varargs...)
+}
+
+// SearchMessages mocks base method.
+func (m *MockChatsClient) SearchMessages(arg0 context.Context, arg1 *__.SearchQuery, arg2 ...grpc.CallOption) (*__.SearchResultsList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchMessages", varargs...)
+	ret0, _ := ret[0].(*__.SearchResultsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMessages indicates an expected call of SearchMessages.
+func (mr *MockChatsClientMockRecorder) SearchMessages(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockChatsClient)(nil).SearchMessages), varargs...)
+}
@@ -0,0 +1,2271 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.6.1
+// source: chat.proto
+
+package __
+
+import (
+	context "context"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ChatMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatID                 int64  `protobuf:"varint,1,opt,name=ChatID,proto3" json:"ChatID,omitempty"`
+	UserID                 int64  `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Role                   string `protobuf:"bytes,3,opt,name=Role,proto3" json:"Role,omitempty"`
+	LastReadMessageID      int64  `protobuf:"varint,4,opt,name=LastReadMessageID,proto3" json:"LastReadMessageID,omitempty"`
+	LastDeliveredMessageID int64  `protobuf:"varint,5,opt,name=LastDeliveredMessageID,proto3" json:"LastDeliveredMessageID,omitempty"`
+}
+
+func (x *ChatMember) Reset() {
+	*x = ChatMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMember) ProtoMessage() {}
+
+func (x *ChatMember) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMember.ProtoReflect.Descriptor instead.
+func (*ChatMember) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChatMember) GetChatID() int64 {
+	if x != nil {
+		return x.ChatID
+	}
+	return 0
+}
+
+func (x *ChatMember) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ChatMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ChatMember) GetLastReadMessageID() int64 {
+	if x != nil {
+		return x.LastReadMessageID
+	}
+	return 0
+}
+
+func (x *ChatMember) GetLastDeliveredMessageID() int64 {
+	if x != nil {
+		return x.LastDeliveredMessageID
+	}
+	return 0
+}
+
+type Chat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatID     int64         `protobuf:"varint,1,opt,name=ChatID,proto3" json:"ChatID,omitempty"`
+	Type       string        `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Title      string        `protobuf:"bytes,3,opt,name=Title,proto3" json:"Title,omitempty"`
+	AvatarLink string        `protobuf:"bytes,4,opt,name=AvatarLink,proto3" json:"AvatarLink,omitempty"`
+	Members    []*ChatMember `protobuf:"bytes,5,rep,name=Members,proto3" json:"Members,omitempty"`
+}
+
+func (x *Chat) Reset() {
+	*x = Chat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Chat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chat) ProtoMessage() {}
+
+func (x *Chat) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chat.ProtoReflect.Descriptor instead.
+func (*Chat) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Chat) GetChatID() int64 {
+	if x != nil {
+		return x.ChatID
+	}
+	return 0
+}
+
+func (x *Chat) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Chat) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Chat) GetAvatarLink() string {
+	if x != nil {
+		return x.AvatarLink
+	}
+	return ""
+}
+
+func (x *Chat) GetMembers() []*ChatMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageID      int64                `protobuf:"varint,1,opt,name=MessageID,proto3" json:"MessageID,omitempty"`
+	ChatID         int64                `protobuf:"varint,2,opt,name=ChatID,proto3" json:"ChatID,omitempty"`
+	AuthorID       int64                `protobuf:"varint,3,opt,name=AuthorID,proto3" json:"AuthorID,omitempty"`
+	Text           string               `protobuf:"bytes,4,opt,name=Text,proto3" json:"Text,omitempty"`
+	TimeOfCreation string               `protobuf:"bytes,5,opt,name=TimeOfCreation,proto3" json:"TimeOfCreation,omitempty"`
+	CreationDate   *timestamp.Timestamp `protobuf:"bytes,6,opt,name=CreationDate,proto3" json:"CreationDate,omitempty"`
+	EditDate       *timestamp.Timestamp `protobuf:"bytes,7,opt,name=EditDate,proto3" json:"EditDate,omitempty"` // Is not set if message was never edited
+	IsDeleted      bool                 `protobuf:"varint,8,opt,name=IsDeleted,proto3" json:"IsDeleted,omitempty"`
+	Attachments    []*Attachment        `protobuf:"bytes,9,rep,name=Attachments,proto3" json:"Attachments,omitempty"`
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Message) GetMessageID() int64 {
+	if x != nil {
+		return x.MessageID
+	}
+	return 0
+}
+
+func (x *Message) GetChatID() int64 {
+	if x != nil {
+		return x.ChatID
+	}
+	return 0
+}
+
+func (x *Message) GetAuthorID() int64 {
+	if x != nil {
+		return x.AuthorID
+	}
+	return 0
+}
+
+func (x *Message) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Message) GetTimeOfCreation() string {
+	if x != nil {
+		return x.TimeOfCreation
+	}
+	return ""
+}
+
+func (x *Message) GetCreationDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreationDate
+	}
+	return nil
+}
+
+func (x *Message) GetEditDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.EditDate
+	}
+	return nil
+}
+
+func (x *Message) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *Message) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// Attachment references pin or board shared in message, previews are resolved by gateway
+type Attachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"` // "pin" or "board"
+	ID   int64  `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Attachment) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Attachment) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type ChatID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatID int64 `protobuf:"varint,1,opt,name=chatID,proto3" json:"chatID,omitempty"`
+}
+
+func (x *ChatID) Reset() {
+	*x = ChatID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatID) ProtoMessage() {}
+
+func (x *ChatID) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatID.ProtoReflect.Descriptor instead.
+func (*ChatID) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ChatID) GetChatID() int64 {
+	if x != nil {
+		return x.ChatID
+	}
+	return 0
+}
+
+type MessageID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageID int64 `protobuf:"varint,1,opt,name=messageID,proto3" json:"messageID,omitempty"`
+}
+
+func (x *MessageID) Reset() {
+	*x = MessageID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageID) ProtoMessage() {}
+
+func (x *MessageID) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageID.ProtoReflect.Descriptor instead.
+func (*MessageID) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MessageID) GetMessageID() int64 {
+	if x != nil {
+		return x.MessageID
+	}
+	return 0
+}
+
+type UserID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid int64 `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *UserID) Reset() {
+	*x = UserID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserID) ProtoMessage() {}
+
+func (x *UserID) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserID.ProtoReflect.Descriptor instead.
+func (*UserID) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UserID) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type ChatUsers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstUserID  int64 `protobuf:"varint,1,opt,name=firstUserID,proto3" json:"firstUserID,omitempty"`
+	SecondUserID int64 `protobuf:"varint,2,opt,name=secondUserID,proto3" json:"secondUserID,omitempty"`
+}
+
+func (x *ChatUsers) Reset() {
+	*x = ChatUsers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatUsers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatUsers) ProtoMessage() {}
+
+func (x *ChatUsers) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatUsers.ProtoReflect.Descriptor instead.
+func (*ChatUsers) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChatUsers) GetFirstUserID() int64 {
+	if x != nil {
+		return x.FirstUserID
+	}
+	return 0
+}
+
+func (x *ChatUsers) GetSecondUserID() int64 {
+	if x != nil {
+		return x.SecondUserID
+	}
+	return 0
+}
+
+type ChatsList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chats []*Chat `protobuf:"bytes,1,rep,name=chats,proto3" json:"chats,omitempty"`
+}
+
+func (x *ChatsList) Reset() {
+	*x = ChatsList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatsList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatsList) ProtoMessage() {}
+
+func (x *ChatsList) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatsList.ProtoReflect.Descriptor instead.
+func (*ChatsList) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ChatsList) GetChats() []*Chat {
+	if x != nil {
+		return x.Chats
+	}
+	return nil
+}
+
+type MessagesList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *MessagesList) Reset() {
+	*x = MessagesList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessagesList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagesList) ProtoMessage() {}
+
+func (x *MessagesList) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessagesList.ProtoReflect.Descriptor instead.
+func (*MessagesList) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MessagesList) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// ChatSummary is chat as it is shown in list of user's chats
+type ChatSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chat        *Chat    `protobuf:"bytes,1,opt,name=chat,proto3" json:"chat,omitempty"`
+	LastMessage *Message `protobuf:"bytes,2,opt,name=lastMessage,proto3" json:"lastMessage,omitempty"` // Is not set if chat is empty
+	UnreadCount int64    `protobuf:"varint,3,opt,name=unreadCount,proto3" json:"unreadCount,omitempty"`
+}
+
+func (x *ChatSummary) Reset() {
+	*x = ChatSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatSummary) ProtoMessage() {}
+
+func (x *ChatSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatSummary.ProtoReflect.Descriptor instead.
+func (*ChatSummary) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ChatSummary) GetChat() *Chat {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *ChatSummary) GetLastMessage() *Message {
+	if x != nil {
+		return x.LastMessage
+	}
+	return nil
+}
+
+func (x *ChatSummary) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type ChatSummariesList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Summaries []*ChatSummary `protobuf:"bytes,1,rep,name=summaries,proto3" json:"summaries,omitempty"`
+}
+
+func (x *ChatSummariesList) Reset() {
+	*x = ChatSummariesList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatSummariesList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatSummariesList) ProtoMessage() {}
+
+func (x *ChatSummariesList) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatSummariesList.ProtoReflect.Descriptor instead.
+func (*ChatSummariesList) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ChatSummariesList) GetSummaries() []*ChatSummary {
+	if x != nil {
+		return x.Summaries
+	}
+	return nil
+}
+
+type MessagesPage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatID          int64 `protobuf:"varint,1,opt,name=chatID,proto3" json:"chatID,omitempty"`
+	BeforeMessageID int64 `protobuf:"varint,2,opt,name=beforeMessageID,proto3" json:"beforeMessageID,omitempty"` // 0 means "from the newest message"
+	Limit           int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *MessagesPage) Reset() {
+	*x = MessagesPage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessagesPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagesPage) ProtoMessage() {}
+
+func (x *MessagesPage) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessagesPage.ProtoReflect.Descriptor instead.
+func (*MessagesPage) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MessagesPage) GetChatID() int64 {
+	if x != nil {
+		return x.ChatID
+	}
+	return 0
+}
+
+func (x *MessagesPage) GetBeforeMessageID() int64 {
+	if x != nil {
+		return x.BeforeMessageID
+	}
+	return 0
+}
+
+func (x *MessagesPage) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID          int64  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"` // Only chats of this user are searched
+	Query           string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	BeforeMessageID int64  `protobuf:"varint,3,opt,name=beforeMessageID,proto3" json:"beforeMessageID,omitempty"` // 0 means "from the newest message"
+	Limit           int64  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	ContextSize     int64  `protobuf:"varint,5,opt,name=contextSize,proto3" json:"contextSize,omitempty"` // How many messages before and after every found one are returned
+}
+
+func (x *SearchQuery) Reset() {
+	*x = SearchQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchQuery) ProtoMessage() {}
+
+func (x *SearchQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchQuery.ProtoReflect.Descriptor instead.
+func (*SearchQuery) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SearchQuery) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SearchQuery) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchQuery) GetBeforeMessageID() int64 {
+	if x != nil {
+		return x.BeforeMessageID
+	}
+	return 0
+}
+
+func (x *SearchQuery) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchQuery) GetContextSize() int64 {
+	if x != nil {
+		return x.ContextSize
+	}
+	return 0
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message   `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+	Before  []*Message `protobuf:"bytes,2,rep,name=Before,proto3" json:"Before,omitempty"` // Oldest first
+	After   []*Message `protobuf:"bytes,3,rep,name=After,proto3" json:"After,omitempty"`   // Oldest first
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchResult) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *SearchResult) GetBefore() []*Message {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *SearchResult) GetAfter() []*Message {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type SearchResultsList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*SearchResult `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"` // Newest first
+}
+
+func (x *SearchResultsList) Reset() {
+	*x = SearchResultsList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResultsList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResultsList) ProtoMessage() {}
+
+func (x *SearchResultsList) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResultsList.ProtoReflect.Descriptor instead.
+func (*SearchResultsList) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchResultsList) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_chat_proto_rawDescGZIP(), []int{16}
+}
+
+var File_chat_proto protoreflect.FileDescriptor
+
+var file_chat_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x63, 0x68,
+	0x61, 0x74, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xb6, 0x01, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x68, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x43, 0x68, 0x61, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65,
+	0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x11, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x16, 0x4c, 0x61, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x4c, 0x61, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x22, 0x94, 0x01, 0x0a,
+	0x04, 0x43, 0x68, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x68, 0x61, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x43, 0x68, 0x61, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x76, 0x61, 0x74, 0x61,
+	0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x76, 0x61,
+	0x74, 0x61, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x2a, 0x0a, 0x07, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x22, 0xe1, 0x02, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x43, 0x68, 0x61, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x43,
+	0x68, 0x61, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x54,
+	0x69, 0x6d, 0x65, 0x4f, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a,
+	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a,
+	0x08, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x45, 0x64, 0x69,
+	0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x0b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x30, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x22, 0x20, 0x0a, 0x06, 0x43, 0x68, 0x61,
+	0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x44, 0x22, 0x29, 0x0a, 0x09, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x22, 0x1a, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75,
+	0x69, 0x64, 0x22, 0x51, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x2d, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x74, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x05, 0x63,
+	0x68, 0x61, 0x74, 0x73, 0x22, 0x39, 0x0a, 0x0c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22,
+	0x80, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x1e, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12,
+	0x2f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x44, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x09, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x22, 0x66, 0x0a, 0x0c, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x44,
+	0x12, 0x28, 0x0a, 0x0f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x9d, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x28,
+	0x0a, 0x0f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x83, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x27, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x42, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x23, 0x0a, 0x05, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x05, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x32, 0xd0, 0x07, 0x0a, 0x05, 0x43, 0x68, 0x61, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x0a,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x0f, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x0c, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x49, 0x44, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x0f, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x68, 0x61, 0x74, 0x12, 0x0a,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x49, 0x44, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x07, 0x47, 0x65,
+	0x74, 0x43, 0x68, 0x61, 0x74, 0x12, 0x0c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61,
+	0x74, 0x49, 0x44, 0x1a, 0x0a, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x22,
+	0x00, 0x12, 0x2e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x68, 0x61, 0x74, 0x73,
+	0x12, 0x0c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x0f,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0x00, 0x12, 0x3b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x0c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x1a, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x37,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x10, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x1a, 0x11, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x08, 0x53, 0x61, 0x76, 0x65, 0x43,
+	0x68, 0x61, 0x74, 0x12, 0x0a, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x1a,
+	0x0b, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x29,
+	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x0c, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x49, 0x44, 0x1a, 0x0b, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x74, 0x49, 0x44, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x0f, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x0c,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x49, 0x44, 0x22, 0x00, 0x12, 0x30,
+	0x0a, 0x0d, 0x41, 0x64, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x10, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x1a, 0x0b, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00,
+	0x12, 0x31, 0x0a, 0x0e, 0x53, 0x61, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x10, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x1a, 0x0b, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x16, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x10, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x1a,
+	0x10, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61,
+	0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43,
+	0x68, 0x61, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x1a, 0x0b, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0f, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0f, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x1a, 0x0d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x0b, 0x53, 0x61, 0x76, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0b, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x0c, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74,
+	0x49, 0x44, 0x1a, 0x12, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x12, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x50, 0x61, 0x67, 0x65, 0x1a,
+	0x12, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x11, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x17, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x00, 0x42, 0x04, 0x5a, 0x02, 0x2e, 0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_chat_proto_rawDescOnce sync.Once
+	file_chat_proto_rawDescData = file_chat_proto_rawDesc
+)
+
+func file_chat_proto_rawDescGZIP() []byte {
+	file_chat_proto_rawDescOnce.Do(func() {
+		file_chat_proto_rawDescData = protoimpl.X.CompressGZIP(file_chat_proto_rawDescData)
+	})
+	return file_chat_proto_rawDescData
+}
+
+var file_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_chat_proto_goTypes = []interface{}{
+	(*ChatMember)(nil),          // 0: chat.ChatMember
+	(*Chat)(nil),                // 1: chat.Chat
+	(*Message)(nil),             // 2: chat.Message
+	(*Attachment)(nil),          // 3: chat.Attachment
+	(*ChatID)(nil),              // 4: chat.ChatID
+	(*MessageID)(nil),           // 5: chat.MessageID
+	(*UserID)(nil),              // 6: chat.UserID
+	(*ChatUsers)(nil),           // 7: chat.ChatUsers
+	(*ChatsList)(nil),           // 8: chat.ChatsList
+	(*MessagesList)(nil),        // 9: chat.MessagesList
+	(*ChatSummary)(nil),         // 10: chat.ChatSummary
+	(*ChatSummariesList)(nil),   // 11: chat.ChatSummariesList
+	(*MessagesPage)(nil),        // 12: chat.MessagesPage
+	(*SearchQuery)(nil),         // 13: chat.SearchQuery
+	(*SearchResult)(nil),        // 14: chat.SearchResult
+	(*SearchResultsList)(nil),   // 15: chat.SearchResultsList
+	(*Error)(nil),               // 16: chat.Error
+	(*timestamp.Timestamp)(nil), // 17: google.protobuf.Timestamp
+}
+var file_chat_proto_depIdxs = []int32{
+	0,  // 0: chat.Chat.Members:type_name -> chat.ChatMember
+	17, // 1: chat.Message.CreationDate:type_name -> google.protobuf.Timestamp
+	17, // 2: chat.Message.EditDate:type_name -> google.protobuf.Timestamp
+	3,  // 3: chat.Message.Attachments:type_name -> chat.Attachment
+	1,  // 4: chat.ChatsList.chats:type_name -> chat.Chat
+	2,  // 5: chat.MessagesList.messages:type_name -> chat.Message
+	1,  // 6: chat.ChatSummary.chat:type_name -> chat.Chat
+	2,  // 7: chat.ChatSummary.lastMessage:type_name -> chat.Message
+	10, // 8: chat.ChatSummariesList.summaries:type_name -> chat.ChatSummary
+	2,  // 9: chat.SearchResult.Message:type_name -> chat.Message
+	2,  // 10: chat.SearchResult.Before:type_name -> chat.Message
+	2,  // 11: chat.SearchResult.After:type_name -> chat.Message
+	14, // 12: chat.SearchResultsList.Results:type_name -> chat.SearchResult
+	7,  // 13: chat.Chats.CreateChat:input_type -> chat.ChatUsers
+	1,  // 14: chat.Chats.CreateGroupChat:input_type -> chat.Chat
+	4,  // 15: chat.Chats.GetChat:input_type -> chat.ChatID
+	6,  // 16: chat.Chats.GetAllChats:input_type -> chat.UserID
+	6,  // 17: chat.Chats.GetChatSummaries:input_type -> chat.UserID
+	0,  // 18: chat.Chats.GetChatSummary:input_type -> chat.ChatMember
+	1,  // 19: chat.Chats.SaveChat:input_type -> chat.Chat
+	4,  // 20: chat.Chats.DeleteChat:input_type -> chat.ChatID
+	7,  // 21: chat.Chats.GetChatIDByUsers:input_type -> chat.ChatUsers
+	0,  // 22: chat.Chats.AddChatMember:input_type -> chat.ChatMember
+	0,  // 23: chat.Chats.SaveChatMember:input_type -> chat.ChatMember
+	0,  // 24: chat.Chats.MoveChatMemberReceipts:input_type -> chat.ChatMember
+	0,  // 25: chat.Chats.RemoveChatMember:input_type -> chat.ChatMember
+	2,  // 26: chat.Chats.AddMessage:input_type -> chat.Message
+	5,  // 27: chat.Chats.GetMessage:input_type -> chat.MessageID
+	2,  // 28: chat.Chats.SaveMessage:input_type -> chat.Message
+	4,  // 29: chat.Chats.GetMessages:input_type -> chat.ChatID
+	12, // 30: chat.Chats.GetMessagesPage:input_type -> chat.MessagesPage
+	13, // 31: chat.Chats.SearchMessages:input_type -> chat.SearchQuery
+	4,  // 32: chat.Chats.CreateChat:output_type -> chat.ChatID
+	4,  // 33: chat.Chats.CreateGroupChat:output_type -> chat.ChatID
+	1,  // 34: chat.Chats.GetChat:output_type -> chat.Chat
+	8,  // 35: chat.Chats.GetAllChats:output_type -> chat.ChatsList
+	11, // 36: chat.Chats.GetChatSummaries:output_type -> chat.ChatSummariesList
+	10, // 37: chat.Chats.GetChatSummary:output_type -> chat.ChatSummary
+	16, // 38: chat.Chats.SaveChat:output_type -> chat.Error
+	16, // 39: chat.Chats.DeleteChat:output_type -> chat.Error
+	4,  // 40: chat.Chats.GetChatIDByUsers:output_type -> chat.ChatID
+	16, // 41: chat.Chats.AddChatMember:output_type -> chat.Error
+	16, // 42: chat.Chats.SaveChatMember:output_type -> chat.Error
+	0,  // 43: chat.Chats.MoveChatMemberReceipts:output_type -> chat.ChatMember
+	16, // 44: chat.Chats.RemoveChatMember:output_type -> chat.Error
+	5,  // 45: chat.Chats.AddMessage:output_type -> chat.MessageID
+	2,  // 46: chat.Chats.GetMessage:output_type -> chat.Message
+	16, // 47: chat.Chats.SaveMessage:output_type -> chat.Error
+	9,  // 48: chat.Chats.GetMessages:output_type -> chat.MessagesList
+	9,  // 49: chat.Chats.GetMessagesPage:output_type -> chat.MessagesList
+	15, // 50: chat.Chats.SearchMessages:output_type -> chat.SearchResultsList
+	32, // [32:51] is the sub-list for method output_type
+	13, // [13:32] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_chat_proto_init() }
+func file_chat_proto_init() {
+	if File_chat_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_chat_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Chat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Attachment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatUsers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatsList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessagesList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatSummariesList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessagesPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResultsList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_chat_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_chat_proto_goTypes,
+		DependencyIndexes: file_chat_proto_depIdxs,
+		MessageInfos:      file_chat_proto_msgTypes,
+	}.Build()
+	File_chat_proto = out.File
+	file_chat_proto_rawDesc = nil
+	file_chat_proto_goTypes = nil
+	file_chat_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ChatsClient is the client API for Chats service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ChatsClient interface {
+	CreateChat(ctx context.Context, in *ChatUsers, opts ...grpc.CallOption) (*ChatID, error)
+	CreateGroupChat(ctx context.Context, in *Chat, opts ...grpc.CallOption) (*ChatID, error)
+	GetChat(ctx context.Context, in *ChatID, opts ...grpc.CallOption) (*Chat, error)
+	GetAllChats(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ChatsList, error)
+	GetChatSummaries(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ChatSummariesList, error)
+	GetChatSummary(ctx context.Context, in *ChatMember, opts ...grpc.CallOption) (*ChatSummary, error)
+	SaveChat(ctx context.Context, in *Chat, opts ...grpc.CallOption) (*Error, error)
+	DeleteChat(ctx context.Context, in *ChatID, opts ...grpc.CallOption) (*Error, error)
+	GetChatIDByUsers(ctx context.Context, in *ChatUsers, opts ...grpc.CallOption) (*ChatID, error)
+	AddChatMember(ctx context.Context, in *ChatMember, opts ...grpc.CallOption) (*Error, error)
+	SaveChatMember(ctx context.Context, in *ChatMember, opts ...grpc.CallOption) (*Error, error)
+	MoveChatMemberReceipts(ctx context.Context, in *ChatMember, opts ...grpc.CallOption) (*ChatMember, error)
+	RemoveChatMember(ctx context.Context, in *ChatMember, opts ...grpc.CallOption) (*Error, error)
+	AddMessage(ctx context.Context, in *Message, opts ...grpc.CallOption) (*MessageID, error)
+	GetMessage(ctx context.Context, in *MessageID, opts ...grpc.CallOption) (*Message, error)
+	SaveMessage(ctx context.Context, in *Message, opts ...grpc.CallOption) (*Error, error)
+	GetMessages(ctx context.Context, in *ChatID, opts ...grpc.CallOption) (*MessagesList, error)
+	GetMessagesPage(ctx context.Context, in *MessagesPage, opts ...grpc.CallOption) (*MessagesList, error)
+	SearchMessages(ctx context.Context, in *SearchQuery, opts ...grpc.CallOption) (*SearchResultsList, error)
+}
+
+type chatsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChatsClient(cc grpc.ClientConnInterface) ChatsClient {
+	return &chatsClient{cc}
+}
+
+func (c *chatsClient) CreateChat(ctx context.Context, in *ChatUsers, opts ...grpc.CallOption) (*ChatID, error) {
+	out := new(ChatID)
+	err := c.cc.Invoke(ctx, "/chat.Chats/CreateChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatsClient) CreateGroupChat(ctx context.Context, in *Chat, opts ...grpc.CallOption) (*ChatID, error) {
+	out := new(ChatID)
+	err := c.cc.Invoke(ctx, "/chat.Chats/CreateGroupChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatsClient) GetChat(ctx context.Context, in *ChatID, opts ...grpc.CallOption) (*Chat, error) {
+	out := new(Chat)
+	err := c.cc.Invoke(ctx, "/chat.Chats/GetChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatsClient) GetAllChats(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ChatsList, error) {
+	out := new(ChatsList)
+	err := c.cc.Invoke(ctx, "/chat.Chats/GetAllChats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatsClient) GetChatSummaries(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*ChatSummariesList, error) {
+	out := new(ChatSummariesList)
+	err := c.cc.Invoke(ctx, "/chat.Chats/GetChatSummaries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatsClient) GetChatSummary(ctx context.Context, in *ChatMember, opts ...grpc.CallOption) (*ChatSummary, error) {
+	out := new(ChatSummary)
+	err := c.cc.Invoke(ctx, "/chat.Chats/GetChatSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatsClient) SaveChat(ctx context.Context, in *Chat, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/chat.Chats/SaveChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatsClient) DeleteChat(ctx context.Context, in *ChatID, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/chat.Chats/DeleteChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatsClient) GetChatIDByUsers(ctx context.Context, in *ChatUsers, opts ...grpc.CallOption) (*ChatID, error) {
+	out := new(ChatID)
+	err := c.cc.Invoke(ctx, "/chat.Chats/GetChatIDByUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatsClient) AddChatMember(ctx context.Context, in *ChatMember, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/chat.Chats/AddChatMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatsClient) SaveChatMember(ctx context.Context, in *ChatMember, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/chat.Chats/SaveChatMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatsClient) MoveChatMemberReceipts(ctx context.Context, in *ChatMember, opts ...grpc.CallOption) (*ChatMember, error) {
+	out := new(ChatMember)
+	err := c.cc.Invoke(ctx, "/chat.Chats/MoveChatMemberReceipts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatsClient) RemoveChatMember(ctx context.Context, in *ChatMember, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/chat.Chats/RemoveChatMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatsClient) AddMessage(ctx context.Context, in *Message, opts ...grpc.CallOption) (*MessageID, error) {
+	out := new(MessageID)
+	err := c.cc.Invoke(ctx, "/chat.Chats/AddMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatsClient) GetMessage(ctx context.Context, in *MessageID, opts ...grpc.CallOption) (*Message, error) {
+	out := new(Message)
+	err := c.cc.Invoke(ctx, "/chat.Chats/GetMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatsClient) SaveMessage(ctx context.Context, in *Message, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/chat.Chats/SaveMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatsClient) GetMessages(ctx context.Context, in *ChatID, opts ...grpc.CallOption) (*MessagesList, error) {
+	out := new(MessagesList)
+	err := c.cc.Invoke(ctx, "/chat.Chats/GetMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatsClient) GetMessagesPage(ctx context.Context, in *MessagesPage, opts ...grpc.CallOption) (*MessagesList, error) {
+	out := new(MessagesList)
+	err := c.cc.Invoke(ctx, "/chat.Chats/GetMessagesPage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatsClient) SearchMessages(ctx context.Context, in *SearchQuery, opts ...grpc.CallOption) (*SearchResultsList, error) {
+	out := new(SearchResultsList)
+	err := c.cc.Invoke(ctx, "/chat.Chats/SearchMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChatsServer is the server API for Chats service.
+type ChatsServer interface {
+	CreateChat(context.Context, *ChatUsers) (*ChatID, error)
+	CreateGroupChat(context.Context, *Chat) (*ChatID, error)
+	GetChat(context.Context, *ChatID) (*Chat, error)
+	GetAllChats(context.Context, *UserID) (*ChatsList, error)
+	GetChatSummaries(context.Context, *UserID) (*ChatSummariesList, error)
+	GetChatSummary(context.Context, *ChatMember) (*ChatSummary, error)
+	SaveChat(context.Context, *Chat) (*Error, error)
+	DeleteChat(context.Context, *ChatID) (*Error, error)
+	GetChatIDByUsers(context.Context, *ChatUsers) (*ChatID, error)
+	AddChatMember(context.Context, *ChatMember) (*Error, error)
+	SaveChatMember(context.Context, *ChatMember) (*Error, error)
+	MoveChatMemberReceipts(context.Context, *ChatMember) (*ChatMember, error)
+	RemoveChatMember(context.Context, *ChatMember) (*Error, error)
+	AddMessage(context.Context, *Message) (*MessageID, error)
+	GetMessage(context.Context, *MessageID) (*Message, error)
+	SaveMessage(context.Context, *Message) (*Error, error)
+	GetMessages(context.Context, *ChatID) (*MessagesList, error)
+	GetMessagesPage(context.Context, *MessagesPage) (*MessagesList, error)
+	SearchMessages(context.Context, *SearchQuery) (*SearchResultsList, error)
+}
+
+// UnimplementedChatsServer can be embedded to have forward compatible implementations.
+type UnimplementedChatsServer struct {
+}
+
+func (*UnimplementedChatsServer) CreateChat(context.Context, *ChatUsers) (*ChatID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChat not implemented")
+}
+func (*UnimplementedChatsServer) CreateGroupChat(context.Context, *Chat) (*ChatID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupChat not implemented")
+}
+func (*UnimplementedChatsServer) GetChat(context.Context, *ChatID) (*Chat, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChat not implemented")
+}
+func (*UnimplementedChatsServer) GetAllChats(context.Context, *UserID) (*ChatsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllChats not implemented")
+}
+func (*UnimplementedChatsServer) GetChatSummaries(context.Context, *UserID) (*ChatSummariesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatSummaries not implemented")
+}
+func (*UnimplementedChatsServer) GetChatSummary(context.Context, *ChatMember) (*ChatSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatSummary not implemented")
+}
+func (*UnimplementedChatsServer) SaveChat(context.Context, *Chat) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveChat not implemented")
+}
+func (*UnimplementedChatsServer) DeleteChat(context.Context, *ChatID) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChat not implemented")
+}
+func (*UnimplementedChatsServer) GetChatIDByUsers(context.Context, *ChatUsers) (*ChatID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatIDByUsers not implemented")
+}
+func (*UnimplementedChatsServer) AddChatMember(context.Context, *ChatMember) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddChatMember not implemented")
+}
+func (*UnimplementedChatsServer) SaveChatMember(context.Context, *ChatMember) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveChatMember not implemented")
+}
+func (*UnimplementedChatsServer) MoveChatMemberReceipts(context.Context, *ChatMember) (*ChatMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveChatMemberReceipts not implemented")
+}
+func (*UnimplementedChatsServer) RemoveChatMember(context.Context, *ChatMember) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveChatMember not implemented")
+}
+func (*UnimplementedChatsServer) AddMessage(context.Context, *Message) (*MessageID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMessage not implemented")
+}
+func (*UnimplementedChatsServer) GetMessage(context.Context, *MessageID) (*Message, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessage not implemented")
+}
+func (*UnimplementedChatsServer) SaveMessage(context.Context, *Message) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveMessage not implemented")
+}
+func (*UnimplementedChatsServer) GetMessages(context.Context, *ChatID) (*MessagesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessages not implemented")
+}
+func (*UnimplementedChatsServer) GetMessagesPage(context.Context, *MessagesPage) (*MessagesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessagesPage not implemented")
+}
+func (*UnimplementedChatsServer) SearchMessages(context.Context, *SearchQuery) (*SearchResultsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMessages not implemented")
+}
+
+func RegisterChatsServer(s *grpc.Server, srv ChatsServer) {
+	s.RegisterService(&_Chats_serviceDesc, srv)
+}
+
+func _Chats_CreateChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatUsers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatsServer).CreateChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat.Chats/CreateChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatsServer).CreateChat(ctx, req.(*ChatUsers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chats_CreateGroupChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Chat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatsServer).CreateGroupChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat.Chats/CreateGroupChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatsServer).CreateGroupChat(ctx, req.(*Chat))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chats_GetChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatsServer).GetChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat.Chats/GetChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatsServer).GetChat(ctx, req.(*ChatID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chats_GetAllChats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatsServer).GetAllChats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat.Chats/GetAllChats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatsServer).GetAllChats(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chats_GetChatSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatsServer).GetChatSummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat.Chats/GetChatSummaries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatsServer).GetChatSummaries(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chats_GetChatSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatsServer).GetChatSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat.Chats/GetChatSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatsServer).GetChatSummary(ctx, req.(*ChatMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chats_SaveChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Chat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatsServer).SaveChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat.Chats/SaveChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatsServer).SaveChat(ctx, req.(*Chat))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chats_DeleteChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatsServer).DeleteChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat.Chats/DeleteChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatsServer).DeleteChat(ctx, req.(*ChatID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chats_GetChatIDByUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatUsers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatsServer).GetChatIDByUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat.Chats/GetChatIDByUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatsServer).GetChatIDByUsers(ctx, req.(*ChatUsers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chats_AddChatMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatsServer).AddChatMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat.Chats/AddChatMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatsServer).AddChatMember(ctx, req.(*ChatMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chats_SaveChatMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatsServer).SaveChatMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat.Chats/SaveChatMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatsServer).SaveChatMember(ctx, req.(*ChatMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chats_MoveChatMemberReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatsServer).MoveChatMemberReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat.Chats/MoveChatMemberReceipts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatsServer).MoveChatMemberReceipts(ctx, req.(*ChatMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chats_RemoveChatMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatsServer).RemoveChatMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat.Chats/RemoveChatMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatsServer).RemoveChatMember(ctx, req.(*ChatMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chats_AddMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Message)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatsServer).AddMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat.Chats/AddMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatsServer).AddMessage(ctx, req.(*Message))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chats_GetMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatsServer).GetMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat.Chats/GetMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatsServer).GetMessage(ctx, req.(*MessageID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chats_SaveMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Message)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatsServer).SaveMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat.Chats/SaveMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatsServer).SaveMessage(ctx, req.(*Message))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chats_GetMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatsServer).GetMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat.Chats/GetMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatsServer).GetMessages(ctx, req.(*ChatID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chats_GetMessagesPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessagesPage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatsServer).GetMessagesPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat.Chats/GetMessagesPage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatsServer).GetMessagesPage(ctx, req.(*MessagesPage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chats_SearchMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatsServer).SearchMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chat.Chats/SearchMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatsServer).SearchMessages(ctx, req.(*SearchQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Chats_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "chat.Chats",
+	HandlerType: (*ChatsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateChat",
+			Handler:    _Chats_CreateChat_Handler,
+		},
+		{
+			MethodName: "CreateGroupChat",
+			Handler:    _Chats_CreateGroupChat_Handler,
+		},
+		{
+			MethodName: "GetChat",
+			Handler:    _Chats_GetChat_Handler,
+		},
+		{
+			MethodName: "GetAllChats",
+			Handler:    _Chats_GetAllChats_Handler,
+		},
+		{
+			MethodName: "GetChatSummaries",
+			Handler:    _Chats_GetChatSummaries_Handler,
+		},
+		{
+			MethodName: "GetChatSummary",
+			Handler:    _Chats_GetChatSummary_Handler,
+		},
+		{
+			MethodName: "SaveChat",
+			Handler:    _Chats_SaveChat_Handler,
+		},
+		{
+			MethodName: "DeleteChat",
+			Handler:    _Chats_DeleteChat_Handler,
+		},
+		{
+			MethodName: "GetChatIDByUsers",
+			Handler:    _Chats_GetChatIDByUsers_Handler,
+		},
+		{
+			MethodName: "AddChatMember",
+			Handler:    _Chats_AddChatMember_Handler,
+		},
+		{
+			MethodName: "SaveChatMember",
+			Handler:    _Chats_SaveChatMember_Handler,
+		},
+		{
+			MethodName: "MoveChatMemberReceipts",
+			Handler:    _Chats_MoveChatMemberReceipts_Handler,
+		},
+		{
+			MethodName: "RemoveChatMember",
+			Handler:    _Chats_RemoveChatMember_Handler,
+		},
+		{
+			MethodName: "AddMessage",
+			Handler:    _Chats_AddMessage_Handler,
+		},
+		{
+			MethodName: "GetMessage",
+			Handler:    _Chats_GetMessage_Handler,
+		},
+		{
+			MethodName: "SaveMessage",
+			Handler:    _Chats_SaveMessage_Handler,
+		},
+		{
+			MethodName: "GetMessages",
+			Handler:    _Chats_GetMessages_Handler,
+		},
+		{
+			MethodName: "GetMessagesPage",
+			Handler:    _Chats_GetMessagesPage_Handler,
+		},
+		{
+			MethodName: "SearchMessages",
+			Handler:    _Chats_SearchMessages_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chat.proto",
+}
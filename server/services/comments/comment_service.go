@@ -4,9 +4,11 @@ import (
 	"context"
 	"pinterest/domain/entity"
 	. "pinterest/services/comments/proto"
+	"time"
 
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type service struct {
@@ -17,66 +19,321 @@ func NewService(db *pgxpool.Pool) *service {
 	return &service{db}
 }
 
-const addCommentQuery string = "INSERT INTO comments (userID, pinID, text)\n" +
-	"values ($1, $2, $3);"
+const addCommentQuery string = "INSERT INTO comments (userID, pinID, text, parentID)\n" +
+	"values ($1, $2, $3, NULLIF($4, 0))\n" +
+	"RETURNING id;"
+const getParentCommentQuery string = "SELECT pinID, COALESCE(parentID, 0) FROM comments\n" +
+	"WHERE id=$1;"
+const updateRepliesCountQuery string = "UPDATE comments SET replies_count = replies_count + $2\n" +
+	"WHERE id=$1;"
 
-func (s *service) AddComment(ctx context.Context, comment *Comment) (*Error, error) {
+// AddComment adds comment to pin
+// If comment is a reply, it is added to the thread of comment it replies to,
+// so threads are never more than one level deep
+// It returns comment's assigned ID, nil on success and nil, error on failure
+func (s *service) AddComment(ctx context.Context, comment *Comment) (*CommentID, error) {
 	tx, err := s.db.Begin(context.Background())
 	if err != nil {
-		return &Error{}, entity.TransactionBeginError
+		return &CommentID{}, entity.TransactionBeginError
 	}
 	defer tx.Rollback(context.Background())
 
-	commandTag, err := tx.Exec(context.Background(),
+	rootID := comment.ParentID
+	if comment.ParentID != 0 {
+		var parentPinID, parentParentID int64
+		err = tx.QueryRow(context.Background(), getParentCommentQuery, comment.ParentID).Scan(&parentPinID, &parentParentID)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return &CommentID{}, entity.ParentCommentNotFoundError
+			}
+			return &CommentID{}, entity.CommentScanError
+		}
+		if parentPinID != comment.PinID {
+			return &CommentID{}, entity.ParentCommentNotFoundError
+		}
+		if parentParentID != 0 {
+			rootID = parentParentID
+		}
+	}
+
+	newCommentID := CommentID{}
+	row := tx.QueryRow(context.Background(),
 		addCommentQuery,
 		comment.UserID,
 		comment.PinID,
-		comment.PinComment)
+		comment.PinComment,
+		rootID)
+	err = row.Scan(&newCommentID.CommentID)
 	if err != nil {
-		return &Error{}, err
+		return &CommentID{}, entity.AddCommentError
 	}
-	if commandTag.RowsAffected() != 1 {
-		return &Error{}, entity.AddCommentError
+
+	if rootID != 0 {
+		_, err = tx.Exec(context.Background(), updateRepliesCountQuery, rootID, 1)
+		if err != nil {
+			return &CommentID{}, entity.AddCommentError
+		}
 	}
 
 	err = tx.Commit(context.Background())
 	if err != nil {
-		return &Error{}, entity.TransactionCommitError
+		return &CommentID{}, entity.TransactionCommitError
 	}
-	return &Error{}, nil
+	return &newCommentID, nil
+}
+
+// commentRow is implemented by both pgx.Row and pgx.Rows
+type commentRow interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanComment fills comment with columns selected by commentFields
+func scanComment(row commentRow, comment *Comment) error {
+	var creationDate time.Time
+	var editDate *time.Time
+	err := row.Scan(&comment.CommentID, &comment.UserID, &comment.PinID, &comment.PinComment,
+		&creationDate, &editDate, &comment.ParentID, &comment.RepliesCount, &comment.Username, &comment.AvatarLink)
+	if err != nil {
+		return err
+	}
+
+	comment.CreationDate = timestamppb.New(creationDate)
+	if editDate != nil {
+		comment.EditDate = timestamppb.New(*editDate)
+	}
+	return nil
+}
+
+// commentFields are selected from comments joined with their authors
+const commentFields string = "comments.id, comments.userID, comments.pinID, comments.text,\n" +
+	"comments.creationDate, comments.editDate, COALESCE(comments.parentID, 0), comments.replies_count,\n" +
+	"users.username, users.avatar\n" +
+	"FROM comments\n" +
+	"INNER JOIN users ON users.userID = comments.userID\n"
+
+const getCommentQuery string = "SELECT " + commentFields +
+	"WHERE comments.id=$1;"
+
+// GetComment fetches comment with passed ID
+// It returns that comment, nil on success and nil, error on failure
+func (s *service) GetComment(ctx context.Context, commentID *CommentID) (*Comment, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &Comment{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	comment := Comment{}
+	err = scanComment(tx.QueryRow(context.Background(), getCommentQuery, commentID.CommentID), &comment)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return &Comment{}, entity.CommentNotFoundError
+		}
+		return &Comment{}, entity.CommentScanError
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &Comment{}, entity.TransactionCommitError
+	}
+	return &comment, nil
 }
 
-const getCommentsByPinQuery string = "SELECT userID, pinID, text FROM comments\n" +
-	"WHERE pinID=$1;"
+const countCommentsQuery string = "SELECT COUNT(*) FROM comments WHERE pinID=$1;"
+const getOldestThreadsQuery string = "SELECT " + commentFields +
+	"WHERE comments.pinID=$1 AND comments.parentID IS NULL AND comments.id > $2\n" +
+	"ORDER BY comments.id\n" +
+	"LIMIT $3;"
+const getNewestThreadsQuery string = "SELECT " + commentFields +
+	"WHERE comments.pinID=$1 AND comments.parentID IS NULL AND ($2 = 0 OR comments.id < $2)\n" +
+	"ORDER BY comments.id DESC\n" +
+	"LIMIT $3;"
+const getMostRepliedThreadsQuery string = "SELECT " + commentFields +
+	"WHERE comments.pinID=$1 AND comments.parentID IS NULL\n" +
+	"AND ($2 = 0 OR (comments.replies_count, comments.id) < ($4, $2))\n" +
+	"ORDER BY comments.replies_count DESC, comments.id DESC\n" +
+	"LIMIT $3;"
+const getFirstRepliesQuery string = "SELECT " + commentFields +
+	"WHERE comments.id IN (\n" +
+	"    SELECT id FROM (\n" +
+	"        SELECT id, ROW_NUMBER() OVER (PARTITION BY parentID ORDER BY id) AS position\n" +
+	"        FROM comments WHERE parentID = ANY($1)) AS replies\n" +
+	"    WHERE position <= $2)\n" +
+	"ORDER BY comments.id;"
 
-func (s *service) GetComments(ctx context.Context, pinID *PinID) (*CommentsList, error) {
+// GetComments fetches page of pin's threads in requested order and at most page.RepliesLimit first replies of each of them
+// Pages are continued from the last thread of previous page, so comments added meanwhile don't shift them
+// When sorting by replies, threads that get or lose replies between requests move in the order,
+// so they may appear on two pages or on none of them
+// It returns threads' first comments followed by replies, oldest first, and amount of all pin's comments
+func (s *service) GetComments(ctx context.Context, page *CommentsPage) (*CommentsList, error) {
 	tx, err := s.db.Begin(context.Background())
 	if err != nil {
 		return &CommentsList{}, entity.TransactionBeginError
 	}
 	defer tx.Rollback(context.Background())
 
-	comments := make([]*Comment, 0)
-	rows, err := tx.Query(context.Background(), getCommentsByPinQuery, pinID.PinID)
+	commentsList := CommentsList{Comments: make([]*Comment, 0)}
+	err = tx.QueryRow(context.Background(), countCommentsQuery, page.PinID).Scan(&commentsList.TotalCount)
+	if err != nil {
+		return &CommentsList{}, entity.GetCommentsError
+	}
+
+	var rows pgx.Rows
+	switch page.Sort {
+	case string(entity.OldestCommentsSortKey):
+		rows, err = tx.Query(context.Background(), getOldestThreadsQuery, page.PinID, page.AfterCommentID, page.Limit)
+	case string(entity.NewestCommentsSortKey):
+		rows, err = tx.Query(context.Background(), getNewestThreadsQuery, page.PinID, page.AfterCommentID, page.Limit)
+	case string(entity.MostRepliedCommentsSortKey):
+		rows, err = tx.Query(context.Background(), getMostRepliedThreadsQuery,
+			page.PinID, page.AfterCommentID, page.Limit, page.AfterRepliesCount)
+	default:
+		return &CommentsList{}, entity.CommentsSortError
+	}
+	if err != nil {
+		return &CommentsList{}, entity.GetCommentsError
+	}
+
+	threadIDs := make([]int64, 0)
+	for rows.Next() {
+		comment := Comment{}
+		err = scanComment(rows, &comment)
+		if err != nil {
+			return &CommentsList{}, entity.CommentScanError
+		}
+		commentsList.Comments = append(commentsList.Comments, &comment)
+		if comment.RepliesCount > 0 {
+			threadIDs = append(threadIDs, comment.CommentID)
+		}
+	}
+
+	if len(threadIDs) > 0 && page.RepliesLimit > 0 {
+		rows, err = tx.Query(context.Background(), getFirstRepliesQuery, threadIDs, page.RepliesLimit)
+		if err != nil {
+			return &CommentsList{}, entity.GetCommentsError
+		}
+
+		for rows.Next() {
+			comment := Comment{}
+			err = scanComment(rows, &comment)
+			if err != nil {
+				return &CommentsList{}, entity.CommentScanError
+			}
+			commentsList.Comments = append(commentsList.Comments, &comment)
+		}
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &CommentsList{}, entity.TransactionCommitError
+	}
+	return &commentsList, nil
+}
+
+const getThreadRepliesCountQuery string = "SELECT replies_count FROM comments WHERE id=$1 AND parentID IS NULL;"
+const getThreadRepliesQuery string = "SELECT " + commentFields +
+	"WHERE comments.parentID=$1 AND comments.id > $2\n" +
+	"ORDER BY comments.id\n" +
+	"LIMIT $3;"
+
+// GetReplies fetches page of thread's replies, oldest first, continued from the last reply of previous page
+// It returns replies and amount of all thread's replies on success, CommentNotFoundError if thread does not exist
+func (s *service) GetReplies(ctx context.Context, page *RepliesPage) (*CommentsList, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &CommentsList{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	commentsList := CommentsList{Comments: make([]*Comment, 0)}
+	err = tx.QueryRow(context.Background(), getThreadRepliesCountQuery, page.ThreadID).Scan(&commentsList.TotalCount)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return &CommentsList{}, entity.CommentsNotFoundError
+			return &CommentsList{}, entity.CommentNotFoundError
 		}
 		return &CommentsList{}, entity.GetCommentsError
 	}
 
+	rows, err := tx.Query(context.Background(), getThreadRepliesQuery, page.ThreadID, page.AfterCommentID, page.Limit)
+	if err != nil {
+		return &CommentsList{}, entity.GetCommentsError
+	}
+
 	for rows.Next() {
 		comment := Comment{}
-		err = rows.Scan(&comment.UserID, &comment.PinID, &comment.PinComment)
+		err = scanComment(rows, &comment)
 		if err != nil {
 			return &CommentsList{}, entity.CommentScanError
 		}
-		comments = append(comments, &comment)
+		commentsList.Comments = append(commentsList.Comments, &comment)
 	}
 
 	err = tx.Commit(context.Background())
 	if err != nil {
 		return &CommentsList{}, entity.TransactionCommitError
 	}
-	return &CommentsList{Comments: comments}, nil
+	return &commentsList, nil
+}
+
+const editCommentQuery string = "UPDATE comments SET text=$2, editDate=CURRENT_TIMESTAMP\n" +
+	"WHERE id=$1;"
+
+// EditComment changes comment's text and remembers when it was edited
+// It returns nil on success, error on failure
+func (s *service) EditComment(ctx context.Context, comment *Comment) (*Error, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	commandTag, err := tx.Exec(context.Background(), editCommentQuery, comment.CommentID, comment.PinComment)
+	if err != nil {
+		return &Error{}, err
+	}
+	if commandTag.RowsAffected() != 1 {
+		return &Error{}, entity.CommentNotFoundError
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionCommitError
+	}
+	return &Error{}, nil
+}
+
+const deleteCommentQuery string = "DELETE FROM comments WHERE id=$1\n" +
+	"RETURNING COALESCE(parentID, 0);"
+
+// DeleteComment deletes comment with passed ID along with its replies
+// It returns nil on success, error on failure
+func (s *service) DeleteComment(ctx context.Context, commentID *CommentID) (*Error, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	var parentID int64
+	err = tx.QueryRow(context.Background(), deleteCommentQuery, commentID.CommentID).Scan(&parentID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return &Error{}, entity.CommentNotFoundError
+		}
+		return &Error{}, err
+	}
+
+	if parentID != 0 {
+		_, err = tx.Exec(context.Background(), updateRepliesCountQuery, parentID, -1)
+		if err != nil {
+			return &Error{}, err
+		}
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionCommitError
+	}
+	return &Error{}, nil
 }
@@ -8,6 +8,7 @@ package __
 
 import (
 	context "context"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -24,16 +25,21 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type PinID struct {
+type CommentsPage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PinID int64 `protobuf:"varint,1,opt,name=pinID,proto3" json:"pinID,omitempty"`
+	PinID             int64  `protobuf:"varint,1,opt,name=PinID,proto3" json:"PinID,omitempty"`
+	Sort              string `protobuf:"bytes,2,opt,name=Sort,proto3" json:"Sort,omitempty"`                            // "newest", "oldest" or "most-replied"
+	AfterCommentID    int64  `protobuf:"varint,3,opt,name=AfterCommentID,proto3" json:"AfterCommentID,omitempty"`       // Last thread of previous page, 0 for the first page
+	AfterRepliesCount int64  `protobuf:"varint,4,opt,name=AfterRepliesCount,proto3" json:"AfterRepliesCount,omitempty"` // Replies count of that thread, used only when sorting by replies
+	Limit             int64  `protobuf:"varint,5,opt,name=Limit,proto3" json:"Limit,omitempty"`                         // Maximum amount of threads
+	RepliesLimit      int64  `protobuf:"varint,6,opt,name=RepliesLimit,proto3" json:"RepliesLimit,omitempty"`           // Maximum amount of first replies returned for each thread
 }
 
-func (x *PinID) Reset() {
-	*x = PinID{}
+func (x *CommentsPage) Reset() {
+	*x = CommentsPage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_comments_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -41,13 +47,13 @@ func (x *PinID) Reset() {
 	}
 }
 
-func (x *PinID) String() string {
+func (x *CommentsPage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PinID) ProtoMessage() {}
+func (*CommentsPage) ProtoMessage() {}
 
-func (x *PinID) ProtoReflect() protoreflect.Message {
+func (x *CommentsPage) ProtoReflect() protoreflect.Message {
 	mi := &file_comments_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -59,32 +65,137 @@ func (x *PinID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PinID.ProtoReflect.Descriptor instead.
-func (*PinID) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommentsPage.ProtoReflect.Descriptor instead.
+func (*CommentsPage) Descriptor() ([]byte, []int) {
 	return file_comments_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *PinID) GetPinID() int64 {
+func (x *CommentsPage) GetPinID() int64 {
 	if x != nil {
 		return x.PinID
 	}
 	return 0
 }
 
+func (x *CommentsPage) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *CommentsPage) GetAfterCommentID() int64 {
+	if x != nil {
+		return x.AfterCommentID
+	}
+	return 0
+}
+
+func (x *CommentsPage) GetAfterRepliesCount() int64 {
+	if x != nil {
+		return x.AfterRepliesCount
+	}
+	return 0
+}
+
+func (x *CommentsPage) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *CommentsPage) GetRepliesLimit() int64 {
+	if x != nil {
+		return x.RepliesLimit
+	}
+	return 0
+}
+
+type RepliesPage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThreadID       int64 `protobuf:"varint,1,opt,name=ThreadID,proto3" json:"ThreadID,omitempty"`             // ID of thread's first comment
+	AfterCommentID int64 `protobuf:"varint,2,opt,name=AfterCommentID,proto3" json:"AfterCommentID,omitempty"` // Last reply of previous page, 0 for the first page
+	Limit          int64 `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`                   // Maximum amount of replies
+}
+
+func (x *RepliesPage) Reset() {
+	*x = RepliesPage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepliesPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepliesPage) ProtoMessage() {}
+
+func (x *RepliesPage) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepliesPage.ProtoReflect.Descriptor instead.
+func (*RepliesPage) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RepliesPage) GetThreadID() int64 {
+	if x != nil {
+		return x.ThreadID
+	}
+	return 0
+}
+
+func (x *RepliesPage) GetAfterCommentID() int64 {
+	if x != nil {
+		return x.AfterCommentID
+	}
+	return 0
+}
+
+func (x *RepliesPage) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
 type Comment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID     int64  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
-	PinID      int64  `protobuf:"varint,2,opt,name=PinID,proto3" json:"PinID,omitempty"`
-	PinComment string `protobuf:"bytes,3,opt,name=PinComment,proto3" json:"PinComment,omitempty"`
+	UserID       int64                `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	PinID        int64                `protobuf:"varint,2,opt,name=PinID,proto3" json:"PinID,omitempty"`
+	PinComment   string               `protobuf:"bytes,3,opt,name=PinComment,proto3" json:"PinComment,omitempty"`
+	CommentID    int64                `protobuf:"varint,4,opt,name=CommentID,proto3" json:"CommentID,omitempty"`
+	CreationDate *timestamp.Timestamp `protobuf:"bytes,5,opt,name=CreationDate,proto3" json:"CreationDate,omitempty"`
+	EditDate     *timestamp.Timestamp `protobuf:"bytes,6,opt,name=EditDate,proto3" json:"EditDate,omitempty"`  // Not set if comment was never edited
+	ParentID     int64                `protobuf:"varint,7,opt,name=ParentID,proto3" json:"ParentID,omitempty"` // 0 if comment is not a reply
+	RepliesCount int64                `protobuf:"varint,8,opt,name=RepliesCount,proto3" json:"RepliesCount,omitempty"`
+	Username     string               `protobuf:"bytes,9,opt,name=Username,proto3" json:"Username,omitempty"`      // Author's username
+	AvatarLink   string               `protobuf:"bytes,10,opt,name=AvatarLink,proto3" json:"AvatarLink,omitempty"` // Author's avatar
 }
 
 func (x *Comment) Reset() {
 	*x = Comment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comments_proto_msgTypes[1]
+		mi := &file_comments_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -97,7 +208,7 @@ func (x *Comment) String() string {
 func (*Comment) ProtoMessage() {}
 
 func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_comments_proto_msgTypes[1]
+	mi := &file_comments_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -110,7 +221,7 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Comment.ProtoReflect.Descriptor instead.
 func (*Comment) Descriptor() ([]byte, []int) {
-	return file_comments_proto_rawDescGZIP(), []int{1}
+	return file_comments_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Comment) GetUserID() int64 {
@@ -134,18 +245,115 @@ func (x *Comment) GetPinComment() string {
 	return ""
 }
 
+func (x *Comment) GetCommentID() int64 {
+	if x != nil {
+		return x.CommentID
+	}
+	return 0
+}
+
+func (x *Comment) GetCreationDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreationDate
+	}
+	return nil
+}
+
+func (x *Comment) GetEditDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.EditDate
+	}
+	return nil
+}
+
+func (x *Comment) GetParentID() int64 {
+	if x != nil {
+		return x.ParentID
+	}
+	return 0
+}
+
+func (x *Comment) GetRepliesCount() int64 {
+	if x != nil {
+		return x.RepliesCount
+	}
+	return 0
+}
+
+func (x *Comment) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Comment) GetAvatarLink() string {
+	if x != nil {
+		return x.AvatarLink
+	}
+	return ""
+}
+
+type CommentID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentID int64 `protobuf:"varint,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
+}
+
+func (x *CommentID) Reset() {
+	*x = CommentID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_comments_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentID) ProtoMessage() {}
+
+func (x *CommentID) ProtoReflect() protoreflect.Message {
+	mi := &file_comments_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentID.ProtoReflect.Descriptor instead.
+func (*CommentID) Descriptor() ([]byte, []int) {
+	return file_comments_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CommentID) GetCommentID() int64 {
+	if x != nil {
+		return x.CommentID
+	}
+	return 0
+}
+
 type CommentsList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Comments []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Comments   []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`      // Threads' first comments in requested order, followed by their replies
+	TotalCount int64      `protobuf:"varint,2,opt,name=TotalCount,proto3" json:"TotalCount,omitempty"` // Amount of all pin's comments, including replies, or of thread's replies for RepliesPage
 }
 
 func (x *CommentsList) Reset() {
 	*x = CommentsList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comments_proto_msgTypes[2]
+		mi := &file_comments_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -158,7 +366,7 @@ func (x *CommentsList) String() string {
 func (*CommentsList) ProtoMessage() {}
 
 func (x *CommentsList) ProtoReflect() protoreflect.Message {
-	mi := &file_comments_proto_msgTypes[2]
+	mi := &file_comments_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -171,7 +379,7 @@ func (x *CommentsList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommentsList.ProtoReflect.Descriptor instead.
 func (*CommentsList) Descriptor() ([]byte, []int) {
-	return file_comments_proto_rawDescGZIP(), []int{2}
+	return file_comments_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CommentsList) GetComments() []*Comment {
@@ -181,6 +389,13 @@ func (x *CommentsList) GetComments() []*Comment {
 	return nil
 }
 
+func (x *CommentsList) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type Error struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -190,7 +405,7 @@ type Error struct {
 func (x *Error) Reset() {
 	*x = Error{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_comments_proto_msgTypes[3]
+		mi := &file_comments_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -203,7 +418,7 @@ func (x *Error) String() string {
 func (*Error) ProtoMessage() {}
 
 func (x *Error) ProtoReflect() protoreflect.Message {
-	mi := &file_comments_proto_msgTypes[3]
+	mi := &file_comments_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -216,35 +431,91 @@ func (x *Error) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Error.ProtoReflect.Descriptor instead.
 func (*Error) Descriptor() ([]byte, []int) {
-	return file_comments_proto_rawDescGZIP(), []int{3}
+	return file_comments_proto_rawDescGZIP(), []int{5}
 }
 
 var File_comments_proto protoreflect.FileDescriptor
 
 var file_comments_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x1d, 0x0a, 0x05, 0x50, 0x69,
-	0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x70, 0x69, 0x6e, 0x49, 0x44, 0x22, 0x57, 0x0a, 0x07, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05,
-	0x50, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x50, 0x69, 0x6e,
-	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x22, 0x3d, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x32, 0x78, 0x0a, 0x08, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0b, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69,
-	0x73, 0x74, 0x22, 0x00, 0x42, 0x04, 0x5a, 0x02, 0x2e, 0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x12, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc8, 0x01, 0x0a, 0x0c,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x50, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x50, 0x69, 0x6e,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x41, 0x66, 0x74, 0x65, 0x72, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x2c,
+	0x0a, 0x11, 0x41, 0x66, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65,
+	0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x67, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65,
+	0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49,
+	0x44, 0x12, 0x26, 0x0a, 0x0e, 0x41, 0x66, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0xe9, 0x02, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x50, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x69, 0x6e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50,
+	0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x3e, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x45, 0x64, 0x69, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x41,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x22, 0x29, 0x0a, 0x09, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x5d, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xe8,
+	0x02, 0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x41,
+	0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x13, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x61,
+	0x67, 0x65, 0x1a, 0x16, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x50, 0x61, 0x67,
+	0x65, 0x1a, 0x16, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0b, 0x45,
+	0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x0f, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00,
+	0x12, 0x37, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x42, 0x04, 0x5a, 0x02, 0x2e, 0x2f, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -259,24 +530,37 @@ func file_comments_proto_rawDescGZIP() []byte {
 	return file_comments_proto_rawDescData
 }
 
-var file_comments_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_comments_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_comments_proto_goTypes = []interface{}{
-	(*PinID)(nil),        // 0: comments.PinID
-	(*Comment)(nil),      // 1: comments.Comment
-	(*CommentsList)(nil), // 2: comments.CommentsList
-	(*Error)(nil),        // 3: comments.Error
+	(*CommentsPage)(nil),        // 0: comments.CommentsPage
+	(*RepliesPage)(nil),         // 1: comments.RepliesPage
+	(*Comment)(nil),             // 2: comments.Comment
+	(*CommentID)(nil),           // 3: comments.CommentID
+	(*CommentsList)(nil),        // 4: comments.CommentsList
+	(*Error)(nil),               // 5: comments.Error
+	(*timestamp.Timestamp)(nil), // 6: google.protobuf.Timestamp
 }
 var file_comments_proto_depIdxs = []int32{
-	1, // 0: comments.CommentsList.comments:type_name -> comments.Comment
-	1, // 1: comments.Comments.AddComment:input_type -> comments.Comment
-	0, // 2: comments.Comments.GetComments:input_type -> comments.PinID
-	3, // 3: comments.Comments.AddComment:output_type -> comments.Error
-	2, // 4: comments.Comments.GetComments:output_type -> comments.CommentsList
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	6, // 0: comments.Comment.CreationDate:type_name -> google.protobuf.Timestamp
+	6, // 1: comments.Comment.EditDate:type_name -> google.protobuf.Timestamp
+	2, // 2: comments.CommentsList.comments:type_name -> comments.Comment
+	2, // 3: comments.Comments.AddComment:input_type -> comments.Comment
+	3, // 4: comments.Comments.GetComment:input_type -> comments.CommentID
+	0, // 5: comments.Comments.GetComments:input_type -> comments.CommentsPage
+	1, // 6: comments.Comments.GetReplies:input_type -> comments.RepliesPage
+	2, // 7: comments.Comments.EditComment:input_type -> comments.Comment
+	3, // 8: comments.Comments.DeleteComment:input_type -> comments.CommentID
+	3, // 9: comments.Comments.AddComment:output_type -> comments.CommentID
+	2, // 10: comments.Comments.GetComment:output_type -> comments.Comment
+	4, // 11: comments.Comments.GetComments:output_type -> comments.CommentsList
+	4, // 12: comments.Comments.GetReplies:output_type -> comments.CommentsList
+	5, // 13: comments.Comments.EditComment:output_type -> comments.Error
+	5, // 14: comments.Comments.DeleteComment:output_type -> comments.Error
+	9, // [9:15] is the sub-list for method output_type
+	3, // [3:9] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_comments_proto_init() }
@@ -286,7 +570,7 @@ func file_comments_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_comments_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PinID); i {
+			switch v := v.(*CommentsPage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -298,7 +582,7 @@ func file_comments_proto_init() {
 			}
 		}
 		file_comments_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Comment); i {
+			switch v := v.(*RepliesPage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -310,7 +594,7 @@ func file_comments_proto_init() {
 			}
 		}
 		file_comments_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommentsList); i {
+			switch v := v.(*Comment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -322,6 +606,30 @@ func file_comments_proto_init() {
 			}
 		}
 		file_comments_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentsList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_comments_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Error); i {
 			case 0:
 				return &v.state
@@ -340,7 +648,7 @@ func file_comments_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_comments_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -366,8 +674,12 @@ const _ = grpc.SupportPackageIsVersion6
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type CommentsClient interface {
-	AddComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*Error, error)
-	GetComments(ctx context.Context, in *PinID, opts ...grpc.CallOption) (*CommentsList, error)
+	AddComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*CommentID, error)
+	GetComment(ctx context.Context, in *CommentID, opts ...grpc.CallOption) (*Comment, error)
+	GetComments(ctx context.Context, in *CommentsPage, opts ...grpc.CallOption) (*CommentsList, error)
+	GetReplies(ctx context.Context, in *RepliesPage, opts ...grpc.CallOption) (*CommentsList, error)
+	EditComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*Error, error)
+	DeleteComment(ctx context.Context, in *CommentID, opts ...grpc.CallOption) (*Error, error)
 }
 
 type commentsClient struct {
@@ -378,8 +690,8 @@ func NewCommentsClient(cc grpc.ClientConnInterface) CommentsClient {
 	return &commentsClient{cc}
 }
 
-func (c *commentsClient) AddComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*Error, error) {
-	out := new(Error)
+func (c *commentsClient) AddComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*CommentID, error) {
+	out := new(CommentID)
 	err := c.cc.Invoke(ctx, "/comments.Comments/AddComment", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -387,7 +699,16 @@ func (c *commentsClient) AddComment(ctx context.Context, in *Comment, opts ...gr
 	return out, nil
 }
 
-func (c *commentsClient) GetComments(ctx context.Context, in *PinID, opts ...grpc.CallOption) (*CommentsList, error) {
+func (c *commentsClient) GetComment(ctx context.Context, in *CommentID, opts ...grpc.CallOption) (*Comment, error) {
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, "/comments.Comments/GetComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) GetComments(ctx context.Context, in *CommentsPage, opts ...grpc.CallOption) (*CommentsList, error) {
 	out := new(CommentsList)
 	err := c.cc.Invoke(ctx, "/comments.Comments/GetComments", in, out, opts...)
 	if err != nil {
@@ -396,22 +717,65 @@ func (c *commentsClient) GetComments(ctx context.Context, in *PinID, opts ...grp
 	return out, nil
 }
 
+func (c *commentsClient) GetReplies(ctx context.Context, in *RepliesPage, opts ...grpc.CallOption) (*CommentsList, error) {
+	out := new(CommentsList)
+	err := c.cc.Invoke(ctx, "/comments.Comments/GetReplies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) EditComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/comments.Comments/EditComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) DeleteComment(ctx context.Context, in *CommentID, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/comments.Comments/DeleteComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommentsServer is the server API for Comments service.
 type CommentsServer interface {
-	AddComment(context.Context, *Comment) (*Error, error)
-	GetComments(context.Context, *PinID) (*CommentsList, error)
+	AddComment(context.Context, *Comment) (*CommentID, error)
+	GetComment(context.Context, *CommentID) (*Comment, error)
+	GetComments(context.Context, *CommentsPage) (*CommentsList, error)
+	GetReplies(context.Context, *RepliesPage) (*CommentsList, error)
+	EditComment(context.Context, *Comment) (*Error, error)
+	DeleteComment(context.Context, *CommentID) (*Error, error)
 }
 
 // UnimplementedCommentsServer can be embedded to have forward compatible implementations.
 type UnimplementedCommentsServer struct {
 }
 
-func (*UnimplementedCommentsServer) AddComment(context.Context, *Comment) (*Error, error) {
+func (*UnimplementedCommentsServer) AddComment(context.Context, *Comment) (*CommentID, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddComment not implemented")
 }
-func (*UnimplementedCommentsServer) GetComments(context.Context, *PinID) (*CommentsList, error) {
+func (*UnimplementedCommentsServer) GetComment(context.Context, *CommentID) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComment not implemented")
+}
+func (*UnimplementedCommentsServer) GetComments(context.Context, *CommentsPage) (*CommentsList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetComments not implemented")
 }
+func (*UnimplementedCommentsServer) GetReplies(context.Context, *RepliesPage) (*CommentsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplies not implemented")
+}
+func (*UnimplementedCommentsServer) EditComment(context.Context, *Comment) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
+}
+func (*UnimplementedCommentsServer) DeleteComment(context.Context, *CommentID) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
 
 func RegisterCommentsServer(s *grpc.Server, srv CommentsServer) {
 	s.RegisterService(&_Comments_serviceDesc, srv)
@@ -435,8 +799,26 @@ func _Comments_AddComment_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Comments_GetComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).GetComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comments.Comments/GetComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).GetComment(ctx, req.(*CommentID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Comments_GetComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PinID)
+	in := new(CommentsPage)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -448,7 +830,61 @@ func _Comments_GetComments_Handler(srv interface{}, ctx context.Context, dec fun
 		FullMethod: "/comments.Comments/GetComments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommentsServer).GetComments(ctx, req.(*PinID))
+		return srv.(CommentsServer).GetComments(ctx, req.(*CommentsPage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_GetReplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepliesPage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).GetReplies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comments.Comments/GetReplies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).GetReplies(ctx, req.(*RepliesPage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Comment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).EditComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comments.Comments/EditComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).EditComment(ctx, req.(*Comment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comments.Comments/DeleteComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).DeleteComment(ctx, req.(*CommentID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -461,10 +897,26 @@ var _Comments_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddComment",
 			Handler:    _Comments_AddComment_Handler,
 		},
+		{
+			MethodName: "GetComment",
+			Handler:    _Comments_GetComment_Handler,
+		},
 		{
 			MethodName: "GetComments",
 			Handler:    _Comments_GetComments_Handler,
 		},
+		{
+			MethodName: "GetReplies",
+			Handler:    _Comments_GetReplies_Handler,
+		},
+		{
+			MethodName: "EditComment",
+			Handler:    _Comments_EditComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _Comments_DeleteComment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "comments.proto",
@@ -34,8 +34,8 @@ func NewService(db *pgxpool.Pool, s3 *session.Session) *service {
 	return &service{db, s3}
 }
 
-const createBoardQuery string = "INSERT INTO Boards (userID, title, description)\n" +
-	"values ($1, $2, $3)\n" +
+const createBoardQuery string = "INSERT INTO Boards (userID, title, description, is_secret)\n" +
+	"values ($1, $2, $3, $4)\n" +
 	"RETURNING boardID"
 const increaseBoardCountQuery string = "UPDATE Users SET boards_count = boards_count + 1 WHERE userID=$1"
 
@@ -48,7 +48,7 @@ func (s *service) CreateBoard(ctx context.Context, board *Board) (*BoardID, erro
 	}
 	defer tx.Rollback(context.Background())
 
-	row := tx.QueryRow(context.Background(), createBoardQuery, board.UserID, board.Title, board.Description)
+	row := tx.QueryRow(context.Background(), createBoardQuery, board.UserID, board.Title, board.Description, board.IsSecret)
 	newBoardID := 0
 	err = row.Scan(&newBoardID)
 	if err != nil {
@@ -68,23 +68,25 @@ func (s *service) CreateBoard(ctx context.Context, board *Board) (*BoardID, erro
 }
 
 const getBoardQuery string = "SELECT userID, title, description, " +
-	"imageLink, imageHeight, imageWidth, imageAvgColor\n" +
+	"imageLink, imageHeight, imageWidth, imageAvgColor, COALESCE(coverPinID, 0), is_archived, is_secret, layout_version, followers_count\n" +
 	"FROM Boards\n" +
-	"WHERE boardID=$1"
+	"WHERE boardID=$1 AND " + visibleBoardCondition
 
 // GetBoard fetches board with passed ID from database
+// Secret board is not found unless requester is its owner or collaborator
 // It returns that board, nil on success and nil, error on failure
-func (s *service) GetBoard(ctx context.Context, boardID *BoardID) (*Board, error) {
+func (s *service) GetBoard(ctx context.Context, boardRequest *BoardRequest) (*Board, error) {
 	tx, err := s.db.Begin(context.Background())
 	if err != nil {
 		return &Board{}, entity.TransactionBeginError
 	}
 	defer tx.Rollback(context.Background())
 
-	board := Board{BoardID: boardID.BoardID}
-	row := tx.QueryRow(context.Background(), getBoardQuery, boardID.BoardID)
+	board := Board{BoardID: boardRequest.BoardID}
+	row := tx.QueryRow(context.Background(), getBoardQuery, boardRequest.BoardID, boardRequest.RequesterID)
 	err = row.Scan(&board.UserID, &board.Title, &board.Description,
-		&board.ImageLink, &board.ImageHeight, &board.ImageWidth, &board.ImageAvgColor)
+		&board.ImageLink, &board.ImageHeight, &board.ImageWidth, &board.ImageAvgColor,
+		&board.CoverPinID, &board.IsArchived, &board.IsSecret, &board.LayoutVersion, &board.FollowersCount)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return &Board{}, entity.BoardNotFoundError
@@ -100,20 +102,28 @@ func (s *service) GetBoard(ctx context.Context, boardID *BoardID) (*Board, error
 }
 
 const getBoardsByUserQuery string = "SELECT boardID, title, description, " +
-	"imageLink, imageHeight, imageWidth, imageAvgColor\n" +
+	"imageLink, imageHeight, imageWidth, imageAvgColor, COALESCE(coverPinID, 0), is_archived, is_secret, layout_version, followers_count\n" +
 	"FROM Boards\n" +
-	"WHERE userID=$1"
+	"WHERE userID=$1 AND " + visibleBoardCondition
+
+// visibleBoardCondition is true if board is not secret or user passed as $2 is its owner or collaborator
+const visibleBoardCondition string = "(NOT Boards.is_secret OR Boards.userID = $2 OR " + acceptedCollaboratorCondition + ")"
+
+// acceptedCollaboratorCondition is true if user passed as $2 has accepted invitation to board
+const acceptedCollaboratorCondition string = "EXISTS (SELECT 1 FROM board_collaborators\n" +
+	"WHERE board_collaborators.boardID = Boards.boardID AND board_collaborators.userID = $2 AND is_accepted)"
 
 // GetBoards fetches all boards created by user with specified ID from database
+// Secret boards are skipped unless requester is their owner or collaborator
 // It returns slice of these boards, nil on success and nil, error on failure
-func (s *service) GetBoards(ctx context.Context, userID *UserID) (*BoardsList, error) {
+func (s *service) GetBoards(ctx context.Context, boardsOfUser *BoardsOfUser) (*BoardsList, error) {
 	tx, err := s.db.Begin(context.Background())
 	if err != nil {
 		return &BoardsList{}, entity.TransactionBeginError
 	}
 	defer tx.Rollback(context.Background())
 
-	rows, err := tx.Query(context.Background(), getBoardsByUserQuery, userID.Uid)
+	rows, err := tx.Query(context.Background(), getBoardsByUserQuery, boardsOfUser.UserID, boardsOfUser.RequesterID)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return &BoardsList{}, entity.BoardsNotFoundError
@@ -123,9 +133,10 @@ func (s *service) GetBoards(ctx context.Context, userID *UserID) (*BoardsList, e
 
 	boards := make([]*Board, 0)
 	for rows.Next() {
-		board := Board{UserID: userID.Uid}
+		board := Board{UserID: boardsOfUser.UserID}
 		err = rows.Scan(&board.BoardID, &board.Title, &board.Description,
-			&board.ImageLink, &board.ImageHeight, &board.ImageWidth, &board.ImageAvgColor)
+			&board.ImageLink, &board.ImageHeight, &board.ImageWidth, &board.ImageAvgColor,
+			&board.CoverPinID, &board.IsArchived, &board.IsSecret, &board.LayoutVersion, &board.FollowersCount)
 		if err != nil {
 			return &BoardsList{}, entity.BoardScanError
 		}
@@ -140,7 +151,7 @@ func (s *service) GetBoards(ctx context.Context, userID *UserID) (*BoardsList, e
 }
 
 const getInitUserBoardQuery string = "SELECT b1.boardID, b1.title, b1.description, " +
-	"b1.imageLink, b1.imageHeight, b1.imageWidth, b1.imageAvgColor\n" +
+	"b1.imageLink, b1.imageHeight, b1.imageWidth, b1.imageAvgColor, COALESCE(b1.coverPinID, 0), b1.is_archived, b1.is_secret, b1.layout_version, b1.followers_count\n" +
 	"FROM boards AS b1\n" +
 	"INNER JOIN boards AS b2 on b2.boardID = b1.boardID AND b2.userID = $1\n" +
 	"GROUP BY b1.boardID, b2.userID\n" +
@@ -158,7 +169,8 @@ func (s *service) GetInitUserBoard(ctx context.Context, userID *UserID) (*BoardI
 	board := Board{UserID: userID.Uid}
 	row := tx.QueryRow(context.Background(), getInitUserBoardQuery, userID.Uid)
 	err = row.Scan(&board.BoardID, &board.Title, &board.Description,
-		&board.ImageLink, &board.ImageHeight, &board.ImageWidth, &board.ImageAvgColor)
+		&board.ImageLink, &board.ImageHeight, &board.ImageWidth, &board.ImageAvgColor,
+		&board.CoverPinID, &board.IsArchived, &board.IsSecret, &board.LayoutVersion, &board.FollowersCount)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return &BoardID{}, entity.BoardNotFoundError
@@ -173,6 +185,61 @@ func (s *service) GetInitUserBoard(ctx context.Context, userID *UserID) (*BoardI
 	return &BoardID{BoardID: board.BoardID}, nil
 }
 
+const updateBoardQuery string = "UPDATE Boards\n" +
+	"SET title=$2, description=$3, coverPinID=NULLIF($4, 0), is_archived=$5, is_secret=$6\n" +
+	"WHERE boardID=$1"
+const getBoardPinImageQuery string = "SELECT pins.imageLink, pins.imageHeight, pins.imageWidth, pins.imageAvgColor\n" +
+	"FROM pins\n" +
+	"INNER JOIN pairs on pairs.pinID = pins.pinID AND pairs.boardID = $1\n" +
+	"WHERE pins.pinID = $2"
+
+// UpdateBoard saves board's title, description, cover pin, archived and secret flags to database
+// If cover pin is chosen, its image becomes board's avatar
+// It returns nil on success, error on failure
+func (s *service) UpdateBoard(ctx context.Context, board *Board) (*Error, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	commandTag, err := tx.Exec(context.Background(), updateBoardQuery, board.BoardID,
+		board.Title, board.Description, board.CoverPinID, board.IsArchived, board.IsSecret)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "Duplicate") {
+			return &Error{}, entity.BoardTitleAlreadyExistsError
+		}
+		return &Error{}, entity.UpdateBoardError
+	}
+	if commandTag.RowsAffected() != 1 {
+		return &Error{}, entity.BoardNotFoundError
+	}
+
+	if board.CoverPinID != 0 {
+		avatarInfo := FileInfo{BoardID: board.BoardID}
+		row := tx.QueryRow(context.Background(), getBoardPinImageQuery, board.BoardID, board.CoverPinID)
+		err = row.Scan(&avatarInfo.ImageLink, &avatarInfo.ImageHeight, &avatarInfo.ImageWidth, &avatarInfo.ImageAvgColor)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return &Error{}, entity.CoverPinNotInBoardError
+			}
+			return &Error{}, entity.PinScanError
+		}
+
+		_, err = tx.Exec(context.Background(), saveBoardPictureQuery, avatarInfo.BoardID,
+			avatarInfo.ImageLink, avatarInfo.ImageHeight, avatarInfo.ImageWidth, avatarInfo.ImageAvgColor)
+		if err != nil {
+			return &Error{}, entity.BoardAvatarUploadError
+		}
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionCommitError
+	}
+	return &Error{}, nil
+}
+
 const deleteBoardQuery string = "DELETE FROM Boards WHERE boardID=$1 RETURNING userID"
 const decreaseBoardCountQuery string = "UPDATE Users SET boards_count = boards_count - 1 WHERE userID=$1"
 
@@ -231,6 +298,584 @@ func (s *service) UploadBoardAvatar(ctx context.Context, imageInfo *FileInfo) (*
 	return &Error{}, nil
 }
 
+const inviteCollaboratorQuery string = "INSERT INTO board_collaborators (boardID, userID, role)\n" +
+	"values ($1, $2, $3)"
+
+// InviteCollaborator adds pending invitation of user to board with passed role
+// It returns nil on success, error on failure
+func (s *service) InviteCollaborator(ctx context.Context, collaborator *Collaborator) (*Error, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	_, err = tx.Exec(context.Background(), inviteCollaboratorQuery, collaborator.BoardID, collaborator.UserID, collaborator.Role)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "Duplicate") {
+			return &Error{}, entity.CollaboratorAlreadyExistsError
+		}
+		if strings.Contains(err.Error(), `violates foreign key constraint "board_collaborators_users"`) {
+			return &Error{}, entity.UserNotFoundError
+		}
+		if strings.Contains(err.Error(), `violates foreign key constraint "board_collaborators_boards"`) {
+			return &Error{}, entity.BoardNotFoundError
+		}
+		return &Error{}, err
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionCommitError
+	}
+	return &Error{}, nil
+}
+
+const acceptInvitationQuery string = "UPDATE board_collaborators\n" +
+	"SET is_accepted = true\n" +
+	"WHERE boardID=$1 AND userID=$2 AND NOT is_accepted"
+
+// AcceptInvitation makes invited user board's collaborator
+// It returns nil on success, error on failure
+func (s *service) AcceptInvitation(ctx context.Context, member *BoardMember) (*Error, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	commandTag, err := tx.Exec(context.Background(), acceptInvitationQuery, member.BoardID, member.UserID)
+	if err != nil {
+		return &Error{}, err
+	}
+	if commandTag.RowsAffected() != 1 {
+		return &Error{}, entity.InvitationNotFoundError
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionCommitError
+	}
+	return &Error{}, nil
+}
+
+const removeCollaboratorQuery string = "DELETE FROM board_collaborators WHERE boardID=$1 AND userID=$2"
+
+// RemoveCollaborator removes user from board's collaborators, pending invitations are removed too
+// It returns nil on success, error on failure
+func (s *service) RemoveCollaborator(ctx context.Context, member *BoardMember) (*Error, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	commandTag, err := tx.Exec(context.Background(), removeCollaboratorQuery, member.BoardID, member.UserID)
+	if err != nil {
+		return &Error{}, err
+	}
+	if commandTag.RowsAffected() != 1 {
+		return &Error{}, entity.CollaboratorNotFoundError
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionCommitError
+	}
+	return &Error{}, nil
+}
+
+const getCollaboratorsQuery string = "SELECT Users.userID, Users.username, COALESCE(Users.avatar, ''), board_collaborators.role\n" +
+	"FROM board_collaborators\n" +
+	"INNER JOIN Users ON Users.userID = board_collaborators.userID\n" +
+	"WHERE board_collaborators.boardID=$1 AND board_collaborators.is_accepted\n" +
+	"ORDER BY Users.username"
+
+// GetCollaborators fetches users who accepted invitation to board
+// It returns slice of collaborators, nil on success and nil, error on failure
+func (s *service) GetCollaborators(ctx context.Context, boardID *BoardID) (*CollaboratorsList, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &CollaboratorsList{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	rows, err := tx.Query(context.Background(), getCollaboratorsQuery, boardID.BoardID)
+	if err != nil {
+		return &CollaboratorsList{}, err
+	}
+
+	collaborators := make([]*Collaborator, 0)
+	for rows.Next() {
+		collaborator := Collaborator{BoardID: boardID.BoardID}
+		err = rows.Scan(&collaborator.UserID, &collaborator.Username, &collaborator.AvatarLink, &collaborator.Role)
+		if err != nil {
+			return &CollaboratorsList{}, entity.CollaboratorScanError
+		}
+		collaborators = append(collaborators, &collaborator)
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &CollaboratorsList{}, entity.TransactionCommitError
+	}
+	return &CollaboratorsList{Collaborators: collaborators}, nil
+}
+
+const getInvitationsQuery string = "SELECT Boards.boardID, Boards.userID, title, description, " +
+	"imageLink, imageHeight, imageWidth, imageAvgColor, COALESCE(coverPinID, 0), is_archived, is_secret, layout_version, followers_count, " +
+	"board_collaborators.role\n" +
+	"FROM board_collaborators\n" +
+	"INNER JOIN Boards ON Boards.boardID = board_collaborators.boardID\n" +
+	"WHERE board_collaborators.userID=$1 AND NOT board_collaborators.is_accepted"
+
+// GetInvitations fetches boards which user was invited to, but has not joined yet
+// It returns slice of invitations, nil on success and nil, error on failure
+func (s *service) GetInvitations(ctx context.Context, userID *UserID) (*InvitationsList, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &InvitationsList{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	rows, err := tx.Query(context.Background(), getInvitationsQuery, userID.Uid)
+	if err != nil {
+		return &InvitationsList{}, err
+	}
+
+	invitations := make([]*Invitation, 0)
+	for rows.Next() {
+		invitation := Invitation{Board: &Board{}}
+		board := invitation.Board
+		err = rows.Scan(&board.BoardID, &board.UserID, &board.Title, &board.Description,
+			&board.ImageLink, &board.ImageHeight, &board.ImageWidth, &board.ImageAvgColor,
+			&board.CoverPinID, &board.IsArchived, &board.IsSecret, &board.LayoutVersion, &board.FollowersCount, &invitation.Role)
+		if err != nil {
+			return &InvitationsList{}, entity.BoardScanError
+		}
+		invitations = append(invitations, &invitation)
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &InvitationsList{}, entity.TransactionCommitError
+	}
+	return &InvitationsList{Invitations: invitations}, nil
+}
+
+const getBoardRoleQuery string = "SELECT CASE WHEN Boards.userID = $2 THEN 'owner'\n" +
+	"ELSE COALESCE((SELECT role FROM board_collaborators\n" +
+	"WHERE board_collaborators.boardID = Boards.boardID AND board_collaborators.userID = $2 AND is_accepted), '') END\n" +
+	"FROM Boards\n" +
+	"WHERE boardID=$1"
+
+// GetBoardRole fetches user's role on board, pending invitations give no role
+// It returns role ("owner", "editor", "viewer" or empty string), nil on success and nil, error on failure
+func (s *service) GetBoardRole(ctx context.Context, member *BoardMember) (*BoardRole, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &BoardRole{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	role := BoardRole{}
+	row := tx.QueryRow(context.Background(), getBoardRoleQuery, member.BoardID, member.UserID)
+	err = row.Scan(&role.Role)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return &BoardRole{}, entity.BoardNotFoundError
+		}
+		return &BoardRole{}, entity.BoardScanError
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &BoardRole{}, entity.TransactionCommitError
+	}
+	return &role, nil
+}
+
+const bumpLayoutVersionQuery string = "UPDATE Boards SET layout_version = layout_version + 1 WHERE boardID=$1"
+
+// bumpLayoutVersion marks that board's pins, sections or their order are changed
+// Board's row stays locked until transaction ends, so concurrent changes of the same board are applied one by one
+func bumpLayoutVersion(tx pgx.Tx, boardID int64) error {
+	commandTag, err := tx.Exec(context.Background(), bumpLayoutVersionQuery, boardID)
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() != 1 {
+		return entity.BoardNotFoundError
+	}
+	return nil
+}
+
+const checkSectionQuery string = "SELECT sectionID FROM board_sections WHERE sectionID=$1 AND boardID=$2"
+
+// checkSection checks if section belongs to board, 0 means "no section" and is always fine
+func checkSection(tx pgx.Tx, boardID int64, sectionID int64) error {
+	if sectionID == 0 {
+		return nil
+	}
+
+	row := tx.QueryRow(context.Background(), checkSectionQuery, sectionID, boardID)
+	err := row.Scan(&sectionID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return entity.SectionNotFoundError
+		}
+		return entity.SectionScanError
+	}
+	return nil
+}
+
+const createSectionQuery string = "INSERT INTO board_sections (boardID, title, position)\n" +
+	"SELECT $1, $2, COALESCE(MAX(position), 0) + 1 FROM board_sections WHERE boardID=$1\n" +
+	"RETURNING sectionID"
+
+// CreateSection adds new section to the end of board
+// It returns section's assigned ID and nil on success, any number and error on failure
+func (s *service) CreateSection(ctx context.Context, section *Section) (*SectionID, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &SectionID{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	err = bumpLayoutVersion(tx, section.BoardID)
+	if err != nil {
+		return &SectionID{}, err
+	}
+
+	newSectionID := 0
+	row := tx.QueryRow(context.Background(), createSectionQuery, section.BoardID, section.Title)
+	err = row.Scan(&newSectionID)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "Duplicate") {
+			return &SectionID{}, entity.SectionTitleAlreadyExistsError
+		}
+		return &SectionID{}, entity.SectionScanError
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &SectionID{}, entity.TransactionCommitError
+	}
+	return &SectionID{SectionID: int64(newSectionID)}, nil
+}
+
+const renameSectionQuery string = "UPDATE board_sections SET title=$3 WHERE sectionID=$1 AND boardID=$2"
+
+// RenameSection changes title of board's section
+// It returns nil on success, error on failure
+func (s *service) RenameSection(ctx context.Context, section *Section) (*Error, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	commandTag, err := tx.Exec(context.Background(), renameSectionQuery, section.SectionID, section.BoardID, section.Title)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "Duplicate") {
+			return &Error{}, entity.SectionTitleAlreadyExistsError
+		}
+		return &Error{}, err
+	}
+	if commandTag.RowsAffected() != 1 {
+		return &Error{}, entity.SectionNotFoundError
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionCommitError
+	}
+	return &Error{}, nil
+}
+
+const deleteSectionQuery string = "DELETE FROM board_sections WHERE sectionID=$1 AND boardID=$2"
+
+// DeleteSection deletes board's section, its pins stay on the board without section
+// It returns nil on success, error on failure
+func (s *service) DeleteSection(ctx context.Context, section *Section) (*Error, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	err = bumpLayoutVersion(tx, section.BoardID)
+	if err != nil {
+		return &Error{}, err
+	}
+
+	commandTag, err := tx.Exec(context.Background(), deleteSectionQuery, section.SectionID, section.BoardID)
+	if err != nil {
+		return &Error{}, err
+	}
+	if commandTag.RowsAffected() != 1 {
+		return &Error{}, entity.SectionNotFoundError
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionCommitError
+	}
+	return &Error{}, nil
+}
+
+const getSectionsQuery string = "SELECT sectionID, title\n" +
+	"FROM board_sections\n" +
+	"WHERE boardID=$1\n" +
+	"ORDER BY position, sectionID"
+
+// GetSections fetches all sections of board in board's order
+// It returns slice of sections, nil on success and nil, error on failure
+func (s *service) GetSections(ctx context.Context, boardID *BoardID) (*SectionsList, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &SectionsList{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	rows, err := tx.Query(context.Background(), getSectionsQuery, boardID.BoardID)
+	if err != nil {
+		return &SectionsList{}, err
+	}
+
+	sections := make([]*Section, 0)
+	for rows.Next() {
+		section := Section{BoardID: boardID.BoardID}
+		err = rows.Scan(&section.SectionID, &section.Title)
+		if err != nil {
+			return &SectionsList{}, entity.SectionScanError
+		}
+		sections = append(sections, &section)
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &SectionsList{}, entity.TransactionCommitError
+	}
+	return &SectionsList{Sections: sections}, nil
+}
+
+const movePinQuery string = "UPDATE pairs\n" +
+	"SET sectionID=NULLIF($3, 0), position=(SELECT COALESCE(MAX(position), 0) + 1 FROM pairs WHERE boardID=$1)\n" +
+	"WHERE boardID=$1 AND pinID=$2"
+
+// MovePin moves pin to the end of another section of the same board
+// It returns nil on success, error on failure
+func (s *service) MovePin(ctx context.Context, pinMove *PinMove) (*Error, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	err = bumpLayoutVersion(tx, pinMove.BoardID)
+	if err != nil {
+		return &Error{}, err
+	}
+
+	err = checkSection(tx, pinMove.BoardID, pinMove.SectionID)
+	if err != nil {
+		return &Error{}, err
+	}
+
+	commandTag, err := tx.Exec(context.Background(), movePinQuery, pinMove.BoardID, pinMove.PinID, pinMove.SectionID)
+	if err != nil {
+		return &Error{}, err
+	}
+	if commandTag.RowsAffected() != 1 {
+		return &Error{}, entity.PinNotInBoardError
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionCommitError
+	}
+	return &Error{}, nil
+}
+
+const checkLayoutVersionQuery string = "UPDATE Boards SET layout_version = layout_version + 1\n" +
+	"WHERE boardID=$1 AND layout_version=$2\n" +
+	"RETURNING layout_version"
+const setSectionPositionQuery string = "UPDATE board_sections SET position=$3 WHERE sectionID=$1 AND boardID=$2"
+const moveUnlistedSectionsQuery string = "UPDATE board_sections SET position = position + $2\n" +
+	"WHERE boardID=$1 AND NOT sectionID = ANY($3)"
+const setPinPositionQuery string = "UPDATE pairs SET sectionID=NULLIF($3, 0), position=$4 WHERE boardID=$1 AND pinID=$2"
+const moveUnlistedPinsQuery string = "UPDATE pairs SET position = position + $2\n" +
+	"WHERE boardID=$1 AND NOT pinID = ANY($3)"
+
+// ReorderBoard saves order of board's sections and pins, together with pins' sections
+// Order is saved only if nobody changed board since passed layout version, so that concurrent changes are not lost
+// Sections and pins which were not passed keep their relative order after passed ones
+// It returns board's new layout version, nil on success and nil, error on failure
+func (s *service) ReorderBoard(ctx context.Context, boardOrder *BoardOrder) (*LayoutVersion, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &LayoutVersion{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	newVersion := LayoutVersion{}
+	row := tx.QueryRow(context.Background(), checkLayoutVersionQuery, boardOrder.BoardID, boardOrder.LayoutVersion)
+	err = row.Scan(&newVersion.LayoutVersion)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return &LayoutVersion{}, entity.BoardLayoutConflictError
+		}
+		return &LayoutVersion{}, entity.BoardScanError
+	}
+
+	for i, sectionID := range boardOrder.SectionIDs {
+		commandTag, err := tx.Exec(context.Background(), setSectionPositionQuery, sectionID, boardOrder.BoardID, i+1)
+		if err != nil {
+			return &LayoutVersion{}, err
+		}
+		if commandTag.RowsAffected() != 1 {
+			return &LayoutVersion{}, entity.SectionNotFoundError
+		}
+	}
+
+	_, err = tx.Exec(context.Background(), moveUnlistedSectionsQuery, boardOrder.BoardID, len(boardOrder.SectionIDs), boardOrder.SectionIDs)
+	if err != nil {
+		return &LayoutVersion{}, err
+	}
+
+	pinIDs := make([]int64, 0, len(boardOrder.Pins))
+	for i, placement := range boardOrder.Pins {
+		err = checkSection(tx, boardOrder.BoardID, placement.SectionID)
+		if err != nil {
+			return &LayoutVersion{}, err
+		}
+
+		commandTag, err := tx.Exec(context.Background(), setPinPositionQuery, boardOrder.BoardID,
+			placement.PinID, placement.SectionID, i+1)
+		if err != nil {
+			return &LayoutVersion{}, err
+		}
+		if commandTag.RowsAffected() != 1 {
+			return &LayoutVersion{}, entity.PinNotInBoardError
+		}
+		pinIDs = append(pinIDs, placement.PinID)
+	}
+
+	_, err = tx.Exec(context.Background(), moveUnlistedPinsQuery, boardOrder.BoardID, len(pinIDs), pinIDs)
+	if err != nil {
+		return &LayoutVersion{}, err
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &LayoutVersion{}, entity.TransactionCommitError
+	}
+	return &newVersion, nil
+}
+
+const followBoardQuery string = "INSERT INTO board_followers(boardID, userID) VALUES ($1, $2)"
+const updateBoardFollowersQuery string = "UPDATE Boards SET followers_count = followers_count + $2 WHERE boardID=$1"
+
+// FollowBoard makes user follow board and updates board's followers counter
+// It returns nil on success, error on failure
+func (s *service) FollowBoard(ctx context.Context, follower *BoardMember) (*Error, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	_, err = tx.Exec(context.Background(), followBoardQuery, follower.BoardID, follower.UserID)
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "Duplicate"):
+			return &Error{}, entity.BoardFollowAlreadyExistsError
+		case strings.Contains(err.Error(), `violates foreign key constraint "board_followers_boards"`):
+			return &Error{}, entity.BoardNotFoundError
+		case strings.Contains(err.Error(), `violates foreign key constraint "board_followers_users"`):
+			return &Error{}, entity.UserNotFoundError
+		default:
+			return &Error{}, err
+		}
+	}
+
+	_, err = tx.Exec(context.Background(), updateBoardFollowersQuery, follower.BoardID, 1)
+	if err != nil {
+		return &Error{}, entity.FollowCountUpdateError
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionCommitError
+	}
+	return &Error{}, nil
+}
+
+const unfollowBoardQuery string = "DELETE FROM board_followers WHERE boardID=$1 AND userID=$2"
+
+// UnfollowBoard makes user stop following board and updates board's followers counter
+// It returns nil on success, error on failure
+func (s *service) UnfollowBoard(ctx context.Context, follower *BoardMember) (*Error, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	commandTag, err := tx.Exec(context.Background(), unfollowBoardQuery, follower.BoardID, follower.UserID)
+	if err != nil {
+		return &Error{}, err
+	}
+	if commandTag.RowsAffected() != 1 {
+		return &Error{}, entity.BoardFollowNotFoundError
+	}
+
+	_, err = tx.Exec(context.Background(), updateBoardFollowersQuery, follower.BoardID, -1)
+	if err != nil {
+		return &Error{}, entity.FollowCountUpdateError
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionCommitError
+	}
+	return &Error{}, nil
+}
+
+const getBoardFollowersQuery string = "SELECT userID FROM board_followers WHERE boardID=$1"
+
+// GetBoardFollowers fetches IDs of all users who follow board
+// It returns list of IDs, nil on success and nil, error on failure
+func (s *service) GetBoardFollowers(ctx context.Context, boardID *BoardID) (*UserIDList, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &UserIDList{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	rows, err := tx.Query(context.Background(), getBoardFollowersQuery, boardID.BoardID)
+	if err != nil {
+		return &UserIDList{}, err
+	}
+
+	followerIDs := make([]int64, 0)
+	for rows.Next() {
+		var followerID int64
+		err = rows.Scan(&followerID)
+		if err != nil {
+			return &UserIDList{}, entity.UserScanError
+		}
+		followerIDs = append(followerIDs, followerID)
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &UserIDList{}, entity.TransactionCommitError
+	}
+	return &UserIDList{Ids: followerIDs}, nil
+}
+
 const createPinQuery string = "INSERT INTO Pins (userID, title, description, imageLink, imageHeight, imageWidth, imageAvgColor, creationDate)\n" +
 	"values ($1, $2, $3, $4, $5, $6, $7, $8)\n" +
 	"RETURNING pinID;\n"
@@ -266,10 +911,10 @@ func (s *service) CreatePin(ctx context.Context, pin *Pin) (*PinID, error) {
 	return &PinID{PinID: int64(newPinID)}, nil
 }
 
-const createPairQuery string = "INSERT INTO pairs (boardID, pinID)\n" +
-	"values ($1, $2);\n"
+const createPairQuery string = "INSERT INTO pairs (boardID, pinID, position)\n" +
+	"SELECT $1, $2, COALESCE(MAX(position), 0) + 1 FROM pairs WHERE boardID=$1;\n"
 
-// AddPin add new pin to specified board with passed fields
+// AddPin add new pin to the end of specified board
 // It returns nil on success, error on failure
 func (s *service) AddPin(ctx context.Context, pinInBoard *PinInBoard) (*Error, error) {
 	tx, err := s.db.Begin(context.Background())
@@ -278,6 +923,11 @@ func (s *service) AddPin(ctx context.Context, pinInBoard *PinInBoard) (*Error, e
 	}
 	defer tx.Rollback(context.Background())
 
+	err = bumpLayoutVersion(tx, pinInBoard.BoardID)
+	if err != nil {
+		return &Error{}, err
+	}
+
 	commandTag, err := tx.Exec(context.Background(), createPairQuery, pinInBoard.BoardID, pinInBoard.PinID)
 	if err != nil {
 		return &Error{}, err
@@ -295,26 +945,37 @@ func (s *service) AddPin(ctx context.Context, pinInBoard *PinInBoard) (*Error, e
 
 const getPinQuery string = "SELECT userID, title, description," +
 	"imageLink, imageHeight, imageWidth, ImageAvgColor, " +
-	"creationDate, reports_count\n" +
+	"creationDate, reports_count, NOT " + publicPinCondition + "\n" +
 	"FROM Pins\n" +
-	"WHERE pinID=$1"
-
-// GetPin fetches user with passed ID from database
-// It returns that user, nil on success and nil, error on failure
-func (s *service) GetPin(ctx context.Context, pinID *PinID) (*Pin, error) {
+	"WHERE pinID=$1 AND " + visiblePinCondition
+
+// publicPinCondition is true if pin is on at least one board which is not secret
+const publicPinCondition string = "EXISTS (SELECT 1 FROM pairs\n" +
+	"INNER JOIN Boards ON Boards.boardID = pairs.boardID\n" +
+	"WHERE pairs.pinID = pins.pinID AND NOT Boards.is_secret)"
+
+// visiblePinCondition is true if user passed as $2 is pin's author or can see at least one board with pin
+const visiblePinCondition string = "(pins.userID = $2 OR EXISTS (SELECT 1 FROM pairs\n" +
+	"INNER JOIN Boards ON Boards.boardID = pairs.boardID\n" +
+	"WHERE pairs.pinID = pins.pinID AND " + visibleBoardCondition + "))"
+
+// GetPin fetches pin with passed ID from database
+// Pin which is only on secret boards is not found unless requester is its author or can see one of them
+// It returns that pin, nil on success and nil, error on failure
+func (s *service) GetPin(ctx context.Context, pinRequest *PinRequest) (*Pin, error) {
 	tx, err := s.db.Begin(context.Background())
 	if err != nil {
 		return &Pin{}, entity.TransactionBeginError
 	}
 	defer tx.Rollback(context.Background())
 
-	row := tx.QueryRow(context.Background(), getPinQuery, pinID.PinID)
+	row := tx.QueryRow(context.Background(), getPinQuery, pinRequest.PinID, pinRequest.RequesterID)
 
-	pin := Pin{PinID: pinID.PinID}
+	pin := Pin{PinID: pinRequest.PinID}
 	var pinCreationDate time.Time
 	err = row.Scan(&pin.UserID, &pin.Title, &pin.Description,
 		&pin.ImageLink, &pin.ImageHeight, &pin.ImageWidth, &pin.ImageAvgColor,
-		&pinCreationDate, &pin.ReportsCount)
+		&pinCreationDate, &pin.ReportsCount, &pin.IsSecret)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return &Pin{}, entity.PinNotFoundError
@@ -332,20 +993,24 @@ func (s *service) GetPin(ctx context.Context, pinID *PinID) (*Pin, error) {
 
 const getPinsByBoardQuery string = "SELECT pins.pinID, pins.userID, pins.title, pins.description, " +
 	"pins.imageLink, pins.imageHeight, pins.imageWidth, pins.imageAvgColor, " +
-	"pins.creationDate, pins.reports_count\n" +
+	"pins.creationDate, pins.reports_count, COALESCE(pairs.sectionID, 0)\n" +
 	"FROM Pins\n" +
-	"INNER JOIN pairs on pins.pinID = pairs.pinID WHERE boardID=$1"
+	"INNER JOIN pairs on pins.pinID = pairs.pinID\n" +
+	"INNER JOIN Boards on Boards.boardID = pairs.boardID\n" +
+	"WHERE pairs.boardID=$1 AND " + visibleBoardCondition + "\n" +
+	"ORDER BY pairs.position, pairs.pinID"
 
-// GetPins fetches all pins from board
+// GetPins fetches all pins from board in board's order, together with their sections
+// Secret board has no pins unless requester is its owner or collaborator
 // It returns slice of all pins in board, nil on success and nil, error on failure
-func (s *service) GetPins(ctx context.Context, boardID *BoardID) (*PinsList, error) {
+func (s *service) GetPins(ctx context.Context, boardRequest *BoardRequest) (*PinsList, error) {
 	tx, err := s.db.Begin(context.Background())
 	if err != nil {
 		return &PinsList{}, entity.TransactionBeginError
 	}
 	defer tx.Rollback(context.Background())
 
-	rows, err := tx.Query(context.Background(), getPinsByBoardQuery, boardID.BoardID)
+	rows, err := tx.Query(context.Background(), getPinsByBoardQuery, boardRequest.BoardID, boardRequest.RequesterID)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return &PinsList{}, nil
@@ -359,7 +1024,7 @@ func (s *service) GetPins(ctx context.Context, boardID *BoardID) (*PinsList, err
 		pin := Pin{}
 		err = rows.Scan(&pin.PinID, &pin.UserID, &pin.Title, &pin.Description,
 			&pin.ImageLink, &pin.ImageHeight, &pin.ImageWidth, &pin.ImageAvgColor,
-			&pinCreationDate, &pin.ReportsCount)
+			&pinCreationDate, &pin.ReportsCount, &pin.SectionID)
 		if err != nil {
 			return &PinsList{}, entity.PinScanError
 		}
@@ -442,8 +1107,8 @@ func (s *service) GetLastBoardPin(ctx context.Context, boardID *BoardID) (*Pin,
 	return &pin, nil
 }
 
-const getBoardsWithPinQuery string = "SELECT boardID, userID, title, description, " +
-	"imageLink, imageHeight, imageWidth, imageAvgColor\n" +
+const getBoardsWithPinQuery string = "SELECT board.boardID, userID, title, description, " +
+	"imageLink, imageHeight, imageWidth, imageAvgColor, COALESCE(coverPinID, 0), is_archived, is_secret, layout_version, followers_count\n" +
 	"FROM Boards as board\n" +
 	"INNER JOIN pairs on pairs.boardID = board.boardID AND pairs.pinID = $1"
 
@@ -466,7 +1131,8 @@ func (s *service) GetBoardsWithPin(ctx context.Context, pinID *PinID) (*BoardsLi
 	for rows.Next() {
 		board := Board{}
 		err = rows.Scan(&board.BoardID, &board.UserID, &board.Title, &board.Description,
-			&board.ImageLink, &board.ImageHeight, &board.ImageWidth, &board.ImageAvgColor)
+			&board.ImageLink, &board.ImageHeight, &board.ImageWidth, &board.ImageAvgColor,
+			&board.CoverPinID, &board.IsArchived, &board.IsSecret, &board.LayoutVersion, &board.FollowersCount)
 		if err != nil {
 			return &BoardsList{}, entity.PinScanError
 		}
@@ -509,7 +1175,42 @@ func (s *service) SavePicture(ctx context.Context, pin *Pin) (*Error, error) {
 	return &Error{}, nil
 }
 
+const updatePinQuery string = "UPDATE pins\n" +
+	"SET title=$1, " +
+	"description=$2, " +
+	"imageLink=$3, " +
+	"imageHeight=$4, " +
+	"imageWidth=$5, " +
+	"imageAvgColor=$6\n" +
+	"WHERE pinID=$7"
+
+// UpdatePin saves pin's title, description and picture to database
+// It returns nil on success and error on failure
+func (s *service) UpdatePin(ctx context.Context, pin *Pin) (*Error, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	commandTag, err := tx.Exec(context.Background(), updatePinQuery, pin.Title, pin.Description,
+		pin.ImageLink, pin.ImageHeight, pin.ImageWidth, pin.ImageAvgColor, pin.PinID)
+	if err != nil {
+		return &Error{}, entity.PinSavingError
+	}
+	if commandTag.RowsAffected() != 1 {
+		return &Error{}, entity.PinNotFoundError
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionCommitError
+	}
+	return &Error{}, nil
+}
+
 const deletePairQuery string = "DELETE FROM pairs WHERE pinID = $1 AND boardID = $2;"
+const resetBoardCoverQuery string = "UPDATE Boards SET coverPinID = NULL WHERE boardID = $2 AND coverPinID = $1"
 
 // RemovePin removes pin with passed boardID
 // It returns nil on success and error on failure
@@ -520,6 +1221,11 @@ func (s *service) RemovePin(ctx context.Context, pinInBoard *PinInBoard) (*Error
 	}
 	defer tx.Rollback(context.Background())
 
+	err = bumpLayoutVersion(tx, pinInBoard.BoardID)
+	if err != nil {
+		return &Error{}, err
+	}
+
 	commandTag, err := tx.Exec(context.Background(), deletePairQuery, pinInBoard.PinID, pinInBoard.BoardID)
 	if err != nil {
 		return &Error{}, err
@@ -528,6 +1234,11 @@ func (s *service) RemovePin(ctx context.Context, pinInBoard *PinInBoard) (*Error
 		return &Error{}, entity.RemovePinError
 	}
 
+	_, err = tx.Exec(context.Background(), resetBoardCoverQuery, pinInBoard.PinID, pinInBoard.BoardID) // Board falls back to automatic cover
+	if err != nil {
+		return &Error{}, entity.RemovePinError
+	}
+
 	err = tx.Commit(context.Background())
 	if err != nil {
 		return &Error{}, entity.TransactionCommitError
@@ -632,11 +1343,14 @@ const getPinsWithOffsetQuery string = "SELECT pins.pinID, pins.userID, pins.titl
 	"pins.imageLink, pins.imageHeight, pins.imageWidth, pins.imageAvgColor, " +
 	"pins.creationDate, pins.reports_count\n" +
 	"FROM Pins\n" +
+	"INNER JOIN Users ON Users.userID = pins.userID\n" +
+	"WHERE NOT Users.is_private\n" + // Pins of private accounts are only shown to followers
+	"AND " + publicPinCondition + "\n" +
 	"ORDER BY pins.pinID DESC\n" +
 	"OFFSET $1\n" +
 	"LIMIT $2;"
 
-// GetPinsWithOffset generates the main feed
+// GetPinsWithOffset generates the main feed out of pins on boards which are not secret
 // It returns ~amount pins and nil on success, nil and error on failure
 func (s *service) GetPinsWithOffset(ctx context.Context, feedInfo *FeedInfo) (*PinsList, error) {
 	tx, err := s.db.Begin(context.Background())
@@ -679,14 +1393,23 @@ const SearchAllPinsQuery string = "SELECT pins.pinID, pins.userID, pins.title, p
 	"pins.imageLink, pins.imageHeight, pins.imageWidth, pins.imageAvgColor, " +
 	"pins.creationDate, pins.reports_count\n" +
 	"FROM pins\n" +
-	"WHERE LOWER(pins.title) LIKE $1;"
+	"WHERE LOWER(pins.title) LIKE $1\n" +
+	"AND " + notBlockedPinAuthorCondition + "\n" +
+	"AND " + publicPinCondition + ";"
 const SearchPeriodPinsQuery string = "SELECT pins.pinID, pins.userID, pins.title, pins.description, " +
 	"pins.imageLink, pins.imageHeight, pins.imageWidth, pins.imageAvgColor, " +
 	"pins.creationDate, pins.reports_count\n" +
 	"FROM pins\n" +
-	"WHERE LOWER(pins.title) LIKE $1 AND now() - pins.creationdate < $2;"
+	"WHERE LOWER(pins.title) LIKE $1 AND now() - pins.creationdate < $3\n" +
+	"AND " + notBlockedPinAuthorCondition + "\n" +
+	"AND " + publicPinCondition + ";"
 
-// SearchPins returns pins by keywords
+// notBlockedPinAuthorCondition is true if pin's author and user passed as $2 have not blocked each other
+const notBlockedPinAuthorCondition string = "NOT EXISTS (SELECT 1 FROM Blocks\n" +
+	"WHERE (blockerID = pins.userID AND blockedID = $2) OR (blockerID = $2 AND blockedID = pins.userID))"
+
+// SearchPins returns pins by keywords, skipping pins of users who blocked requester or were blocked by them
+// and pins which are only on secret boards
 // It returns suitable pins and nil on success, nil and error on failure
 func (s *service) SearchPins(ctx context.Context, searchInput *SearchInput) (*PinsList, error) {
 	tx, err := s.db.Begin(context.Background())
@@ -699,7 +1422,7 @@ func (s *service) SearchPins(ctx context.Context, searchInput *SearchInput) (*Pi
 
 	switch searchInput.Interval {
 	case "allTime":
-		rows, err = tx.Query(context.Background(), SearchAllPinsQuery, "%"+searchInput.KeyWords+"%")
+		rows, err = tx.Query(context.Background(), SearchAllPinsQuery, "%"+searchInput.KeyWords+"%", searchInput.RequesterID)
 	case "hour", "day", "week":
 		var interval pgtype.Interval
 		switch searchInput.Interval {
@@ -710,7 +1433,7 @@ func (s *service) SearchPins(ctx context.Context, searchInput *SearchInput) (*Pi
 		case "week":
 			interval.Set(24 * 7 * time.Hour)
 		}
-		rows, err = tx.Query(context.Background(), SearchPeriodPinsQuery, "%"+searchInput.KeyWords+"%", interval)
+		rows, err = tx.Query(context.Background(), SearchPeriodPinsQuery, "%"+searchInput.KeyWords+"%", searchInput.RequesterID, interval)
 	default:
 		return &PinsList{}, entity.WrongSearchInterval
 	}
@@ -746,10 +1469,11 @@ const GetPinsByUsersIDQuery string = "SELECT pins.pinID, pins.userID, pins.title
 	"pins.imageLink, pins.imageHeight, pins.imageWidth, pins.imageAvgColor, " +
 	"pins.creationDate, pins.reports_count\n" +
 	"FROM Pins\n" +
-	"WHERE pins.UserID = ANY($1)" +
+	"WHERE pins.UserID = ANY($1)\n" +
+	"AND " + publicPinCondition + "\n" +
 	"ORDER BY pins.PinID DESC;" // So that newest pins will come up first
 
-// GetPinsOfUsers outputs all pins of passed users
+// GetPinsOfUsers outputs all pins of passed users, skipping pins which are only on secret boards
 // It returns slice of pins, nil on success, nil, error on failure
 func (s *service) GetPinsOfUsers(ctx context.Context, userIDs *UserIDList) (*PinsList, error) {
 	tx, err := s.db.Begin(context.Background())
@@ -787,6 +1511,60 @@ func (s *service) GetPinsOfUsers(ctx context.Context, userIDs *UserIDList) (*Pin
 	return &PinsList{Pins: pins}, nil
 }
 
+const getPinsOfFollowedBoardsQuery string = "SELECT pins.pinID, pins.userID, pins.title, pins.description, " +
+	"pins.imageLink, pins.imageHeight, pins.imageWidth, pins.imageAvgColor, " +
+	"pins.creationDate, pins.reports_count\n" +
+	"FROM Pins\n" +
+	"WHERE pins.pinID IN (SELECT pairs.pinID FROM pairs\n" +
+	"INNER JOIN board_followers ON board_followers.boardID = pairs.boardID\n" +
+	"INNER JOIN Boards ON Boards.boardID = pairs.boardID\n" +
+	"INNER JOIN Users ON Users.userID = Boards.userID\n" +
+	"WHERE board_followers.userID = $1\n" +
+	"AND " + visibleBoardCondition + "\n" +
+	"AND (NOT Users.is_private OR Boards.userID = $2 OR " + boardOwnerFollowerCondition + "))\n" +
+	"ORDER BY pins.PinID DESC;" // So that newest pins will come up first
+
+// boardOwnerFollowerCondition is true if user passed as $2 follows board's owner, requested follows are not counted
+const boardOwnerFollowerCondition string = "EXISTS (SELECT 1 FROM Followers\n" +
+	"WHERE Followers.followerID = $2 AND Followers.followedID = Boards.userID)"
+
+// GetPinsOfFollowedBoards outputs all pins from boards which user follows
+// Secret boards are skipped unless user is their owner or collaborator,
+// boards of private accounts are skipped unless user follows their owner
+// It returns slice of pins, nil on success, nil, error on failure
+func (s *service) GetPinsOfFollowedBoards(ctx context.Context, userID *UserID) (*PinsList, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return nil, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	rows, err := tx.Query(context.Background(), getPinsOfFollowedBoardsQuery, userID.Uid, userID.Uid)
+	if err != nil {
+		return &PinsList{}, entity.PinScanError
+	}
+
+	pins := make([]*Pin, 0)
+	var pinCreationDate time.Time
+	for rows.Next() {
+		pin := Pin{}
+		err = rows.Scan(&pin.PinID, &pin.UserID, &pin.Title, &pin.Description,
+			&pin.ImageLink, &pin.ImageHeight, &pin.ImageWidth, &pin.ImageAvgColor,
+			&pinCreationDate, &pin.ReportsCount)
+		if err != nil {
+			return &PinsList{}, entity.PinScanError
+		}
+		pin.CreationDate = timestamppb.New(pinCreationDate)
+		pins = append(pins, &pin)
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &PinsList{}, entity.TransactionCommitError
+	}
+	return &PinsList{Pins: pins}, nil
+}
+
 const getPinRefCount string = "SELECT COUNT(pinID) FROM pairs WHERE pinID = $1"
 
 // PinRefCount count the number of pin references
@@ -30,14 +30,19 @@ type Board struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BoardID       int64  `protobuf:"varint,1,opt,name=BoardID,proto3" json:"BoardID,omitempty"`
-	UserID        int64  `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
-	Title         string `protobuf:"bytes,3,opt,name=Title,proto3" json:"Title,omitempty"`
-	Description   string `protobuf:"bytes,4,opt,name=Description,proto3" json:"Description,omitempty"`
-	ImageLink     string `protobuf:"bytes,5,opt,name=ImageLink,proto3" json:"ImageLink,omitempty"`
-	ImageHeight   int64  `protobuf:"varint,6,opt,name=ImageHeight,proto3" json:"ImageHeight,omitempty"`
-	ImageWidth    int64  `protobuf:"varint,7,opt,name=ImageWidth,proto3" json:"ImageWidth,omitempty"`
-	ImageAvgColor string `protobuf:"bytes,8,opt,name=ImageAvgColor,proto3" json:"ImageAvgColor,omitempty"`
+	BoardID        int64  `protobuf:"varint,1,opt,name=BoardID,proto3" json:"BoardID,omitempty"`
+	UserID         int64  `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Title          string `protobuf:"bytes,3,opt,name=Title,proto3" json:"Title,omitempty"`
+	Description    string `protobuf:"bytes,4,opt,name=Description,proto3" json:"Description,omitempty"`
+	ImageLink      string `protobuf:"bytes,5,opt,name=ImageLink,proto3" json:"ImageLink,omitempty"`
+	ImageHeight    int64  `protobuf:"varint,6,opt,name=ImageHeight,proto3" json:"ImageHeight,omitempty"`
+	ImageWidth     int64  `protobuf:"varint,7,opt,name=ImageWidth,proto3" json:"ImageWidth,omitempty"`
+	ImageAvgColor  string `protobuf:"bytes,8,opt,name=ImageAvgColor,proto3" json:"ImageAvgColor,omitempty"`
+	CoverPinID     int64  `protobuf:"varint,9,opt,name=CoverPinID,proto3" json:"CoverPinID,omitempty"` // 0 if board's last pin is used as cover
+	IsArchived     bool   `protobuf:"varint,10,opt,name=IsArchived,proto3" json:"IsArchived,omitempty"`
+	IsSecret       bool   `protobuf:"varint,11,opt,name=IsSecret,proto3" json:"IsSecret,omitempty"`
+	LayoutVersion  int64  `protobuf:"varint,12,opt,name=LayoutVersion,proto3" json:"LayoutVersion,omitempty"` // Is increased on every change of board's pins, sections or their order
+	FollowersCount int64  `protobuf:"varint,13,opt,name=FollowersCount,proto3" json:"FollowersCount,omitempty"`
 }
 
 func (x *Board) Reset() {
@@ -128,6 +133,41 @@ func (x *Board) GetImageAvgColor() string {
 	return ""
 }
 
+func (x *Board) GetCoverPinID() int64 {
+	if x != nil {
+		return x.CoverPinID
+	}
+	return 0
+}
+
+func (x *Board) GetIsArchived() bool {
+	if x != nil {
+		return x.IsArchived
+	}
+	return false
+}
+
+func (x *Board) GetIsSecret() bool {
+	if x != nil {
+		return x.IsSecret
+	}
+	return false
+}
+
+func (x *Board) GetLayoutVersion() int64 {
+	if x != nil {
+		return x.LayoutVersion
+	}
+	return 0
+}
+
+func (x *Board) GetFollowersCount() int64 {
+	if x != nil {
+		return x.FollowersCount
+	}
+	return 0
+}
+
 type Pin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -144,6 +184,8 @@ type Pin struct {
 	ImageAvgColor string               `protobuf:"bytes,9,opt,name=ImageAvgColor,proto3" json:"ImageAvgColor,omitempty"`
 	CreationDate  *timestamp.Timestamp `protobuf:"bytes,10,opt,name=CreationDate,proto3" json:"CreationDate,omitempty"`
 	ReportsCount  int64                `protobuf:"varint,11,opt,name=ReportsCount,proto3" json:"ReportsCount,omitempty"`
+	IsSecret      bool                 `protobuf:"varint,12,opt,name=IsSecret,proto3" json:"IsSecret,omitempty"`   // True if pin is only on secret boards
+	SectionID     int64                `protobuf:"varint,13,opt,name=SectionID,proto3" json:"SectionID,omitempty"` // Only set when pins are fetched by board, 0 if pin is not in any section
 }
 
 func (x *Pin) Reset() {
@@ -255,6 +297,20 @@ func (x *Pin) GetReportsCount() int64 {
 	return 0
 }
 
+func (x *Pin) GetIsSecret() bool {
+	if x != nil {
+		return x.IsSecret
+	}
+	return false
+}
+
+func (x *Pin) GetSectionID() int64 {
+	if x != nil {
+		return x.SectionID
+	}
+	return 0
+}
+
 type Report struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -373,6 +429,61 @@ func (x *UserID) GetUid() int64 {
 	return 0
 }
 
+type BoardsOfUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID      int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	RequesterID int64 `protobuf:"varint,2,opt,name=requesterID,proto3" json:"requesterID,omitempty"` // Secret boards are returned only if requester is their owner
+}
+
+func (x *BoardsOfUser) Reset() {
+	*x = BoardsOfUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pins_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardsOfUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardsOfUser) ProtoMessage() {}
+
+func (x *BoardsOfUser) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardsOfUser.ProtoReflect.Descriptor instead.
+func (*BoardsOfUser) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BoardsOfUser) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *BoardsOfUser) GetRequesterID() int64 {
+	if x != nil {
+		return x.RequesterID
+	}
+	return 0
+}
+
 type UserIDList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -384,7 +495,7 @@ type UserIDList struct {
 func (x *UserIDList) Reset() {
 	*x = UserIDList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pins_proto_msgTypes[4]
+		mi := &file_pins_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -397,7 +508,7 @@ func (x *UserIDList) String() string {
 func (*UserIDList) ProtoMessage() {}
 
 func (x *UserIDList) ProtoReflect() protoreflect.Message {
-	mi := &file_pins_proto_msgTypes[4]
+	mi := &file_pins_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -410,7 +521,7 @@ func (x *UserIDList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserIDList.ProtoReflect.Descriptor instead.
 func (*UserIDList) Descriptor() ([]byte, []int) {
-	return file_pins_proto_rawDescGZIP(), []int{4}
+	return file_pins_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UserIDList) GetIds() []int64 {
@@ -431,7 +542,7 @@ type BoardID struct {
 func (x *BoardID) Reset() {
 	*x = BoardID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pins_proto_msgTypes[5]
+		mi := &file_pins_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -444,7 +555,7 @@ func (x *BoardID) String() string {
 func (*BoardID) ProtoMessage() {}
 
 func (x *BoardID) ProtoReflect() protoreflect.Message {
-	mi := &file_pins_proto_msgTypes[5]
+	mi := &file_pins_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -457,7 +568,7 @@ func (x *BoardID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoardID.ProtoReflect.Descriptor instead.
 func (*BoardID) Descriptor() ([]byte, []int) {
-	return file_pins_proto_rawDescGZIP(), []int{5}
+	return file_pins_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *BoardID) GetBoardID() int64 {
@@ -467,6 +578,61 @@ func (x *BoardID) GetBoardID() int64 {
 	return 0
 }
 
+type BoardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BoardID     int64 `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	RequesterID int64 `protobuf:"varint,2,opt,name=requesterID,proto3" json:"requesterID,omitempty"` // Secret board is returned only if requester is its owner or collaborator, 0 if requester is not logged in
+}
+
+func (x *BoardRequest) Reset() {
+	*x = BoardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pins_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardRequest) ProtoMessage() {}
+
+func (x *BoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardRequest.ProtoReflect.Descriptor instead.
+func (*BoardRequest) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BoardRequest) GetBoardID() int64 {
+	if x != nil {
+		return x.BoardID
+	}
+	return 0
+}
+
+func (x *BoardRequest) GetRequesterID() int64 {
+	if x != nil {
+		return x.RequesterID
+	}
+	return 0
+}
+
 type BoardsList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -478,7 +644,7 @@ type BoardsList struct {
 func (x *BoardsList) Reset() {
 	*x = BoardsList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pins_proto_msgTypes[6]
+		mi := &file_pins_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -491,7 +657,7 @@ func (x *BoardsList) String() string {
 func (*BoardsList) ProtoMessage() {}
 
 func (x *BoardsList) ProtoReflect() protoreflect.Message {
-	mi := &file_pins_proto_msgTypes[6]
+	mi := &file_pins_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,7 +670,7 @@ func (x *BoardsList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoardsList.ProtoReflect.Descriptor instead.
 func (*BoardsList) Descriptor() ([]byte, []int) {
-	return file_pins_proto_rawDescGZIP(), []int{6}
+	return file_pins_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *BoardsList) GetBoards() []*Board {
@@ -525,7 +691,7 @@ type PinsList struct {
 func (x *PinsList) Reset() {
 	*x = PinsList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pins_proto_msgTypes[7]
+		mi := &file_pins_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -538,7 +704,7 @@ func (x *PinsList) String() string {
 func (*PinsList) ProtoMessage() {}
 
 func (x *PinsList) ProtoReflect() protoreflect.Message {
-	mi := &file_pins_proto_msgTypes[7]
+	mi := &file_pins_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -551,7 +717,7 @@ func (x *PinsList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PinsList.ProtoReflect.Descriptor instead.
 func (*PinsList) Descriptor() ([]byte, []int) {
-	return file_pins_proto_rawDescGZIP(), []int{7}
+	return file_pins_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PinsList) GetPins() []*Pin {
@@ -572,7 +738,7 @@ type PinID struct {
 func (x *PinID) Reset() {
 	*x = PinID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pins_proto_msgTypes[8]
+		mi := &file_pins_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -585,7 +751,7 @@ func (x *PinID) String() string {
 func (*PinID) ProtoMessage() {}
 
 func (x *PinID) ProtoReflect() protoreflect.Message {
-	mi := &file_pins_proto_msgTypes[8]
+	mi := &file_pins_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -598,7 +764,7 @@ func (x *PinID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PinID.ProtoReflect.Descriptor instead.
 func (*PinID) Descriptor() ([]byte, []int) {
-	return file_pins_proto_rawDescGZIP(), []int{8}
+	return file_pins_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PinID) GetPinID() int64 {
@@ -608,6 +774,61 @@ func (x *PinID) GetPinID() int64 {
 	return 0
 }
 
+type PinRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PinID       int64 `protobuf:"varint,1,opt,name=pinID,proto3" json:"pinID,omitempty"`
+	RequesterID int64 `protobuf:"varint,2,opt,name=requesterID,proto3" json:"requesterID,omitempty"` // Pin which is only on secret boards is returned only if requester is its author or can see one of them
+}
+
+func (x *PinRequest) Reset() {
+	*x = PinRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pins_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinRequest) ProtoMessage() {}
+
+func (x *PinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinRequest.ProtoReflect.Descriptor instead.
+func (*PinRequest) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PinRequest) GetPinID() int64 {
+	if x != nil {
+		return x.PinID
+	}
+	return 0
+}
+
+func (x *PinRequest) GetRequesterID() int64 {
+	if x != nil {
+		return x.RequesterID
+	}
+	return 0
+}
+
 type ReportID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -619,7 +840,7 @@ type ReportID struct {
 func (x *ReportID) Reset() {
 	*x = ReportID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pins_proto_msgTypes[9]
+		mi := &file_pins_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -632,7 +853,7 @@ func (x *ReportID) String() string {
 func (*ReportID) ProtoMessage() {}
 
 func (x *ReportID) ProtoReflect() protoreflect.Message {
-	mi := &file_pins_proto_msgTypes[9]
+	mi := &file_pins_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -645,7 +866,7 @@ func (x *ReportID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportID.ProtoReflect.Descriptor instead.
 func (*ReportID) Descriptor() ([]byte, []int) {
-	return file_pins_proto_rawDescGZIP(), []int{9}
+	return file_pins_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ReportID) GetReportID() int64 {
@@ -667,7 +888,7 @@ type Save struct {
 func (x *Save) Reset() {
 	*x = Save{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pins_proto_msgTypes[10]
+		mi := &file_pins_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -680,7 +901,7 @@ func (x *Save) String() string {
 func (*Save) ProtoMessage() {}
 
 func (x *Save) ProtoReflect() protoreflect.Message {
-	mi := &file_pins_proto_msgTypes[10]
+	mi := &file_pins_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,7 +914,7 @@ func (x *Save) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Save.ProtoReflect.Descriptor instead.
 func (*Save) Descriptor() ([]byte, []int) {
-	return file_pins_proto_rawDescGZIP(), []int{10}
+	return file_pins_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Save) GetUserID() int64 {
@@ -722,7 +943,7 @@ type BoardOwner struct {
 func (x *BoardOwner) Reset() {
 	*x = BoardOwner{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pins_proto_msgTypes[11]
+		mi := &file_pins_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -735,7 +956,7 @@ func (x *BoardOwner) String() string {
 func (*BoardOwner) ProtoMessage() {}
 
 func (x *BoardOwner) ProtoReflect() protoreflect.Message {
-	mi := &file_pins_proto_msgTypes[11]
+	mi := &file_pins_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,7 +969,7 @@ func (x *BoardOwner) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoardOwner.ProtoReflect.Descriptor instead.
 func (*BoardOwner) Descriptor() ([]byte, []int) {
-	return file_pins_proto_rawDescGZIP(), []int{11}
+	return file_pins_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *BoardOwner) GetUserID() int64 {
@@ -765,32 +986,32 @@ func (x *BoardOwner) GetBoardID() int64 {
 	return 0
 }
 
-type PinInBoard struct {
+type BoardMember struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BoardID int64 `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
-	PinID   int64 `protobuf:"varint,2,opt,name=pinID,proto3" json:"pinID,omitempty"`
+	UserID  int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	BoardID int64 `protobuf:"varint,2,opt,name=boardID,proto3" json:"boardID,omitempty"`
 }
 
-func (x *PinInBoard) Reset() {
-	*x = PinInBoard{}
+func (x *BoardMember) Reset() {
+	*x = BoardMember{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pins_proto_msgTypes[12]
+		mi := &file_pins_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PinInBoard) String() string {
+func (x *BoardMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PinInBoard) ProtoMessage() {}
+func (*BoardMember) ProtoMessage() {}
 
-func (x *PinInBoard) ProtoReflect() protoreflect.Message {
-	mi := &file_pins_proto_msgTypes[12]
+func (x *BoardMember) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -801,53 +1022,54 @@ func (x *PinInBoard) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PinInBoard.ProtoReflect.Descriptor instead.
-func (*PinInBoard) Descriptor() ([]byte, []int) {
-	return file_pins_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use BoardMember.ProtoReflect.Descriptor instead.
+func (*BoardMember) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *PinInBoard) GetBoardID() int64 {
+func (x *BoardMember) GetUserID() int64 {
 	if x != nil {
-		return x.BoardID
+		return x.UserID
 	}
 	return 0
 }
 
-func (x *PinInBoard) GetPinID() int64 {
+func (x *BoardMember) GetBoardID() int64 {
 	if x != nil {
-		return x.PinID
+		return x.BoardID
 	}
 	return 0
 }
 
-type UploadImage struct {
+type Collaborator struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Data:
-	//	*UploadImage_Extension
-	//	*UploadImage_ChunkData
-	Data isUploadImage_Data `protobuf_oneof:"data"`
+	BoardID    int64  `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	UserID     int64  `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Role       string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // "editor" or "viewer"
+	Username   string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	AvatarLink string `protobuf:"bytes,5,opt,name=avatarLink,proto3" json:"avatarLink,omitempty"`
 }
 
-func (x *UploadImage) Reset() {
-	*x = UploadImage{}
+func (x *Collaborator) Reset() {
+	*x = Collaborator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pins_proto_msgTypes[13]
+		mi := &file_pins_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadImage) String() string {
+func (x *Collaborator) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadImage) ProtoMessage() {}
+func (*Collaborator) ProtoMessage() {}
 
-func (x *UploadImage) ProtoReflect() protoreflect.Message {
-	mi := &file_pins_proto_msgTypes[13]
+func (x *Collaborator) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -858,74 +1080,119 @@ func (x *UploadImage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadImage.ProtoReflect.Descriptor instead.
-func (*UploadImage) Descriptor() ([]byte, []int) {
-	return file_pins_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use Collaborator.ProtoReflect.Descriptor instead.
+func (*Collaborator) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{16}
 }
 
-func (m *UploadImage) GetData() isUploadImage_Data {
-	if m != nil {
-		return m.Data
+func (x *Collaborator) GetBoardID() int64 {
+	if x != nil {
+		return x.BoardID
 	}
-	return nil
+	return 0
 }
 
-func (x *UploadImage) GetExtension() string {
-	if x, ok := x.GetData().(*UploadImage_Extension); ok {
-		return x.Extension
+func (x *Collaborator) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Collaborator) GetRole() string {
+	if x != nil {
+		return x.Role
 	}
 	return ""
 }
 
-func (x *UploadImage) GetChunkData() []byte {
-	if x, ok := x.GetData().(*UploadImage_ChunkData); ok {
-		return x.ChunkData
+func (x *Collaborator) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-type isUploadImage_Data interface {
-	isUploadImage_Data()
+func (x *Collaborator) GetAvatarLink() string {
+	if x != nil {
+		return x.AvatarLink
+	}
+	return ""
 }
 
-type UploadImage_Extension struct {
-	Extension string `protobuf:"bytes,1,opt,name=Extension,proto3,oneof"`
+type CollaboratorsList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collaborators []*Collaborator `protobuf:"bytes,1,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
 }
 
-type UploadImage_ChunkData struct {
-	ChunkData []byte `protobuf:"bytes,2,opt,name=chunk_data,json=chunkData,proto3,oneof"`
+func (x *CollaboratorsList) Reset() {
+	*x = CollaboratorsList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pins_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (*UploadImage_Extension) isUploadImage_Data() {}
+func (x *CollaboratorsList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (*UploadImage_ChunkData) isUploadImage_Data() {}
+func (*CollaboratorsList) ProtoMessage() {}
 
-type UploadImageResponse struct {
+func (x *CollaboratorsList) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollaboratorsList.ProtoReflect.Descriptor instead.
+func (*CollaboratorsList) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CollaboratorsList) GetCollaborators() []*Collaborator {
+	if x != nil {
+		return x.Collaborators
+	}
+	return nil
+}
+
+type Invitation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Size uint32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Board *Board `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Role  string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
 }
 
-func (x *UploadImageResponse) Reset() {
-	*x = UploadImageResponse{}
+func (x *Invitation) Reset() {
+	*x = Invitation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pins_proto_msgTypes[14]
+		mi := &file_pins_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadImageResponse) String() string {
+func (x *Invitation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadImageResponse) ProtoMessage() {}
+func (*Invitation) ProtoMessage() {}
 
-func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pins_proto_msgTypes[14]
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -936,54 +1203,50 @@ func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadImageResponse.ProtoReflect.Descriptor instead.
-func (*UploadImageResponse) Descriptor() ([]byte, []int) {
-	return file_pins_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *UploadImageResponse) GetPath() string {
+func (x *Invitation) GetBoard() *Board {
 	if x != nil {
-		return x.Path
+		return x.Board
 	}
-	return ""
+	return nil
 }
 
-func (x *UploadImageResponse) GetSize() uint32 {
+func (x *Invitation) GetRole() string {
 	if x != nil {
-		return x.Size
+		return x.Role
 	}
-	return 0
+	return ""
 }
 
-type FileInfo struct {
+type InvitationsList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BoardID       int64  `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
-	ImageLink     string `protobuf:"bytes,2,opt,name=imageLink,proto3" json:"imageLink,omitempty"`
-	ImageHeight   int64  `protobuf:"varint,3,opt,name=imageHeight,proto3" json:"imageHeight,omitempty"`
-	ImageWidth    int64  `protobuf:"varint,4,opt,name=imageWidth,proto3" json:"imageWidth,omitempty"`
-	ImageAvgColor string `protobuf:"bytes,5,opt,name=imageAvgColor,proto3" json:"imageAvgColor,omitempty"`
+	Invitations []*Invitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
 }
 
-func (x *FileInfo) Reset() {
-	*x = FileInfo{}
+func (x *InvitationsList) Reset() {
+	*x = InvitationsList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pins_proto_msgTypes[15]
+		mi := &file_pins_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FileInfo) String() string {
+func (x *InvitationsList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileInfo) ProtoMessage() {}
+func (*InvitationsList) ProtoMessage() {}
 
-func (x *FileInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pins_proto_msgTypes[15]
+func (x *InvitationsList) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -994,72 +1257,92 @@ func (x *FileInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
-func (*FileInfo) Descriptor() ([]byte, []int) {
-	return file_pins_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use InvitationsList.ProtoReflect.Descriptor instead.
+func (*InvitationsList) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *FileInfo) GetBoardID() int64 {
+func (x *InvitationsList) GetInvitations() []*Invitation {
 	if x != nil {
-		return x.BoardID
+		return x.Invitations
 	}
-	return 0
+	return nil
 }
 
-func (x *FileInfo) GetImageLink() string {
-	if x != nil {
-		return x.ImageLink
-	}
-	return ""
+type BoardRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"` // "owner", "editor", "viewer" or empty if user is not related to board
 }
 
-func (x *FileInfo) GetImageHeight() int64 {
-	if x != nil {
-		return x.ImageHeight
+func (x *BoardRole) Reset() {
+	*x = BoardRole{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pins_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *FileInfo) GetImageWidth() int64 {
-	if x != nil {
-		return x.ImageWidth
+func (x *BoardRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardRole) ProtoMessage() {}
+
+func (x *BoardRole) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *FileInfo) GetImageAvgColor() string {
+// Deprecated: Use BoardRole.ProtoReflect.Descriptor instead.
+func (*BoardRole) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BoardRole) GetRole() string {
 	if x != nil {
-		return x.ImageAvgColor
+		return x.Role
 	}
 	return ""
 }
 
-type SearchInput struct {
+type Section struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KeyWords string `protobuf:"bytes,1,opt,name=keyWords,proto3" json:"keyWords,omitempty"`
-	Interval string `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	SectionID int64  `protobuf:"varint,1,opt,name=sectionID,proto3" json:"sectionID,omitempty"`
+	BoardID   int64  `protobuf:"varint,2,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	Title     string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
 }
 
-func (x *SearchInput) Reset() {
-	*x = SearchInput{}
+func (x *Section) Reset() {
+	*x = Section{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pins_proto_msgTypes[16]
+		mi := &file_pins_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchInput) String() string {
+func (x *Section) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchInput) ProtoMessage() {}
+func (*Section) ProtoMessage() {}
 
-func (x *SearchInput) ProtoReflect() protoreflect.Message {
-	mi := &file_pins_proto_msgTypes[16]
+func (x *Section) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1070,50 +1353,57 @@ func (x *SearchInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchInput.ProtoReflect.Descriptor instead.
-func (*SearchInput) Descriptor() ([]byte, []int) {
-	return file_pins_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use Section.ProtoReflect.Descriptor instead.
+func (*Section) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *SearchInput) GetKeyWords() string {
+func (x *Section) GetSectionID() int64 {
 	if x != nil {
-		return x.KeyWords
+		return x.SectionID
 	}
-	return ""
+	return 0
 }
 
-func (x *SearchInput) GetInterval() string {
+func (x *Section) GetBoardID() int64 {
 	if x != nil {
-		return x.Interval
+		return x.BoardID
+	}
+	return 0
+}
+
+func (x *Section) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-type Number struct {
+type SectionID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Number int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	SectionID int64 `protobuf:"varint,1,opt,name=sectionID,proto3" json:"sectionID,omitempty"`
 }
 
-func (x *Number) Reset() {
-	*x = Number{}
+func (x *SectionID) Reset() {
+	*x = SectionID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pins_proto_msgTypes[17]
+		mi := &file_pins_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Number) String() string {
+func (x *SectionID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Number) ProtoMessage() {}
+func (*SectionID) ProtoMessage() {}
 
-func (x *Number) ProtoReflect() protoreflect.Message {
-	mi := &file_pins_proto_msgTypes[17]
+func (x *SectionID) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1124,44 +1414,43 @@ func (x *Number) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Number.ProtoReflect.Descriptor instead.
-func (*Number) Descriptor() ([]byte, []int) {
-	return file_pins_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SectionID.ProtoReflect.Descriptor instead.
+func (*SectionID) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Number) GetNumber() int64 {
+func (x *SectionID) GetSectionID() int64 {
 	if x != nil {
-		return x.Number
+		return x.SectionID
 	}
 	return 0
 }
 
-type FeedInfo struct {
+type SectionsList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Sections []*Section `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`
 }
 
-func (x *FeedInfo) Reset() {
-	*x = FeedInfo{}
+func (x *SectionsList) Reset() {
+	*x = SectionsList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pins_proto_msgTypes[18]
+		mi := &file_pins_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FeedInfo) String() string {
+func (x *SectionsList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeedInfo) ProtoMessage() {}
+func (*SectionsList) ProtoMessage() {}
 
-func (x *FeedInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pins_proto_msgTypes[18]
+func (x *SectionsList) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1172,50 +1461,45 @@ func (x *FeedInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeedInfo.ProtoReflect.Descriptor instead.
-func (*FeedInfo) Descriptor() ([]byte, []int) {
-	return file_pins_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *FeedInfo) GetOffset() int64 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+// Deprecated: Use SectionsList.ProtoReflect.Descriptor instead.
+func (*SectionsList) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *FeedInfo) GetAmount() int64 {
+func (x *SectionsList) GetSections() []*Section {
 	if x != nil {
-		return x.Amount
+		return x.Sections
 	}
-	return 0
+	return nil
 }
 
-type FilePath struct {
+type PinMove struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ImagePath string `protobuf:"bytes,1,opt,name=imagePath,proto3" json:"imagePath,omitempty"`
+	BoardID   int64 `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	PinID     int64 `protobuf:"varint,2,opt,name=pinID,proto3" json:"pinID,omitempty"`
+	SectionID int64 `protobuf:"varint,3,opt,name=sectionID,proto3" json:"sectionID,omitempty"` // 0 to take pin out of its section
 }
 
-func (x *FilePath) Reset() {
-	*x = FilePath{}
+func (x *PinMove) Reset() {
+	*x = PinMove{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pins_proto_msgTypes[19]
+		mi := &file_pins_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FilePath) String() string {
+func (x *PinMove) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilePath) ProtoMessage() {}
+func (*PinMove) ProtoMessage() {}
 
-func (x *FilePath) ProtoReflect() protoreflect.Message {
-	mi := &file_pins_proto_msgTypes[19]
+func (x *PinMove) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1226,41 +1510,58 @@ func (x *FilePath) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilePath.ProtoReflect.Descriptor instead.
-func (*FilePath) Descriptor() ([]byte, []int) {
-	return file_pins_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use PinMove.ProtoReflect.Descriptor instead.
+func (*PinMove) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *FilePath) GetImagePath() string {
+func (x *PinMove) GetBoardID() int64 {
 	if x != nil {
-		return x.ImagePath
+		return x.BoardID
 	}
-	return ""
+	return 0
 }
 
-type Error struct {
+func (x *PinMove) GetPinID() int64 {
+	if x != nil {
+		return x.PinID
+	}
+	return 0
+}
+
+func (x *PinMove) GetSectionID() int64 {
+	if x != nil {
+		return x.SectionID
+	}
+	return 0
+}
+
+type PinPlacement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PinID     int64 `protobuf:"varint,1,opt,name=pinID,proto3" json:"pinID,omitempty"`
+	SectionID int64 `protobuf:"varint,2,opt,name=sectionID,proto3" json:"sectionID,omitempty"`
 }
 
-func (x *Error) Reset() {
-	*x = Error{}
+func (x *PinPlacement) Reset() {
+	*x = PinPlacement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pins_proto_msgTypes[20]
+		mi := &file_pins_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Error) String() string {
+func (x *PinPlacement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Error) ProtoMessage() {}
+func (*PinPlacement) ProtoMessage() {}
 
-func (x *Error) ProtoReflect() protoreflect.Message {
-	mi := &file_pins_proto_msgTypes[20]
+func (x *PinPlacement) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1271,481 +1572,1163 @@ func (x *Error) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Error.ProtoReflect.Descriptor instead.
-func (*Error) Descriptor() ([]byte, []int) {
-	return file_pins_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use PinPlacement.ProtoReflect.Descriptor instead.
+func (*PinPlacement) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{25}
 }
 
-var File_pins_proto protoreflect.FileDescriptor
+func (x *PinPlacement) GetPinID() int64 {
+	if x != nil {
+		return x.PinID
+	}
+	return 0
+}
 
-var file_pins_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x70, 0x69,
-	0x6e, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xf7, 0x01, 0x0a, 0x05, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x57, 0x69, 0x64, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x41, 0x76, 0x67, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x41, 0x76, 0x67, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0xef, 0x02,
-	0x0a, 0x03, 0x50, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x50, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x42,
-	0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x42, 0x6f,
-	0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a,
-	0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69,
-	0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69,
-	0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c,
-	0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x57, 0x69,
-	0x64, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x41, 0x76,
-	0x67, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x41, 0x76, 0x67, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x3e, 0x0a, 0x0c, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x78, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x50, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x53,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x0a, 0x06, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x1e, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03,
-	0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x23, 0x0a, 0x07, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44,
-	0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x31, 0x0a, 0x0a, 0x42, 0x6f,
-	0x61, 0x72, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e,
-	0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x22, 0x29, 0x0a,
-	0x08, 0x50, 0x69, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x69, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50,
-	0x69, 0x6e, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x22, 0x1d, 0x0a, 0x05, 0x50, 0x69, 0x6e, 0x49,
-	0x44, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x70, 0x69, 0x6e, 0x49, 0x44, 0x22, 0x26, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x22,
-	0x34, 0x0a, 0x04, 0x53, 0x61, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x14, 0x0a, 0x05, 0x70, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x70, 0x69, 0x6e, 0x49, 0x44, 0x22, 0x3e, 0x0a, 0x0a, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4f, 0x77,
-	0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x3c, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x49, 0x6e, 0x42, 0x6f,
-	0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x69,
-	0x6e, 0x49, 0x44, 0x22, 0x56, 0x0a, 0x0b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x12, 0x1e, 0x0a, 0x09, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x44,
-	0x61, 0x74, 0x61, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3d, 0x0a, 0x13, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x08, 0x46,
-	0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49,
-	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
-	0x20, 0x0a, 0x0b, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x57, 0x69, 0x64, 0x74, 0x68, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x57, 0x69, 0x64, 0x74,
-	0x68, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x41, 0x76, 0x67, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x41,
-	0x76, 0x67, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x45, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72,
-	0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x20,
-	0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x22, 0x3a, 0x0a, 0x08, 0x46, 0x65, 0x65, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x28, 0x0a, 0x08,
-	0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x32,
-	0xbf, 0x08, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42,
-	0x6f, 0x61, 0x72, 0x64, 0x1a, 0x0d, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72,
-	0x64, 0x49, 0x44, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72,
-	0x64, 0x12, 0x0d, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44,
-	0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x22, 0x00, 0x12,
-	0x2d, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x12, 0x0c, 0x2e, 0x70,
-	0x69, 0x6e, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x70, 0x69, 0x6e,
-	0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x31,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6f, 0x61,
-	0x72, 0x64, 0x12, 0x0c, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x1a, 0x0d, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22,
-	0x00, 0x12, 0x2b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64,
-	0x12, 0x0d, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x1a,
-	0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x32,
-	0x0a, 0x11, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x41, 0x76, 0x61,
-	0x74, 0x61, 0x72, 0x12, 0x0e, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x22, 0x00, 0x12, 0x25, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x69, 0x6e, 0x12,
-	0x09, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e,
-	0x73, 0x2e, 0x50, 0x69, 0x6e, 0x49, 0x44, 0x22, 0x00, 0x12, 0x29, 0x0a, 0x06, 0x41, 0x64, 0x64,
-	0x50, 0x69, 0x6e, 0x12, 0x10, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x49, 0x6e,
-	0x42, 0x6f, 0x61, 0x72, 0x64, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0x00, 0x12, 0x22, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x12, 0x0b,
-	0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x49, 0x44, 0x1a, 0x09, 0x2e, 0x70, 0x69,
-	0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50,
-	0x69, 0x6e, 0x73, 0x12, 0x0d, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64,
-	0x49, 0x44, 0x1a, 0x0e, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x73, 0x4c, 0x69,
-	0x73, 0x74, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x50,
-	0x69, 0x6e, 0x49, 0x44, 0x12, 0x0c, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x49, 0x44, 0x22,
-	0x00, 0x12, 0x2d, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6f, 0x61, 0x72,
-	0x64, 0x50, 0x69, 0x6e, 0x12, 0x0d, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72,
-	0x64, 0x49, 0x44, 0x1a, 0x09, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x22, 0x00,
-	0x12, 0x33, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x57, 0x69, 0x74,
-	0x68, 0x50, 0x69, 0x6e, 0x12, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x49,
-	0x44, 0x1a, 0x10, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x4c,
-	0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x0b, 0x53, 0x61, 0x76, 0x65, 0x50, 0x69, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x09, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x1a,
-	0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x2c,
-	0x0a, 0x09, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x69, 0x6e, 0x12, 0x10, 0x2e, 0x70, 0x69,
-	0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x49, 0x6e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x1a, 0x0b, 0x2e,
-	0x70, 0x69, 0x6e, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x09,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x69, 0x6e, 0x12, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73,
-	0x2e, 0x50, 0x69, 0x6e, 0x49, 0x44, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0d, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50,
-	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x11, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x1a, 0x19, 0x2e, 0x70, 0x69, 0x6e, 0x73,
-	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x35, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50,
-	0x69, 0x6e, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x0e, 0x2e,
-	0x70, 0x69, 0x6e, 0x73, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0e, 0x2e,
-	0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12,
-	0x31, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x69, 0x6e, 0x73, 0x12, 0x11, 0x2e,
-	0x70, 0x69, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x1a, 0x0e, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74,
-	0x22, 0x00, 0x12, 0x2a, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x52, 0x65, 0x66, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x49, 0x44, 0x1a, 0x0c,
-	0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x00, 0x12, 0x2b,
-	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x2e, 0x70,
-	0x69, 0x6e, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x1a, 0x0b, 0x2e, 0x70,
-	0x69, 0x6e, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0e, 0x47,
-	0x65, 0x74, 0x50, 0x69, 0x6e, 0x73, 0x4f, 0x66, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x10, 0x2e,
-	0x70, 0x69, 0x6e, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x1a,
-	0x0e, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22,
-	0x00, 0x12, 0x2e, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x12, 0x0c, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x1a,
-	0x0e, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x22,
-	0x00, 0x42, 0x04, 0x5a, 0x02, 0x2e, 0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *PinPlacement) GetSectionID() int64 {
+	if x != nil {
+		return x.SectionID
+	}
+	return 0
 }
 
-var (
-	file_pins_proto_rawDescOnce sync.Once
-	file_pins_proto_rawDescData = file_pins_proto_rawDesc
-)
+type BoardOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_pins_proto_rawDescGZIP() []byte {
-	file_pins_proto_rawDescOnce.Do(func() {
-		file_pins_proto_rawDescData = protoimpl.X.CompressGZIP(file_pins_proto_rawDescData)
-	})
-	return file_pins_proto_rawDescData
+	BoardID       int64           `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	LayoutVersion int64           `protobuf:"varint,2,opt,name=layoutVersion,proto3" json:"layoutVersion,omitempty"` // Order is saved only if board was not changed since this version
+	Pins          []*PinPlacement `protobuf:"bytes,3,rep,name=pins,proto3" json:"pins,omitempty"`
+	SectionIDs    []int64         `protobuf:"varint,4,rep,packed,name=sectionIDs,proto3" json:"sectionIDs,omitempty"`
 }
 
-var file_pins_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
-var file_pins_proto_goTypes = []interface{}{
-	(*Board)(nil),               // 0: pins.Board
-	(*Pin)(nil),                 // 1: pins.Pin
-	(*Report)(nil),              // 2: pins.Report
-	(*UserID)(nil),              // 3: pins.UserID
-	(*UserIDList)(nil),          // 4: pins.UserIDList
-	(*BoardID)(nil),             // 5: pins.BoardID
-	(*BoardsList)(nil),          // 6: pins.BoardsList
-	(*PinsList)(nil),            // 7: pins.PinsList
-	(*PinID)(nil),               // 8: pins.PinID
-	(*ReportID)(nil),            // 9: pins.ReportID
-	(*Save)(nil),                // 10: pins.Save
-	(*BoardOwner)(nil),          // 11: pins.BoardOwner
-	(*PinInBoard)(nil),          // 12: pins.PinInBoard
-	(*UploadImage)(nil),         // 13: pins.UploadImage
-	(*UploadImageResponse)(nil), // 14: pins.UploadImageResponse
-	(*FileInfo)(nil),            // 15: pins.FileInfo
-	(*SearchInput)(nil),         // 16: pins.SearchInput
-	(*Number)(nil),              // 17: pins.Number
-	(*FeedInfo)(nil),            // 18: pins.FeedInfo
-	(*FilePath)(nil),            // 19: pins.FilePath
-	(*Error)(nil),               // 20: pins.Error
-	(*timestamp.Timestamp)(nil), // 21: google.protobuf.Timestamp
+func (x *BoardOrder) Reset() {
+	*x = BoardOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pins_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_pins_proto_depIdxs = []int32{
-	21, // 0: pins.Pin.CreationDate:type_name -> google.protobuf.Timestamp
-	0,  // 1: pins.BoardsList.boards:type_name -> pins.Board
-	1,  // 2: pins.PinsList.pins:type_name -> pins.Pin
-	0,  // 3: pins.Pins.CreateBoard:input_type -> pins.Board
-	5,  // 4: pins.Pins.GetBoard:input_type -> pins.BoardID
-	3,  // 5: pins.Pins.GetBoards:input_type -> pins.UserID
-	3,  // 6: pins.Pins.GetInitUserBoard:input_type -> pins.UserID
-	5,  // 7: pins.Pins.DeleteBoard:input_type -> pins.BoardID
-	15, // 8: pins.Pins.UploadBoardAvatar:input_type -> pins.FileInfo
-	1,  // 9: pins.Pins.CreatePin:input_type -> pins.Pin
-	12, // 10: pins.Pins.AddPin:input_type -> pins.PinInBoard
-	8,  // 11: pins.Pins.GetPin:input_type -> pins.PinID
-	5,  // 12: pins.Pins.GetPins:input_type -> pins.BoardID
-	3,  // 13: pins.Pins.GetLastPinID:input_type -> pins.UserID
-	5,  // 14: pins.Pins.GetLastBoardPin:input_type -> pins.BoardID
-	8,  // 15: pins.Pins.GetBoardsWithPin:input_type -> pins.PinID
-	1,  // 16: pins.Pins.SavePicture:input_type -> pins.Pin
-	12, // 17: pins.Pins.RemovePin:input_type -> pins.PinInBoard
-	8,  // 18: pins.Pins.DeletePin:input_type -> pins.PinID
-	13, // 19: pins.Pins.UploadPicture:input_type -> pins.UploadImage
-	18, // 20: pins.Pins.GetPinsWithOffset:input_type -> pins.FeedInfo
-	16, // 21: pins.Pins.SearchPins:input_type -> pins.SearchInput
-	8,  // 22: pins.Pins.PinRefCount:input_type -> pins.PinID
-	19, // 23: pins.Pins.DeleteFile:input_type -> pins.FilePath
-	4,  // 24: pins.Pins.GetPinsOfUsers:input_type -> pins.UserIDList
-	2,  // 25: pins.Pins.CreateReport:input_type -> pins.Report
-	5,  // 26: pins.Pins.CreateBoard:output_type -> pins.BoardID
-	0,  // 27: pins.Pins.GetBoard:output_type -> pins.Board
-	6,  // 28: pins.Pins.GetBoards:output_type -> pins.BoardsList
-	5,  // 29: pins.Pins.GetInitUserBoard:output_type -> pins.BoardID
-	20, // 30: pins.Pins.DeleteBoard:output_type -> pins.Error
-	20, // 31: pins.Pins.UploadBoardAvatar:output_type -> pins.Error
-	8,  // 32: pins.Pins.CreatePin:output_type -> pins.PinID
-	20, // 33: pins.Pins.AddPin:output_type -> pins.Error
-	1,  // 34: pins.Pins.GetPin:output_type -> pins.Pin
-	7,  // 35: pins.Pins.GetPins:output_type -> pins.PinsList
-	8,  // 36: pins.Pins.GetLastPinID:output_type -> pins.PinID
-	1,  // 37: pins.Pins.GetLastBoardPin:output_type -> pins.Pin
-	6,  // 38: pins.Pins.GetBoardsWithPin:output_type -> pins.BoardsList
-	20, // 39: pins.Pins.SavePicture:output_type -> pins.Error
-	20, // 40: pins.Pins.RemovePin:output_type -> pins.Error
-	20, // 41: pins.Pins.DeletePin:output_type -> pins.Error
-	14, // 42: pins.Pins.UploadPicture:output_type -> pins.UploadImageResponse
-	7,  // 43: pins.Pins.GetPinsWithOffset:output_type -> pins.PinsList
-	7,  // 44: pins.Pins.SearchPins:output_type -> pins.PinsList
-	17, // 45: pins.Pins.PinRefCount:output_type -> pins.Number
-	20, // 46: pins.Pins.DeleteFile:output_type -> pins.Error
-	7,  // 47: pins.Pins.GetPinsOfUsers:output_type -> pins.PinsList
-	9,  // 48: pins.Pins.CreateReport:output_type -> pins.ReportID
-	26, // [26:49] is the sub-list for method output_type
-	3,  // [3:26] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+
+func (x *BoardOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_pins_proto_init() }
-func file_pins_proto_init() {
-	if File_pins_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_pins_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Board); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+func (*BoardOrder) ProtoMessage() {}
+
+func (x *BoardOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pins_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pin); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardOrder.ProtoReflect.Descriptor instead.
+func (*BoardOrder) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BoardOrder) GetBoardID() int64 {
+	if x != nil {
+		return x.BoardID
+	}
+	return 0
+}
+
+func (x *BoardOrder) GetLayoutVersion() int64 {
+	if x != nil {
+		return x.LayoutVersion
+	}
+	return 0
+}
+
+func (x *BoardOrder) GetPins() []*PinPlacement {
+	if x != nil {
+		return x.Pins
+	}
+	return nil
+}
+
+func (x *BoardOrder) GetSectionIDs() []int64 {
+	if x != nil {
+		return x.SectionIDs
+	}
+	return nil
+}
+
+type LayoutVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LayoutVersion int64 `protobuf:"varint,1,opt,name=layoutVersion,proto3" json:"layoutVersion,omitempty"`
+}
+
+func (x *LayoutVersion) Reset() {
+	*x = LayoutVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pins_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LayoutVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LayoutVersion) ProtoMessage() {}
+
+func (x *LayoutVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pins_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Report); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LayoutVersion.ProtoReflect.Descriptor instead.
+func (*LayoutVersion) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LayoutVersion) GetLayoutVersion() int64 {
+	if x != nil {
+		return x.LayoutVersion
+	}
+	return 0
+}
+
+type PinInBoard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BoardID int64 `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	PinID   int64 `protobuf:"varint,2,opt,name=pinID,proto3" json:"pinID,omitempty"`
+}
+
+func (x *PinInBoard) Reset() {
+	*x = PinInBoard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pins_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinInBoard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinInBoard) ProtoMessage() {}
+
+func (x *PinInBoard) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pins_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserID); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinInBoard.ProtoReflect.Descriptor instead.
+func (*PinInBoard) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PinInBoard) GetBoardID() int64 {
+	if x != nil {
+		return x.BoardID
+	}
+	return 0
+}
+
+func (x *PinInBoard) GetPinID() int64 {
+	if x != nil {
+		return x.PinID
+	}
+	return 0
+}
+
+type UploadImage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*UploadImage_Extension
+	//	*UploadImage_ChunkData
+	Data isUploadImage_Data `protobuf_oneof:"data"`
+}
+
+func (x *UploadImage) Reset() {
+	*x = UploadImage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pins_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImage) ProtoMessage() {}
+
+func (x *UploadImage) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pins_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserIDList); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pins_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardID); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pins_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardsList); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImage.ProtoReflect.Descriptor instead.
+func (*UploadImage) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{29}
+}
+
+func (m *UploadImage) GetData() isUploadImage_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *UploadImage) GetExtension() string {
+	if x, ok := x.GetData().(*UploadImage_Extension); ok {
+		return x.Extension
+	}
+	return ""
+}
+
+func (x *UploadImage) GetChunkData() []byte {
+	if x, ok := x.GetData().(*UploadImage_ChunkData); ok {
+		return x.ChunkData
+	}
+	return nil
+}
+
+type isUploadImage_Data interface {
+	isUploadImage_Data()
+}
+
+type UploadImage_Extension struct {
+	Extension string `protobuf:"bytes,1,opt,name=Extension,proto3,oneof"`
+}
+
+type UploadImage_ChunkData struct {
+	ChunkData []byte `protobuf:"bytes,2,opt,name=chunk_data,json=chunkData,proto3,oneof"`
+}
+
+func (*UploadImage_Extension) isUploadImage_Data() {}
+
+func (*UploadImage_ChunkData) isUploadImage_Data() {}
+
+type UploadImageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Size uint32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *UploadImageResponse) Reset() {
+	*x = UploadImageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pins_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImageResponse) ProtoMessage() {}
+
+func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pins_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PinsList); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImageResponse.ProtoReflect.Descriptor instead.
+func (*UploadImageResponse) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UploadImageResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *UploadImageResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type FileInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BoardID       int64  `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	ImageLink     string `protobuf:"bytes,2,opt,name=imageLink,proto3" json:"imageLink,omitempty"`
+	ImageHeight   int64  `protobuf:"varint,3,opt,name=imageHeight,proto3" json:"imageHeight,omitempty"`
+	ImageWidth    int64  `protobuf:"varint,4,opt,name=imageWidth,proto3" json:"imageWidth,omitempty"`
+	ImageAvgColor string `protobuf:"bytes,5,opt,name=imageAvgColor,proto3" json:"imageAvgColor,omitempty"`
+}
+
+func (x *FileInfo) Reset() {
+	*x = FileInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pins_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileInfo) ProtoMessage() {}
+
+func (x *FileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pins_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PinID); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
+func (*FileInfo) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FileInfo) GetBoardID() int64 {
+	if x != nil {
+		return x.BoardID
+	}
+	return 0
+}
+
+func (x *FileInfo) GetImageLink() string {
+	if x != nil {
+		return x.ImageLink
+	}
+	return ""
+}
+
+func (x *FileInfo) GetImageHeight() int64 {
+	if x != nil {
+		return x.ImageHeight
+	}
+	return 0
+}
+
+func (x *FileInfo) GetImageWidth() int64 {
+	if x != nil {
+		return x.ImageWidth
+	}
+	return 0
+}
+
+func (x *FileInfo) GetImageAvgColor() string {
+	if x != nil {
+		return x.ImageAvgColor
+	}
+	return ""
+}
+
+type SearchInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyWords    string `protobuf:"bytes,1,opt,name=keyWords,proto3" json:"keyWords,omitempty"`
+	Interval    string `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	RequesterID int64  `protobuf:"varint,3,opt,name=RequesterID,proto3" json:"RequesterID,omitempty"` // Pins of users who blocked requester or were blocked by them are skipped, 0 if requester is not logged in
+}
+
+func (x *SearchInput) Reset() {
+	*x = SearchInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pins_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchInput) ProtoMessage() {}
+
+func (x *SearchInput) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pins_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReportID); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pins_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Save); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pins_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardOwner); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchInput.ProtoReflect.Descriptor instead.
+func (*SearchInput) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SearchInput) GetKeyWords() string {
+	if x != nil {
+		return x.KeyWords
+	}
+	return ""
+}
+
+func (x *SearchInput) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *SearchInput) GetRequesterID() int64 {
+	if x != nil {
+		return x.RequesterID
+	}
+	return 0
+}
+
+type Number struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (x *Number) Reset() {
+	*x = Number{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pins_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Number) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Number) ProtoMessage() {}
+
+func (x *Number) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pins_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PinInBoard); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Number.ProtoReflect.Descriptor instead.
+func (*Number) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Number) GetNumber() int64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+type FeedInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *FeedInfo) Reset() {
+	*x = FeedInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pins_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedInfo) ProtoMessage() {}
+
+func (x *FeedInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pins_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadImage); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedInfo.ProtoReflect.Descriptor instead.
+func (*FeedInfo) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FeedInfo) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *FeedInfo) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type FilePath struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImagePath string `protobuf:"bytes,1,opt,name=imagePath,proto3" json:"imagePath,omitempty"`
+}
+
+func (x *FilePath) Reset() {
+	*x = FilePath{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pins_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilePath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilePath) ProtoMessage() {}
+
+func (x *FilePath) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pins_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadImageResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilePath.ProtoReflect.Descriptor instead.
+func (*FilePath) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *FilePath) GetImagePath() string {
+	if x != nil {
+		return x.ImagePath
+	}
+	return ""
+}
+
+type Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pins_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_pins_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pins_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileInfo); i {
-			case 0:
-				return &v.state
-			case 1:
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_pins_proto_rawDescGZIP(), []int{36}
+}
+
+var File_pins_proto protoreflect.FileDescriptor
+
+var file_pins_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x70, 0x69,
+	0x6e, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x03, 0x0a, 0x05, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x57, 0x69, 0x64, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x41, 0x76, 0x67, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x41, 0x76, 0x67, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1e, 0x0a,
+	0x0a, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a,
+	0x0a, 0x49, 0x73, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x49, 0x73, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x49, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x49, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x4c, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x26, 0x0a, 0x0e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa9, 0x03, 0x0a, 0x03, 0x50, 0x69, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x50, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x50, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a,
+	0x0b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x57, 0x69, 0x64, 0x74, 0x68, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12,
+	0x24, 0x0a, 0x0d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x41, 0x76, 0x67, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x41, 0x76, 0x67,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x3e, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x49, 0x73, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x22, 0x78, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x69, 0x6e,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x50, 0x69, 0x6e, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x0a,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x0c, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x73, 0x4f, 0x66, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x44, 0x22, 0x1e, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03,
+	0x69, 0x64, 0x73, 0x22, 0x23, 0x0a, 0x07, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x4a, 0x0a, 0x0c, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x44, 0x22, 0x31, 0x0a, 0x0a, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52,
+	0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x22, 0x29, 0x0a, 0x08, 0x50, 0x69, 0x6e, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x52, 0x04, 0x70, 0x69,
+	0x6e, 0x73, 0x22, 0x1d, 0x0a, 0x05, 0x50, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x69, 0x6e, 0x49,
+	0x44, 0x22, 0x44, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x70, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x22, 0x26, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x22,
+	0x34, 0x0a, 0x04, 0x53, 0x61, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x70, 0x69, 0x6e, 0x49, 0x44, 0x22, 0x3e, 0x0a, 0x0a, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x3f, 0x0a, 0x0b, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x90, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6c, 0x6c, 0x61,
+	0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x76, 0x61,
+	0x74, 0x61, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x22, 0x4d, 0x0a, 0x11, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x38,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x61,
+	0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x43, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x45, 0x0a,
+	0x0f, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x32, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1f, 0x0a, 0x09, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x6f, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x57, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x29,
+	0x0a, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x39, 0x0a, 0x0c, 0x53, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x08, 0x73, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x69,
+	0x6e, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x57, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x4d, 0x6f, 0x76, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x69, 0x6e,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x69, 0x6e, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x42, 0x0a,
+	0x0c, 0x50, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x69,
+	0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x22, 0x94, 0x01, 0x0a, 0x0a, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x26, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x22, 0x35, 0x0a, 0x0d, 0x4c, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x3c, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x49, 0x6e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x69, 0x6e, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x69, 0x6e, 0x49, 0x44, 0x22, 0x56, 0x0a,
+	0x0b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x09,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x09, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0a,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x48, 0x00, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x42, 0x06, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3d, 0x0a, 0x13, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x57, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x41, 0x76, 0x67, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x41, 0x76, 0x67, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x22, 0x67, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x22, 0x20, 0x0a, 0x06, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x3a, 0x0a, 0x08,
+	0x46, 0x65, 0x65, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x28, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xf8, 0x0f, 0x0a, 0x04,
+	0x50, 0x69, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x12, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x1a, 0x0d, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22,
+	0x00, 0x12, 0x2d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x12, 0x2e,
+	0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x22, 0x00,
+	0x12, 0x33, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x12, 0x12, 0x2e,
+	0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x4f, 0x66, 0x55, 0x73, 0x65,
+	0x72, 0x1a, 0x10, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x69, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x0c, 0x2e, 0x70, 0x69, 0x6e, 0x73,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x0d, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x00, 0x12, 0x29, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x12, 0x0d, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49,
+	0x44, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00,
+	0x12, 0x32, 0x0a, 0x11, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x41,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x0e, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x69, 0x6e,
+	0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x1a, 0x0b,
+	0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x34, 0x0a,
+	0x10, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x11, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x11, 0x2e, 0x70, 0x69, 0x6e, 0x73,
+	0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x1a, 0x0b, 0x2e, 0x70,
+	0x69, 0x6e, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x0d, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x1a, 0x17,
+	0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0c, 0x2e, 0x70, 0x69,
+	0x6e, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x15, 0x2e, 0x70, 0x69, 0x6e, 0x73,
+	0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x22, 0x00, 0x12, 0x34, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x11, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x1a, 0x0f, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x2e, 0x70, 0x69, 0x6e, 0x73,
+	0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0f, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x0d, 0x52,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x2e, 0x70,
+	0x69, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0b, 0x2e, 0x70, 0x69,
+	0x6e, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x0d, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x2e, 0x70, 0x69,
+	0x6e, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e,
+	0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0d, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x1a, 0x12, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x53,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x27, 0x0a,
+	0x07, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x69, 0x6e, 0x12, 0x0d, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e,
+	0x50, 0x69, 0x6e, 0x4d, 0x6f, 0x76, 0x65, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0c, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x10, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x13, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e,
+	0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12,
+	0x2f, 0x0a, 0x0b, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x11,
+	0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00,
+	0x12, 0x31, 0x0a, 0x0d, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x12, 0x11, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x0d, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x69, 0x6e, 0x12, 0x09, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e,
+	0x50, 0x69, 0x6e, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x49, 0x44,
+	0x22, 0x00, 0x12, 0x29, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x50, 0x69, 0x6e, 0x12, 0x10, 0x2e, 0x70,
+	0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x49, 0x6e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x1a, 0x0b,
+	0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x27, 0x0a,
+	0x06, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x12, 0x10, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70, 0x69, 0x6e, 0x73,
+	0x2e, 0x50, 0x69, 0x6e, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e,
+	0x73, 0x12, 0x12, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x61,
+	0x73, 0x74, 0x50, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x0c, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e,
+	0x49, 0x44, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x50, 0x69, 0x6e, 0x12, 0x0d, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x1a, 0x09, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69,
+	0x6e, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73,
+	0x57, 0x69, 0x74, 0x68, 0x50, 0x69, 0x6e, 0x12, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50,
+	0x69, 0x6e, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x0b, 0x53, 0x61, 0x76, 0x65,
+	0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x09, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50,
+	0x69, 0x6e, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x00, 0x12, 0x25, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x6e, 0x12, 0x09,
+	0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x09, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x50, 0x69, 0x6e, 0x12, 0x10, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e,
+	0x49, 0x6e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x69, 0x6e, 0x12, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x49, 0x44,
+	0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12,
+	0x41, 0x0a, 0x0d, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x11, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x1a, 0x19, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x28, 0x01, 0x12, 0x35, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x73, 0x57, 0x69, 0x74,
+	0x68, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x0e, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x46,
+	0x65, 0x65, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0e, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50,
+	0x69, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0a, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x50, 0x69, 0x6e, 0x73, 0x12, 0x11, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x69, 0x6e,
+	0x73, 0x2e, 0x50, 0x69, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x0b,
+	0x50, 0x69, 0x6e, 0x52, 0x65, 0x66, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0b, 0x2e, 0x70, 0x69,
+	0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x49, 0x44, 0x1a, 0x0c, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x1a, 0x0b, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x73,
+	0x4f, 0x66, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x69, 0x6e, 0x73,
+	0x2e, 0x50, 0x69, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x50, 0x69, 0x6e, 0x73, 0x4f, 0x66, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x12, 0x0c, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x1a, 0x0e, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0c, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x69, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x49, 0x44, 0x22, 0x00, 0x42, 0x04, 0x5a, 0x02, 0x2e, 0x2f, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pins_proto_rawDescOnce sync.Once
+	file_pins_proto_rawDescData = file_pins_proto_rawDesc
+)
+
+func file_pins_proto_rawDescGZIP() []byte {
+	file_pins_proto_rawDescOnce.Do(func() {
+		file_pins_proto_rawDescData = protoimpl.X.CompressGZIP(file_pins_proto_rawDescData)
+	})
+	return file_pins_proto_rawDescData
+}
+
+var file_pins_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_pins_proto_goTypes = []interface{}{
+	(*Board)(nil),               // 0: pins.Board
+	(*Pin)(nil),                 // 1: pins.Pin
+	(*Report)(nil),              // 2: pins.Report
+	(*UserID)(nil),              // 3: pins.UserID
+	(*BoardsOfUser)(nil),        // 4: pins.BoardsOfUser
+	(*UserIDList)(nil),          // 5: pins.UserIDList
+	(*BoardID)(nil),             // 6: pins.BoardID
+	(*BoardRequest)(nil),        // 7: pins.BoardRequest
+	(*BoardsList)(nil),          // 8: pins.BoardsList
+	(*PinsList)(nil),            // 9: pins.PinsList
+	(*PinID)(nil),               // 10: pins.PinID
+	(*PinRequest)(nil),          // 11: pins.PinRequest
+	(*ReportID)(nil),            // 12: pins.ReportID
+	(*Save)(nil),                // 13: pins.Save
+	(*BoardOwner)(nil),          // 14: pins.BoardOwner
+	(*BoardMember)(nil),         // 15: pins.BoardMember
+	(*Collaborator)(nil),        // 16: pins.Collaborator
+	(*CollaboratorsList)(nil),   // 17: pins.CollaboratorsList
+	(*Invitation)(nil),          // 18: pins.Invitation
+	(*InvitationsList)(nil),     // 19: pins.InvitationsList
+	(*BoardRole)(nil),           // 20: pins.BoardRole
+	(*Section)(nil),             // 21: pins.Section
+	(*SectionID)(nil),           // 22: pins.SectionID
+	(*SectionsList)(nil),        // 23: pins.SectionsList
+	(*PinMove)(nil),             // 24: pins.PinMove
+	(*PinPlacement)(nil),        // 25: pins.PinPlacement
+	(*BoardOrder)(nil),          // 26: pins.BoardOrder
+	(*LayoutVersion)(nil),       // 27: pins.LayoutVersion
+	(*PinInBoard)(nil),          // 28: pins.PinInBoard
+	(*UploadImage)(nil),         // 29: pins.UploadImage
+	(*UploadImageResponse)(nil), // 30: pins.UploadImageResponse
+	(*FileInfo)(nil),            // 31: pins.FileInfo
+	(*SearchInput)(nil),         // 32: pins.SearchInput
+	(*Number)(nil),              // 33: pins.Number
+	(*FeedInfo)(nil),            // 34: pins.FeedInfo
+	(*FilePath)(nil),            // 35: pins.FilePath
+	(*Error)(nil),               // 36: pins.Error
+	(*timestamp.Timestamp)(nil), // 37: google.protobuf.Timestamp
+}
+var file_pins_proto_depIdxs = []int32{
+	37, // 0: pins.Pin.CreationDate:type_name -> google.protobuf.Timestamp
+	0,  // 1: pins.BoardsList.boards:type_name -> pins.Board
+	1,  // 2: pins.PinsList.pins:type_name -> pins.Pin
+	16, // 3: pins.CollaboratorsList.collaborators:type_name -> pins.Collaborator
+	0,  // 4: pins.Invitation.board:type_name -> pins.Board
+	18, // 5: pins.InvitationsList.invitations:type_name -> pins.Invitation
+	21, // 6: pins.SectionsList.sections:type_name -> pins.Section
+	25, // 7: pins.BoardOrder.pins:type_name -> pins.PinPlacement
+	0,  // 8: pins.Pins.CreateBoard:input_type -> pins.Board
+	7,  // 9: pins.Pins.GetBoard:input_type -> pins.BoardRequest
+	4,  // 10: pins.Pins.GetBoards:input_type -> pins.BoardsOfUser
+	3,  // 11: pins.Pins.GetInitUserBoard:input_type -> pins.UserID
+	0,  // 12: pins.Pins.UpdateBoard:input_type -> pins.Board
+	6,  // 13: pins.Pins.DeleteBoard:input_type -> pins.BoardID
+	31, // 14: pins.Pins.UploadBoardAvatar:input_type -> pins.FileInfo
+	16, // 15: pins.Pins.InviteCollaborator:input_type -> pins.Collaborator
+	15, // 16: pins.Pins.AcceptInvitation:input_type -> pins.BoardMember
+	15, // 17: pins.Pins.RemoveCollaborator:input_type -> pins.BoardMember
+	6,  // 18: pins.Pins.GetCollaborators:input_type -> pins.BoardID
+	3,  // 19: pins.Pins.GetInvitations:input_type -> pins.UserID
+	15, // 20: pins.Pins.GetBoardRole:input_type -> pins.BoardMember
+	21, // 21: pins.Pins.CreateSection:input_type -> pins.Section
+	21, // 22: pins.Pins.RenameSection:input_type -> pins.Section
+	21, // 23: pins.Pins.DeleteSection:input_type -> pins.Section
+	6,  // 24: pins.Pins.GetSections:input_type -> pins.BoardID
+	24, // 25: pins.Pins.MovePin:input_type -> pins.PinMove
+	26, // 26: pins.Pins.ReorderBoard:input_type -> pins.BoardOrder
+	15, // 27: pins.Pins.FollowBoard:input_type -> pins.BoardMember
+	15, // 28: pins.Pins.UnfollowBoard:input_type -> pins.BoardMember
+	6,  // 29: pins.Pins.GetBoardFollowers:input_type -> pins.BoardID
+	1,  // 30: pins.Pins.CreatePin:input_type -> pins.Pin
+	28, // 31: pins.Pins.AddPin:input_type -> pins.PinInBoard
+	11, // 32: pins.Pins.GetPin:input_type -> pins.PinRequest
+	7,  // 33: pins.Pins.GetPins:input_type -> pins.BoardRequest
+	3,  // 34: pins.Pins.GetLastPinID:input_type -> pins.UserID
+	6,  // 35: pins.Pins.GetLastBoardPin:input_type -> pins.BoardID
+	10, // 36: pins.Pins.GetBoardsWithPin:input_type -> pins.PinID
+	1,  // 37: pins.Pins.SavePicture:input_type -> pins.Pin
+	1,  // 38: pins.Pins.UpdatePin:input_type -> pins.Pin
+	28, // 39: pins.Pins.RemovePin:input_type -> pins.PinInBoard
+	10, // 40: pins.Pins.DeletePin:input_type -> pins.PinID
+	29, // 41: pins.Pins.UploadPicture:input_type -> pins.UploadImage
+	34, // 42: pins.Pins.GetPinsWithOffset:input_type -> pins.FeedInfo
+	32, // 43: pins.Pins.SearchPins:input_type -> pins.SearchInput
+	10, // 44: pins.Pins.PinRefCount:input_type -> pins.PinID
+	35, // 45: pins.Pins.DeleteFile:input_type -> pins.FilePath
+	5,  // 46: pins.Pins.GetPinsOfUsers:input_type -> pins.UserIDList
+	3,  // 47: pins.Pins.GetPinsOfFollowedBoards:input_type -> pins.UserID
+	2,  // 48: pins.Pins.CreateReport:input_type -> pins.Report
+	6,  // 49: pins.Pins.CreateBoard:output_type -> pins.BoardID
+	0,  // 50: pins.Pins.GetBoard:output_type -> pins.Board
+	8,  // 51: pins.Pins.GetBoards:output_type -> pins.BoardsList
+	6,  // 52: pins.Pins.GetInitUserBoard:output_type -> pins.BoardID
+	36, // 53: pins.Pins.UpdateBoard:output_type -> pins.Error
+	36, // 54: pins.Pins.DeleteBoard:output_type -> pins.Error
+	36, // 55: pins.Pins.UploadBoardAvatar:output_type -> pins.Error
+	36, // 56: pins.Pins.InviteCollaborator:output_type -> pins.Error
+	36, // 57: pins.Pins.AcceptInvitation:output_type -> pins.Error
+	36, // 58: pins.Pins.RemoveCollaborator:output_type -> pins.Error
+	17, // 59: pins.Pins.GetCollaborators:output_type -> pins.CollaboratorsList
+	19, // 60: pins.Pins.GetInvitations:output_type -> pins.InvitationsList
+	20, // 61: pins.Pins.GetBoardRole:output_type -> pins.BoardRole
+	22, // 62: pins.Pins.CreateSection:output_type -> pins.SectionID
+	36, // 63: pins.Pins.RenameSection:output_type -> pins.Error
+	36, // 64: pins.Pins.DeleteSection:output_type -> pins.Error
+	23, // 65: pins.Pins.GetSections:output_type -> pins.SectionsList
+	36, // 66: pins.Pins.MovePin:output_type -> pins.Error
+	27, // 67: pins.Pins.ReorderBoard:output_type -> pins.LayoutVersion
+	36, // 68: pins.Pins.FollowBoard:output_type -> pins.Error
+	36, // 69: pins.Pins.UnfollowBoard:output_type -> pins.Error
+	5,  // 70: pins.Pins.GetBoardFollowers:output_type -> pins.UserIDList
+	10, // 71: pins.Pins.CreatePin:output_type -> pins.PinID
+	36, // 72: pins.Pins.AddPin:output_type -> pins.Error
+	1,  // 73: pins.Pins.GetPin:output_type -> pins.Pin
+	9,  // 74: pins.Pins.GetPins:output_type -> pins.PinsList
+	10, // 75: pins.Pins.GetLastPinID:output_type -> pins.PinID
+	1,  // 76: pins.Pins.GetLastBoardPin:output_type -> pins.Pin
+	8,  // 77: pins.Pins.GetBoardsWithPin:output_type -> pins.BoardsList
+	36, // 78: pins.Pins.SavePicture:output_type -> pins.Error
+	36, // 79: pins.Pins.UpdatePin:output_type -> pins.Error
+	36, // 80: pins.Pins.RemovePin:output_type -> pins.Error
+	36, // 81: pins.Pins.DeletePin:output_type -> pins.Error
+	30, // 82: pins.Pins.UploadPicture:output_type -> pins.UploadImageResponse
+	9,  // 83: pins.Pins.GetPinsWithOffset:output_type -> pins.PinsList
+	9,  // 84: pins.Pins.SearchPins:output_type -> pins.PinsList
+	33, // 85: pins.Pins.PinRefCount:output_type -> pins.Number
+	36, // 86: pins.Pins.DeleteFile:output_type -> pins.Error
+	9,  // 87: pins.Pins.GetPinsOfUsers:output_type -> pins.PinsList
+	9,  // 88: pins.Pins.GetPinsOfFollowedBoards:output_type -> pins.PinsList
+	12, // 89: pins.Pins.CreateReport:output_type -> pins.ReportID
+	49, // [49:90] is the sub-list for method output_type
+	8,  // [8:49] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_pins_proto_init() }
+func file_pins_proto_init() {
+	if File_pins_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pins_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Board); i {
+			case 0:
+				return &v.state
+			case 1:
 				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
@@ -1753,8 +2736,8 @@ func file_pins_proto_init() {
 				return nil
 			}
 		}
-		file_pins_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchInput); i {
+		file_pins_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1765,8 +2748,8 @@ func file_pins_proto_init() {
 				return nil
 			}
 		}
-		file_pins_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Number); i {
+		file_pins_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1777,8 +2760,8 @@ func file_pins_proto_init() {
 				return nil
 			}
 		}
-		file_pins_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeedInfo); i {
+		file_pins_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1789,8 +2772,8 @@ func file_pins_proto_init() {
 				return nil
 			}
 		}
-		file_pins_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilePath); i {
+		file_pins_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardsOfUser); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1801,8 +2784,164 @@ func file_pins_proto_init() {
 				return nil
 			}
 		}
-		file_pins_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Error); i {
+		file_pins_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserIDList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardsList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinsList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Save); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardOwner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Collaborator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollaboratorsList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invitation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1813,517 +2952,1273 @@ func file_pins_proto_init() {
 				return nil
 			}
 		}
+		file_pins_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvitationsList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Section); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SectionID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SectionsList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinMove); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinPlacement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LayoutVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinInBoard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadImage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadImageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Number); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeedInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilePath); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pins_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pins_proto_msgTypes[29].OneofWrappers = []interface{}{
+		(*UploadImage_Extension)(nil),
+		(*UploadImage_ChunkData)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pins_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   37,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pins_proto_goTypes,
+		DependencyIndexes: file_pins_proto_depIdxs,
+		MessageInfos:      file_pins_proto_msgTypes,
+	}.Build()
+	File_pins_proto = out.File
+	file_pins_proto_rawDesc = nil
+	file_pins_proto_goTypes = nil
+	file_pins_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// PinsClient is the client API for Pins service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PinsClient interface {
+	CreateBoard(ctx context.Context, in *Board, opts ...grpc.CallOption) (*BoardID, error)
+	GetBoard(ctx context.Context, in *BoardRequest, opts ...grpc.CallOption) (*Board, error)
+	GetBoards(ctx context.Context, in *BoardsOfUser, opts ...grpc.CallOption) (*BoardsList, error)
+	GetInitUserBoard(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*BoardID, error)
+	UpdateBoard(ctx context.Context, in *Board, opts ...grpc.CallOption) (*Error, error)
+	DeleteBoard(ctx context.Context, in *BoardID, opts ...grpc.CallOption) (*Error, error)
+	UploadBoardAvatar(ctx context.Context, in *FileInfo, opts ...grpc.CallOption) (*Error, error)
+	InviteCollaborator(ctx context.Context, in *Collaborator, opts ...grpc.CallOption) (*Error, error)
+	AcceptInvitation(ctx context.Context, in *BoardMember, opts ...grpc.CallOption) (*Error, error)
+	RemoveCollaborator(ctx context.Context, in *BoardMember, opts ...grpc.CallOption) (*Error, error)
+	GetCollaborators(ctx context.Context, in *BoardID, opts ...grpc.CallOption) (*CollaboratorsList, error)
+	GetInvitations(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*InvitationsList, error)
+	GetBoardRole(ctx context.Context, in *BoardMember, opts ...grpc.CallOption) (*BoardRole, error)
+	CreateSection(ctx context.Context, in *Section, opts ...grpc.CallOption) (*SectionID, error)
+	RenameSection(ctx context.Context, in *Section, opts ...grpc.CallOption) (*Error, error)
+	DeleteSection(ctx context.Context, in *Section, opts ...grpc.CallOption) (*Error, error)
+	GetSections(ctx context.Context, in *BoardID, opts ...grpc.CallOption) (*SectionsList, error)
+	MovePin(ctx context.Context, in *PinMove, opts ...grpc.CallOption) (*Error, error)
+	ReorderBoard(ctx context.Context, in *BoardOrder, opts ...grpc.CallOption) (*LayoutVersion, error)
+	FollowBoard(ctx context.Context, in *BoardMember, opts ...grpc.CallOption) (*Error, error)
+	UnfollowBoard(ctx context.Context, in *BoardMember, opts ...grpc.CallOption) (*Error, error)
+	GetBoardFollowers(ctx context.Context, in *BoardID, opts ...grpc.CallOption) (*UserIDList, error)
+	CreatePin(ctx context.Context, in *Pin, opts ...grpc.CallOption) (*PinID, error)
+	AddPin(ctx context.Context, in *PinInBoard, opts ...grpc.CallOption) (*Error, error)
+	GetPin(ctx context.Context, in *PinRequest, opts ...grpc.CallOption) (*Pin, error)
+	GetPins(ctx context.Context, in *BoardRequest, opts ...grpc.CallOption) (*PinsList, error)
+	GetLastPinID(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*PinID, error)
+	GetLastBoardPin(ctx context.Context, in *BoardID, opts ...grpc.CallOption) (*Pin, error)
+	GetBoardsWithPin(ctx context.Context, in *PinID, opts ...grpc.CallOption) (*BoardsList, error)
+	SavePicture(ctx context.Context, in *Pin, opts ...grpc.CallOption) (*Error, error)
+	UpdatePin(ctx context.Context, in *Pin, opts ...grpc.CallOption) (*Error, error)
+	RemovePin(ctx context.Context, in *PinInBoard, opts ...grpc.CallOption) (*Error, error)
+	DeletePin(ctx context.Context, in *PinID, opts ...grpc.CallOption) (*Error, error)
+	UploadPicture(ctx context.Context, opts ...grpc.CallOption) (Pins_UploadPictureClient, error)
+	GetPinsWithOffset(ctx context.Context, in *FeedInfo, opts ...grpc.CallOption) (*PinsList, error)
+	SearchPins(ctx context.Context, in *SearchInput, opts ...grpc.CallOption) (*PinsList, error)
+	PinRefCount(ctx context.Context, in *PinID, opts ...grpc.CallOption) (*Number, error)
+	DeleteFile(ctx context.Context, in *FilePath, opts ...grpc.CallOption) (*Error, error)
+	GetPinsOfUsers(ctx context.Context, in *UserIDList, opts ...grpc.CallOption) (*PinsList, error)
+	GetPinsOfFollowedBoards(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*PinsList, error)
+	CreateReport(ctx context.Context, in *Report, opts ...grpc.CallOption) (*ReportID, error)
+}
+
+type pinsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPinsClient(cc grpc.ClientConnInterface) PinsClient {
+	return &pinsClient{cc}
+}
+
+func (c *pinsClient) CreateBoard(ctx context.Context, in *Board, opts ...grpc.CallOption) (*BoardID, error) {
+	out := new(BoardID)
+	err := c.cc.Invoke(ctx, "/pins.Pins/CreateBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) GetBoard(ctx context.Context, in *BoardRequest, opts ...grpc.CallOption) (*Board, error) {
+	out := new(Board)
+	err := c.cc.Invoke(ctx, "/pins.Pins/GetBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) GetBoards(ctx context.Context, in *BoardsOfUser, opts ...grpc.CallOption) (*BoardsList, error) {
+	out := new(BoardsList)
+	err := c.cc.Invoke(ctx, "/pins.Pins/GetBoards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) GetInitUserBoard(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*BoardID, error) {
+	out := new(BoardID)
+	err := c.cc.Invoke(ctx, "/pins.Pins/GetInitUserBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) UpdateBoard(ctx context.Context, in *Board, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/pins.Pins/UpdateBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) DeleteBoard(ctx context.Context, in *BoardID, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/pins.Pins/DeleteBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) UploadBoardAvatar(ctx context.Context, in *FileInfo, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/pins.Pins/UploadBoardAvatar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) InviteCollaborator(ctx context.Context, in *Collaborator, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/pins.Pins/InviteCollaborator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) AcceptInvitation(ctx context.Context, in *BoardMember, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/pins.Pins/AcceptInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) RemoveCollaborator(ctx context.Context, in *BoardMember, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/pins.Pins/RemoveCollaborator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) GetCollaborators(ctx context.Context, in *BoardID, opts ...grpc.CallOption) (*CollaboratorsList, error) {
+	out := new(CollaboratorsList)
+	err := c.cc.Invoke(ctx, "/pins.Pins/GetCollaborators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) GetInvitations(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*InvitationsList, error) {
+	out := new(InvitationsList)
+	err := c.cc.Invoke(ctx, "/pins.Pins/GetInvitations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) GetBoardRole(ctx context.Context, in *BoardMember, opts ...grpc.CallOption) (*BoardRole, error) {
+	out := new(BoardRole)
+	err := c.cc.Invoke(ctx, "/pins.Pins/GetBoardRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) CreateSection(ctx context.Context, in *Section, opts ...grpc.CallOption) (*SectionID, error) {
+	out := new(SectionID)
+	err := c.cc.Invoke(ctx, "/pins.Pins/CreateSection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) RenameSection(ctx context.Context, in *Section, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/pins.Pins/RenameSection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) DeleteSection(ctx context.Context, in *Section, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/pins.Pins/DeleteSection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) GetSections(ctx context.Context, in *BoardID, opts ...grpc.CallOption) (*SectionsList, error) {
+	out := new(SectionsList)
+	err := c.cc.Invoke(ctx, "/pins.Pins/GetSections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) MovePin(ctx context.Context, in *PinMove, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/pins.Pins/MovePin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) ReorderBoard(ctx context.Context, in *BoardOrder, opts ...grpc.CallOption) (*LayoutVersion, error) {
+	out := new(LayoutVersion)
+	err := c.cc.Invoke(ctx, "/pins.Pins/ReorderBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) FollowBoard(ctx context.Context, in *BoardMember, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/pins.Pins/FollowBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) UnfollowBoard(ctx context.Context, in *BoardMember, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/pins.Pins/UnfollowBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) GetBoardFollowers(ctx context.Context, in *BoardID, opts ...grpc.CallOption) (*UserIDList, error) {
+	out := new(UserIDList)
+	err := c.cc.Invoke(ctx, "/pins.Pins/GetBoardFollowers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) CreatePin(ctx context.Context, in *Pin, opts ...grpc.CallOption) (*PinID, error) {
+	out := new(PinID)
+	err := c.cc.Invoke(ctx, "/pins.Pins/CreatePin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) AddPin(ctx context.Context, in *PinInBoard, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/pins.Pins/AddPin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) GetPin(ctx context.Context, in *PinRequest, opts ...grpc.CallOption) (*Pin, error) {
+	out := new(Pin)
+	err := c.cc.Invoke(ctx, "/pins.Pins/GetPin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) GetPins(ctx context.Context, in *BoardRequest, opts ...grpc.CallOption) (*PinsList, error) {
+	out := new(PinsList)
+	err := c.cc.Invoke(ctx, "/pins.Pins/GetPins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) GetLastPinID(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*PinID, error) {
+	out := new(PinID)
+	err := c.cc.Invoke(ctx, "/pins.Pins/GetLastPinID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) GetLastBoardPin(ctx context.Context, in *BoardID, opts ...grpc.CallOption) (*Pin, error) {
+	out := new(Pin)
+	err := c.cc.Invoke(ctx, "/pins.Pins/GetLastBoardPin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) GetBoardsWithPin(ctx context.Context, in *PinID, opts ...grpc.CallOption) (*BoardsList, error) {
+	out := new(BoardsList)
+	err := c.cc.Invoke(ctx, "/pins.Pins/GetBoardsWithPin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) SavePicture(ctx context.Context, in *Pin, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/pins.Pins/SavePicture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) UpdatePin(ctx context.Context, in *Pin, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/pins.Pins/UpdatePin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) RemovePin(ctx context.Context, in *PinInBoard, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/pins.Pins/RemovePin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	file_pins_proto_msgTypes[13].OneofWrappers = []interface{}{
-		(*UploadImage_Extension)(nil),
-		(*UploadImage_ChunkData)(nil),
+	return out, nil
+}
+
+func (c *pinsClient) DeletePin(ctx context.Context, in *PinID, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/pins.Pins/DeletePin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_pins_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   21,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_pins_proto_goTypes,
-		DependencyIndexes: file_pins_proto_depIdxs,
-		MessageInfos:      file_pins_proto_msgTypes,
-	}.Build()
-	File_pins_proto = out.File
-	file_pins_proto_rawDesc = nil
-	file_pins_proto_goTypes = nil
-	file_pins_proto_depIdxs = nil
+	return out, nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func (c *pinsClient) UploadPicture(ctx context.Context, opts ...grpc.CallOption) (Pins_UploadPictureClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Pins_serviceDesc.Streams[0], "/pins.Pins/UploadPicture", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pinsUploadPictureClient{stream}
+	return x, nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+type Pins_UploadPictureClient interface {
+	Send(*UploadImage) error
+	CloseAndRecv() (*UploadImageResponse, error)
+	grpc.ClientStream
+}
 
-// PinsClient is the client API for Pins service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type PinsClient interface {
-	CreateBoard(ctx context.Context, in *Board, opts ...grpc.CallOption) (*BoardID, error)
-	GetBoard(ctx context.Context, in *BoardID, opts ...grpc.CallOption) (*Board, error)
-	GetBoards(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*BoardsList, error)
-	GetInitUserBoard(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*BoardID, error)
-	DeleteBoard(ctx context.Context, in *BoardID, opts ...grpc.CallOption) (*Error, error)
-	UploadBoardAvatar(ctx context.Context, in *FileInfo, opts ...grpc.CallOption) (*Error, error)
-	CreatePin(ctx context.Context, in *Pin, opts ...grpc.CallOption) (*PinID, error)
-	AddPin(ctx context.Context, in *PinInBoard, opts ...grpc.CallOption) (*Error, error)
-	GetPin(ctx context.Context, in *PinID, opts ...grpc.CallOption) (*Pin, error)
-	GetPins(ctx context.Context, in *BoardID, opts ...grpc.CallOption) (*PinsList, error)
-	GetLastPinID(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*PinID, error)
-	GetLastBoardPin(ctx context.Context, in *BoardID, opts ...grpc.CallOption) (*Pin, error)
-	GetBoardsWithPin(ctx context.Context, in *PinID, opts ...grpc.CallOption) (*BoardsList, error)
-	SavePicture(ctx context.Context, in *Pin, opts ...grpc.CallOption) (*Error, error)
-	RemovePin(ctx context.Context, in *PinInBoard, opts ...grpc.CallOption) (*Error, error)
-	DeletePin(ctx context.Context, in *PinID, opts ...grpc.CallOption) (*Error, error)
-	UploadPicture(ctx context.Context, opts ...grpc.CallOption) (Pins_UploadPictureClient, error)
-	GetPinsWithOffset(ctx context.Context, in *FeedInfo, opts ...grpc.CallOption) (*PinsList, error)
-	SearchPins(ctx context.Context, in *SearchInput, opts ...grpc.CallOption) (*PinsList, error)
-	PinRefCount(ctx context.Context, in *PinID, opts ...grpc.CallOption) (*Number, error)
-	DeleteFile(ctx context.Context, in *FilePath, opts ...grpc.CallOption) (*Error, error)
-	GetPinsOfUsers(ctx context.Context, in *UserIDList, opts ...grpc.CallOption) (*PinsList, error)
-	CreateReport(ctx context.Context, in *Report, opts ...grpc.CallOption) (*ReportID, error)
+type pinsUploadPictureClient struct {
+	grpc.ClientStream
 }
 
-type pinsClient struct {
-	cc grpc.ClientConnInterface
+func (x *pinsUploadPictureClient) Send(m *UploadImage) error {
+	return x.ClientStream.SendMsg(m)
 }
 
-func NewPinsClient(cc grpc.ClientConnInterface) PinsClient {
-	return &pinsClient{cc}
+func (x *pinsUploadPictureClient) CloseAndRecv() (*UploadImageResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadImageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (c *pinsClient) CreateBoard(ctx context.Context, in *Board, opts ...grpc.CallOption) (*BoardID, error) {
-	out := new(BoardID)
-	err := c.cc.Invoke(ctx, "/pins.Pins/CreateBoard", in, out, opts...)
+func (c *pinsClient) GetPinsWithOffset(ctx context.Context, in *FeedInfo, opts ...grpc.CallOption) (*PinsList, error) {
+	out := new(PinsList)
+	err := c.cc.Invoke(ctx, "/pins.Pins/GetPinsWithOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) SearchPins(ctx context.Context, in *SearchInput, opts ...grpc.CallOption) (*PinsList, error) {
+	out := new(PinsList)
+	err := c.cc.Invoke(ctx, "/pins.Pins/SearchPins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) PinRefCount(ctx context.Context, in *PinID, opts ...grpc.CallOption) (*Number, error) {
+	out := new(Number)
+	err := c.cc.Invoke(ctx, "/pins.Pins/PinRefCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) DeleteFile(ctx context.Context, in *FilePath, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/pins.Pins/DeleteFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) GetPinsOfUsers(ctx context.Context, in *UserIDList, opts ...grpc.CallOption) (*PinsList, error) {
+	out := new(PinsList)
+	err := c.cc.Invoke(ctx, "/pins.Pins/GetPinsOfUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) GetPinsOfFollowedBoards(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*PinsList, error) {
+	out := new(PinsList)
+	err := c.cc.Invoke(ctx, "/pins.Pins/GetPinsOfFollowedBoards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinsClient) CreateReport(ctx context.Context, in *Report, opts ...grpc.CallOption) (*ReportID, error) {
+	out := new(ReportID)
+	err := c.cc.Invoke(ctx, "/pins.Pins/CreateReport", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *pinsClient) GetBoard(ctx context.Context, in *BoardID, opts ...grpc.CallOption) (*Board, error) {
-	out := new(Board)
-	err := c.cc.Invoke(ctx, "/pins.Pins/GetBoard", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// PinsServer is the server API for Pins service.
+type PinsServer interface {
+	CreateBoard(context.Context, *Board) (*BoardID, error)
+	GetBoard(context.Context, *BoardRequest) (*Board, error)
+	GetBoards(context.Context, *BoardsOfUser) (*BoardsList, error)
+	GetInitUserBoard(context.Context, *UserID) (*BoardID, error)
+	UpdateBoard(context.Context, *Board) (*Error, error)
+	DeleteBoard(context.Context, *BoardID) (*Error, error)
+	UploadBoardAvatar(context.Context, *FileInfo) (*Error, error)
+	InviteCollaborator(context.Context, *Collaborator) (*Error, error)
+	AcceptInvitation(context.Context, *BoardMember) (*Error, error)
+	RemoveCollaborator(context.Context, *BoardMember) (*Error, error)
+	GetCollaborators(context.Context, *BoardID) (*CollaboratorsList, error)
+	GetInvitations(context.Context, *UserID) (*InvitationsList, error)
+	GetBoardRole(context.Context, *BoardMember) (*BoardRole, error)
+	CreateSection(context.Context, *Section) (*SectionID, error)
+	RenameSection(context.Context, *Section) (*Error, error)
+	DeleteSection(context.Context, *Section) (*Error, error)
+	GetSections(context.Context, *BoardID) (*SectionsList, error)
+	MovePin(context.Context, *PinMove) (*Error, error)
+	ReorderBoard(context.Context, *BoardOrder) (*LayoutVersion, error)
+	FollowBoard(context.Context, *BoardMember) (*Error, error)
+	UnfollowBoard(context.Context, *BoardMember) (*Error, error)
+	GetBoardFollowers(context.Context, *BoardID) (*UserIDList, error)
+	CreatePin(context.Context, *Pin) (*PinID, error)
+	AddPin(context.Context, *PinInBoard) (*Error, error)
+	GetPin(context.Context, *PinRequest) (*Pin, error)
+	GetPins(context.Context, *BoardRequest) (*PinsList, error)
+	GetLastPinID(context.Context, *UserID) (*PinID, error)
+	GetLastBoardPin(context.Context, *BoardID) (*Pin, error)
+	GetBoardsWithPin(context.Context, *PinID) (*BoardsList, error)
+	SavePicture(context.Context, *Pin) (*Error, error)
+	UpdatePin(context.Context, *Pin) (*Error, error)
+	RemovePin(context.Context, *PinInBoard) (*Error, error)
+	DeletePin(context.Context, *PinID) (*Error, error)
+	UploadPicture(Pins_UploadPictureServer) error
+	GetPinsWithOffset(context.Context, *FeedInfo) (*PinsList, error)
+	SearchPins(context.Context, *SearchInput) (*PinsList, error)
+	PinRefCount(context.Context, *PinID) (*Number, error)
+	DeleteFile(context.Context, *FilePath) (*Error, error)
+	GetPinsOfUsers(context.Context, *UserIDList) (*PinsList, error)
+	GetPinsOfFollowedBoards(context.Context, *UserID) (*PinsList, error)
+	CreateReport(context.Context, *Report) (*ReportID, error)
+}
+
+// UnimplementedPinsServer can be embedded to have forward compatible implementations.
+type UnimplementedPinsServer struct {
+}
+
+func (*UnimplementedPinsServer) CreateBoard(context.Context, *Board) (*BoardID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBoard not implemented")
+}
+func (*UnimplementedPinsServer) GetBoard(context.Context, *BoardRequest) (*Board, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoard not implemented")
+}
+func (*UnimplementedPinsServer) GetBoards(context.Context, *BoardsOfUser) (*BoardsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoards not implemented")
+}
+func (*UnimplementedPinsServer) GetInitUserBoard(context.Context, *UserID) (*BoardID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInitUserBoard not implemented")
+}
+func (*UnimplementedPinsServer) UpdateBoard(context.Context, *Board) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBoard not implemented")
+}
+func (*UnimplementedPinsServer) DeleteBoard(context.Context, *BoardID) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBoard not implemented")
+}
+func (*UnimplementedPinsServer) UploadBoardAvatar(context.Context, *FileInfo) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadBoardAvatar not implemented")
+}
+func (*UnimplementedPinsServer) InviteCollaborator(context.Context, *Collaborator) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteCollaborator not implemented")
+}
+func (*UnimplementedPinsServer) AcceptInvitation(context.Context, *BoardMember) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvitation not implemented")
+}
+func (*UnimplementedPinsServer) RemoveCollaborator(context.Context, *BoardMember) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollaborator not implemented")
+}
+func (*UnimplementedPinsServer) GetCollaborators(context.Context, *BoardID) (*CollaboratorsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollaborators not implemented")
+}
+func (*UnimplementedPinsServer) GetInvitations(context.Context, *UserID) (*InvitationsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvitations not implemented")
+}
+func (*UnimplementedPinsServer) GetBoardRole(context.Context, *BoardMember) (*BoardRole, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoardRole not implemented")
+}
+func (*UnimplementedPinsServer) CreateSection(context.Context, *Section) (*SectionID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSection not implemented")
+}
+func (*UnimplementedPinsServer) RenameSection(context.Context, *Section) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameSection not implemented")
+}
+func (*UnimplementedPinsServer) DeleteSection(context.Context, *Section) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSection not implemented")
+}
+func (*UnimplementedPinsServer) GetSections(context.Context, *BoardID) (*SectionsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSections not implemented")
+}
+func (*UnimplementedPinsServer) MovePin(context.Context, *PinMove) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MovePin not implemented")
+}
+func (*UnimplementedPinsServer) ReorderBoard(context.Context, *BoardOrder) (*LayoutVersion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderBoard not implemented")
+}
+func (*UnimplementedPinsServer) FollowBoard(context.Context, *BoardMember) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowBoard not implemented")
+}
+func (*UnimplementedPinsServer) UnfollowBoard(context.Context, *BoardMember) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowBoard not implemented")
+}
+func (*UnimplementedPinsServer) GetBoardFollowers(context.Context, *BoardID) (*UserIDList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoardFollowers not implemented")
+}
+func (*UnimplementedPinsServer) CreatePin(context.Context, *Pin) (*PinID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePin not implemented")
+}
+func (*UnimplementedPinsServer) AddPin(context.Context, *PinInBoard) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPin not implemented")
+}
+func (*UnimplementedPinsServer) GetPin(context.Context, *PinRequest) (*Pin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPin not implemented")
+}
+func (*UnimplementedPinsServer) GetPins(context.Context, *BoardRequest) (*PinsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPins not implemented")
+}
+func (*UnimplementedPinsServer) GetLastPinID(context.Context, *UserID) (*PinID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLastPinID not implemented")
+}
+func (*UnimplementedPinsServer) GetLastBoardPin(context.Context, *BoardID) (*Pin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLastBoardPin not implemented")
+}
+func (*UnimplementedPinsServer) GetBoardsWithPin(context.Context, *PinID) (*BoardsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoardsWithPin not implemented")
+}
+func (*UnimplementedPinsServer) SavePicture(context.Context, *Pin) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SavePicture not implemented")
+}
+func (*UnimplementedPinsServer) UpdatePin(context.Context, *Pin) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePin not implemented")
+}
+func (*UnimplementedPinsServer) RemovePin(context.Context, *PinInBoard) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePin not implemented")
+}
+func (*UnimplementedPinsServer) DeletePin(context.Context, *PinID) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePin not implemented")
+}
+func (*UnimplementedPinsServer) UploadPicture(Pins_UploadPictureServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadPicture not implemented")
+}
+func (*UnimplementedPinsServer) GetPinsWithOffset(context.Context, *FeedInfo) (*PinsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPinsWithOffset not implemented")
+}
+func (*UnimplementedPinsServer) SearchPins(context.Context, *SearchInput) (*PinsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPins not implemented")
+}
+func (*UnimplementedPinsServer) PinRefCount(context.Context, *PinID) (*Number, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinRefCount not implemented")
+}
+func (*UnimplementedPinsServer) DeleteFile(context.Context, *FilePath) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFile not implemented")
+}
+func (*UnimplementedPinsServer) GetPinsOfUsers(context.Context, *UserIDList) (*PinsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPinsOfUsers not implemented")
+}
+func (*UnimplementedPinsServer) GetPinsOfFollowedBoards(context.Context, *UserID) (*PinsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPinsOfFollowedBoards not implemented")
+}
+func (*UnimplementedPinsServer) CreateReport(context.Context, *Report) (*ReportID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReport not implemented")
+}
+
+func RegisterPinsServer(s *grpc.Server, srv PinsServer) {
+	s.RegisterService(&_Pins_serviceDesc, srv)
 }
 
-func (c *pinsClient) GetBoards(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*BoardsList, error) {
-	out := new(BoardsList)
-	err := c.cc.Invoke(ctx, "/pins.Pins/GetBoards", in, out, opts...)
-	if err != nil {
+func _Pins_CreateBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Board)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *pinsClient) GetInitUserBoard(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*BoardID, error) {
-	out := new(BoardID)
-	err := c.cc.Invoke(ctx, "/pins.Pins/GetInitUserBoard", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(PinsServer).CreateBoard(ctx, in)
 	}
-	return out, nil
-}
-
-func (c *pinsClient) DeleteBoard(ctx context.Context, in *BoardID, opts ...grpc.CallOption) (*Error, error) {
-	out := new(Error)
-	err := c.cc.Invoke(ctx, "/pins.Pins/DeleteBoard", in, out, opts...)
-	if err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pins.Pins/CreateBoard",
 	}
-	return out, nil
-}
-
-func (c *pinsClient) UploadBoardAvatar(ctx context.Context, in *FileInfo, opts ...grpc.CallOption) (*Error, error) {
-	out := new(Error)
-	err := c.cc.Invoke(ctx, "/pins.Pins/UploadBoardAvatar", in, out, opts...)
-	if err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinsServer).CreateBoard(ctx, req.(*Board))
 	}
-	return out, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pinsClient) CreatePin(ctx context.Context, in *Pin, opts ...grpc.CallOption) (*PinID, error) {
-	out := new(PinID)
-	err := c.cc.Invoke(ctx, "/pins.Pins/CreatePin", in, out, opts...)
-	if err != nil {
+func _Pins_GetBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *pinsClient) AddPin(ctx context.Context, in *PinInBoard, opts ...grpc.CallOption) (*Error, error) {
-	out := new(Error)
-	err := c.cc.Invoke(ctx, "/pins.Pins/AddPin", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(PinsServer).GetBoard(ctx, in)
 	}
-	return out, nil
-}
-
-func (c *pinsClient) GetPin(ctx context.Context, in *PinID, opts ...grpc.CallOption) (*Pin, error) {
-	out := new(Pin)
-	err := c.cc.Invoke(ctx, "/pins.Pins/GetPin", in, out, opts...)
-	if err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pins.Pins/GetBoard",
 	}
-	return out, nil
-}
-
-func (c *pinsClient) GetPins(ctx context.Context, in *BoardID, opts ...grpc.CallOption) (*PinsList, error) {
-	out := new(PinsList)
-	err := c.cc.Invoke(ctx, "/pins.Pins/GetPins", in, out, opts...)
-	if err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinsServer).GetBoard(ctx, req.(*BoardRequest))
 	}
-	return out, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pinsClient) GetLastPinID(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*PinID, error) {
-	out := new(PinID)
-	err := c.cc.Invoke(ctx, "/pins.Pins/GetLastPinID", in, out, opts...)
-	if err != nil {
+func _Pins_GetBoards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardsOfUser)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *pinsClient) GetLastBoardPin(ctx context.Context, in *BoardID, opts ...grpc.CallOption) (*Pin, error) {
-	out := new(Pin)
-	err := c.cc.Invoke(ctx, "/pins.Pins/GetLastBoardPin", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(PinsServer).GetBoards(ctx, in)
 	}
-	return out, nil
-}
-
-func (c *pinsClient) GetBoardsWithPin(ctx context.Context, in *PinID, opts ...grpc.CallOption) (*BoardsList, error) {
-	out := new(BoardsList)
-	err := c.cc.Invoke(ctx, "/pins.Pins/GetBoardsWithPin", in, out, opts...)
-	if err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pins.Pins/GetBoards",
 	}
-	return out, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinsServer).GetBoards(ctx, req.(*BoardsOfUser))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pinsClient) SavePicture(ctx context.Context, in *Pin, opts ...grpc.CallOption) (*Error, error) {
-	out := new(Error)
-	err := c.cc.Invoke(ctx, "/pins.Pins/SavePicture", in, out, opts...)
-	if err != nil {
+func _Pins_GetInitUserBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PinsServer).GetInitUserBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pins.Pins/GetInitUserBoard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinsServer).GetInitUserBoard(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pinsClient) RemovePin(ctx context.Context, in *PinInBoard, opts ...grpc.CallOption) (*Error, error) {
-	out := new(Error)
-	err := c.cc.Invoke(ctx, "/pins.Pins/RemovePin", in, out, opts...)
-	if err != nil {
+func _Pins_UpdateBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Board)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PinsServer).UpdateBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pins.Pins/UpdateBoard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinsServer).UpdateBoard(ctx, req.(*Board))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pinsClient) DeletePin(ctx context.Context, in *PinID, opts ...grpc.CallOption) (*Error, error) {
-	out := new(Error)
-	err := c.cc.Invoke(ctx, "/pins.Pins/DeletePin", in, out, opts...)
-	if err != nil {
+func _Pins_DeleteBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardID)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PinsServer).DeleteBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pins.Pins/DeleteBoard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinsServer).DeleteBoard(ctx, req.(*BoardID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pinsClient) UploadPicture(ctx context.Context, opts ...grpc.CallOption) (Pins_UploadPictureClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Pins_serviceDesc.Streams[0], "/pins.Pins/UploadPicture", opts...)
-	if err != nil {
+func _Pins_UploadBoardAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileInfo)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &pinsUploadPictureClient{stream}
-	return x, nil
-}
-
-type Pins_UploadPictureClient interface {
-	Send(*UploadImage) error
-	CloseAndRecv() (*UploadImageResponse, error)
-	grpc.ClientStream
-}
-
-type pinsUploadPictureClient struct {
-	grpc.ClientStream
-}
-
-func (x *pinsUploadPictureClient) Send(m *UploadImage) error {
-	return x.ClientStream.SendMsg(m)
+	if interceptor == nil {
+		return srv.(PinsServer).UploadBoardAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pins.Pins/UploadBoardAvatar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinsServer).UploadBoardAvatar(ctx, req.(*FileInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *pinsUploadPictureClient) CloseAndRecv() (*UploadImageResponse, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
+func _Pins_InviteCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Collaborator)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	m := new(UploadImageResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(PinsServer).InviteCollaborator(ctx, in)
 	}
-	return m, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pins.Pins/InviteCollaborator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinsServer).InviteCollaborator(ctx, req.(*Collaborator))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pinsClient) GetPinsWithOffset(ctx context.Context, in *FeedInfo, opts ...grpc.CallOption) (*PinsList, error) {
-	out := new(PinsList)
-	err := c.cc.Invoke(ctx, "/pins.Pins/GetPinsWithOffset", in, out, opts...)
-	if err != nil {
+func _Pins_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardMember)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PinsServer).AcceptInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pins.Pins/AcceptInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinsServer).AcceptInvitation(ctx, req.(*BoardMember))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pinsClient) SearchPins(ctx context.Context, in *SearchInput, opts ...grpc.CallOption) (*PinsList, error) {
-	out := new(PinsList)
-	err := c.cc.Invoke(ctx, "/pins.Pins/SearchPins", in, out, opts...)
-	if err != nil {
+func _Pins_RemoveCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardMember)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PinsServer).RemoveCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pins.Pins/RemoveCollaborator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinsServer).RemoveCollaborator(ctx, req.(*BoardMember))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pinsClient) PinRefCount(ctx context.Context, in *PinID, opts ...grpc.CallOption) (*Number, error) {
-	out := new(Number)
-	err := c.cc.Invoke(ctx, "/pins.Pins/PinRefCount", in, out, opts...)
-	if err != nil {
+func _Pins_GetCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardID)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PinsServer).GetCollaborators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pins.Pins/GetCollaborators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinsServer).GetCollaborators(ctx, req.(*BoardID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pinsClient) DeleteFile(ctx context.Context, in *FilePath, opts ...grpc.CallOption) (*Error, error) {
-	out := new(Error)
-	err := c.cc.Invoke(ctx, "/pins.Pins/DeleteFile", in, out, opts...)
-	if err != nil {
+func _Pins_GetInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PinsServer).GetInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pins.Pins/GetInvitations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinsServer).GetInvitations(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pinsClient) GetPinsOfUsers(ctx context.Context, in *UserIDList, opts ...grpc.CallOption) (*PinsList, error) {
-	out := new(PinsList)
-	err := c.cc.Invoke(ctx, "/pins.Pins/GetPinsOfUsers", in, out, opts...)
-	if err != nil {
+func _Pins_GetBoardRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardMember)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(PinsServer).GetBoardRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pins.Pins/GetBoardRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinsServer).GetBoardRole(ctx, req.(*BoardMember))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *pinsClient) CreateReport(ctx context.Context, in *Report, opts ...grpc.CallOption) (*ReportID, error) {
-	out := new(ReportID)
-	err := c.cc.Invoke(ctx, "/pins.Pins/CreateReport", in, out, opts...)
-	if err != nil {
+func _Pins_CreateSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Section)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// PinsServer is the server API for Pins service.
-type PinsServer interface {
-	CreateBoard(context.Context, *Board) (*BoardID, error)
-	GetBoard(context.Context, *BoardID) (*Board, error)
-	GetBoards(context.Context, *UserID) (*BoardsList, error)
-	GetInitUserBoard(context.Context, *UserID) (*BoardID, error)
-	DeleteBoard(context.Context, *BoardID) (*Error, error)
-	UploadBoardAvatar(context.Context, *FileInfo) (*Error, error)
-	CreatePin(context.Context, *Pin) (*PinID, error)
-	AddPin(context.Context, *PinInBoard) (*Error, error)
-	GetPin(context.Context, *PinID) (*Pin, error)
-	GetPins(context.Context, *BoardID) (*PinsList, error)
-	GetLastPinID(context.Context, *UserID) (*PinID, error)
-	GetLastBoardPin(context.Context, *BoardID) (*Pin, error)
-	GetBoardsWithPin(context.Context, *PinID) (*BoardsList, error)
-	SavePicture(context.Context, *Pin) (*Error, error)
-	RemovePin(context.Context, *PinInBoard) (*Error, error)
-	DeletePin(context.Context, *PinID) (*Error, error)
-	UploadPicture(Pins_UploadPictureServer) error
-	GetPinsWithOffset(context.Context, *FeedInfo) (*PinsList, error)
-	SearchPins(context.Context, *SearchInput) (*PinsList, error)
-	PinRefCount(context.Context, *PinID) (*Number, error)
-	DeleteFile(context.Context, *FilePath) (*Error, error)
-	GetPinsOfUsers(context.Context, *UserIDList) (*PinsList, error)
-	CreateReport(context.Context, *Report) (*ReportID, error)
-}
-
-// UnimplementedPinsServer can be embedded to have forward compatible implementations.
-type UnimplementedPinsServer struct {
+	if interceptor == nil {
+		return srv.(PinsServer).CreateSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pins.Pins/CreateSection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinsServer).CreateSection(ctx, req.(*Section))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (*UnimplementedPinsServer) CreateBoard(context.Context, *Board) (*BoardID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBoard not implemented")
-}
-func (*UnimplementedPinsServer) GetBoard(context.Context, *BoardID) (*Board, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBoard not implemented")
-}
-func (*UnimplementedPinsServer) GetBoards(context.Context, *UserID) (*BoardsList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBoards not implemented")
-}
-func (*UnimplementedPinsServer) GetInitUserBoard(context.Context, *UserID) (*BoardID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInitUserBoard not implemented")
-}
-func (*UnimplementedPinsServer) DeleteBoard(context.Context, *BoardID) (*Error, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteBoard not implemented")
-}
-func (*UnimplementedPinsServer) UploadBoardAvatar(context.Context, *FileInfo) (*Error, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UploadBoardAvatar not implemented")
-}
-func (*UnimplementedPinsServer) CreatePin(context.Context, *Pin) (*PinID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePin not implemented")
-}
-func (*UnimplementedPinsServer) AddPin(context.Context, *PinInBoard) (*Error, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPin not implemented")
-}
-func (*UnimplementedPinsServer) GetPin(context.Context, *PinID) (*Pin, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPin not implemented")
-}
-func (*UnimplementedPinsServer) GetPins(context.Context, *BoardID) (*PinsList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPins not implemented")
-}
-func (*UnimplementedPinsServer) GetLastPinID(context.Context, *UserID) (*PinID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLastPinID not implemented")
-}
-func (*UnimplementedPinsServer) GetLastBoardPin(context.Context, *BoardID) (*Pin, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLastBoardPin not implemented")
-}
-func (*UnimplementedPinsServer) GetBoardsWithPin(context.Context, *PinID) (*BoardsList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBoardsWithPin not implemented")
-}
-func (*UnimplementedPinsServer) SavePicture(context.Context, *Pin) (*Error, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SavePicture not implemented")
-}
-func (*UnimplementedPinsServer) RemovePin(context.Context, *PinInBoard) (*Error, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemovePin not implemented")
-}
-func (*UnimplementedPinsServer) DeletePin(context.Context, *PinID) (*Error, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePin not implemented")
-}
-func (*UnimplementedPinsServer) UploadPicture(Pins_UploadPictureServer) error {
-	return status.Errorf(codes.Unimplemented, "method UploadPicture not implemented")
-}
-func (*UnimplementedPinsServer) GetPinsWithOffset(context.Context, *FeedInfo) (*PinsList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPinsWithOffset not implemented")
-}
-func (*UnimplementedPinsServer) SearchPins(context.Context, *SearchInput) (*PinsList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchPins not implemented")
-}
-func (*UnimplementedPinsServer) PinRefCount(context.Context, *PinID) (*Number, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PinRefCount not implemented")
-}
-func (*UnimplementedPinsServer) DeleteFile(context.Context, *FilePath) (*Error, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteFile not implemented")
-}
-func (*UnimplementedPinsServer) GetPinsOfUsers(context.Context, *UserIDList) (*PinsList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPinsOfUsers not implemented")
-}
-func (*UnimplementedPinsServer) CreateReport(context.Context, *Report) (*ReportID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateReport not implemented")
+func _Pins_RenameSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Section)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinsServer).RenameSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pins.Pins/RenameSection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinsServer).RenameSection(ctx, req.(*Section))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterPinsServer(s *grpc.Server, srv PinsServer) {
-	s.RegisterService(&_Pins_serviceDesc, srv)
+func _Pins_DeleteSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Section)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinsServer).DeleteSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pins.Pins/DeleteSection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinsServer).DeleteSection(ctx, req.(*Section))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Pins_CreateBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Board)
+func _Pins_GetSections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PinsServer).CreateBoard(ctx, in)
+		return srv.(PinsServer).GetSections(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pins.Pins/CreateBoard",
+		FullMethod: "/pins.Pins/GetSections",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PinsServer).CreateBoard(ctx, req.(*Board))
+		return srv.(PinsServer).GetSections(ctx, req.(*BoardID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Pins_GetBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BoardID)
+func _Pins_MovePin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMove)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PinsServer).GetBoard(ctx, in)
+		return srv.(PinsServer).MovePin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pins.Pins/GetBoard",
+		FullMethod: "/pins.Pins/MovePin",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PinsServer).GetBoard(ctx, req.(*BoardID))
+		return srv.(PinsServer).MovePin(ctx, req.(*PinMove))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Pins_GetBoards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserID)
+func _Pins_ReorderBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardOrder)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PinsServer).GetBoards(ctx, in)
+		return srv.(PinsServer).ReorderBoard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pins.Pins/GetBoards",
+		FullMethod: "/pins.Pins/ReorderBoard",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PinsServer).GetBoards(ctx, req.(*UserID))
+		return srv.(PinsServer).ReorderBoard(ctx, req.(*BoardOrder))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Pins_GetInitUserBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserID)
+func _Pins_FollowBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardMember)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PinsServer).GetInitUserBoard(ctx, in)
+		return srv.(PinsServer).FollowBoard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pins.Pins/GetInitUserBoard",
+		FullMethod: "/pins.Pins/FollowBoard",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PinsServer).GetInitUserBoard(ctx, req.(*UserID))
+		return srv.(PinsServer).FollowBoard(ctx, req.(*BoardMember))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Pins_DeleteBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BoardID)
+func _Pins_UnfollowBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardMember)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PinsServer).DeleteBoard(ctx, in)
+		return srv.(PinsServer).UnfollowBoard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pins.Pins/DeleteBoard",
+		FullMethod: "/pins.Pins/UnfollowBoard",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PinsServer).DeleteBoard(ctx, req.(*BoardID))
+		return srv.(PinsServer).UnfollowBoard(ctx, req.(*BoardMember))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Pins_UploadBoardAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FileInfo)
+func _Pins_GetBoardFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PinsServer).UploadBoardAvatar(ctx, in)
+		return srv.(PinsServer).GetBoardFollowers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pins.Pins/UploadBoardAvatar",
+		FullMethod: "/pins.Pins/GetBoardFollowers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PinsServer).UploadBoardAvatar(ctx, req.(*FileInfo))
+		return srv.(PinsServer).GetBoardFollowers(ctx, req.(*BoardID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2365,7 +4260,7 @@ func _Pins_AddPin_Handler(srv interface{}, ctx context.Context, dec func(interfa
 }
 
 func _Pins_GetPin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PinID)
+	in := new(PinRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2377,13 +4272,13 @@ func _Pins_GetPin_Handler(srv interface{}, ctx context.Context, dec func(interfa
 		FullMethod: "/pins.Pins/GetPin",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PinsServer).GetPin(ctx, req.(*PinID))
+		return srv.(PinsServer).GetPin(ctx, req.(*PinRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Pins_GetPins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BoardID)
+	in := new(BoardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2395,7 +4290,7 @@ func _Pins_GetPins_Handler(srv interface{}, ctx context.Context, dec func(interf
 		FullMethod: "/pins.Pins/GetPins",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PinsServer).GetPins(ctx, req.(*BoardID))
+		return srv.(PinsServer).GetPins(ctx, req.(*BoardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2472,6 +4367,24 @@ func _Pins_SavePicture_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Pins_UpdatePin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Pin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinsServer).UpdatePin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pins.Pins/UpdatePin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinsServer).UpdatePin(ctx, req.(*Pin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Pins_RemovePin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PinInBoard)
 	if err := dec(in); err != nil {
@@ -2624,6 +4537,24 @@ func _Pins_GetPinsOfUsers_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Pins_GetPinsOfFollowedBoards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinsServer).GetPinsOfFollowedBoards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pins.Pins/GetPinsOfFollowedBoards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinsServer).GetPinsOfFollowedBoards(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Pins_CreateReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Report)
 	if err := dec(in); err != nil {
@@ -2662,6 +4593,10 @@ var _Pins_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetInitUserBoard",
 			Handler:    _Pins_GetInitUserBoard_Handler,
 		},
+		{
+			MethodName: "UpdateBoard",
+			Handler:    _Pins_UpdateBoard_Handler,
+		},
 		{
 			MethodName: "DeleteBoard",
 			Handler:    _Pins_DeleteBoard_Handler,
@@ -2670,6 +4605,66 @@ var _Pins_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UploadBoardAvatar",
 			Handler:    _Pins_UploadBoardAvatar_Handler,
 		},
+		{
+			MethodName: "InviteCollaborator",
+			Handler:    _Pins_InviteCollaborator_Handler,
+		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _Pins_AcceptInvitation_Handler,
+		},
+		{
+			MethodName: "RemoveCollaborator",
+			Handler:    _Pins_RemoveCollaborator_Handler,
+		},
+		{
+			MethodName: "GetCollaborators",
+			Handler:    _Pins_GetCollaborators_Handler,
+		},
+		{
+			MethodName: "GetInvitations",
+			Handler:    _Pins_GetInvitations_Handler,
+		},
+		{
+			MethodName: "GetBoardRole",
+			Handler:    _Pins_GetBoardRole_Handler,
+		},
+		{
+			MethodName: "CreateSection",
+			Handler:    _Pins_CreateSection_Handler,
+		},
+		{
+			MethodName: "RenameSection",
+			Handler:    _Pins_RenameSection_Handler,
+		},
+		{
+			MethodName: "DeleteSection",
+			Handler:    _Pins_DeleteSection_Handler,
+		},
+		{
+			MethodName: "GetSections",
+			Handler:    _Pins_GetSections_Handler,
+		},
+		{
+			MethodName: "MovePin",
+			Handler:    _Pins_MovePin_Handler,
+		},
+		{
+			MethodName: "ReorderBoard",
+			Handler:    _Pins_ReorderBoard_Handler,
+		},
+		{
+			MethodName: "FollowBoard",
+			Handler:    _Pins_FollowBoard_Handler,
+		},
+		{
+			MethodName: "UnfollowBoard",
+			Handler:    _Pins_UnfollowBoard_Handler,
+		},
+		{
+			MethodName: "GetBoardFollowers",
+			Handler:    _Pins_GetBoardFollowers_Handler,
+		},
 		{
 			MethodName: "CreatePin",
 			Handler:    _Pins_CreatePin_Handler,
@@ -2702,6 +4697,10 @@ var _Pins_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SavePicture",
 			Handler:    _Pins_SavePicture_Handler,
 		},
+		{
+			MethodName: "UpdatePin",
+			Handler:    _Pins_UpdatePin_Handler,
+		},
 		{
 			MethodName: "RemovePin",
 			Handler:    _Pins_RemovePin_Handler,
@@ -2730,6 +4729,10 @@ var _Pins_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPinsOfUsers",
 			Handler:    _Pins_GetPinsOfUsers_Handler,
 		},
+		{
+			MethodName: "GetPinsOfFollowedBoards",
+			Handler:    _Pins_GetPinsOfFollowedBoards_Handler,
+		},
 		{
 			MethodName: "CreateReport",
 			Handler:    _Pins_CreateReport_Handler,
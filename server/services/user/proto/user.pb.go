@@ -783,6 +783,53 @@ func (x *Follows) GetFollowedID() int64 {
 	return 0
 }
 
+type FollowResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsRequested bool `protobuf:"varint,1,opt,name=isRequested,proto3" json:"isRequested,omitempty"` // Followed user's account is private, so follow request was created instead
+}
+
+func (x *FollowResult) Reset() {
+	*x = FollowResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowResult) ProtoMessage() {}
+
+func (x *FollowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowResult.ProtoReflect.Descriptor instead.
+func (*FollowResult) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FollowResult) GetIsRequested() bool {
+	if x != nil {
+		return x.IsRequested
+	}
+	return false
+}
+
 type IfFollowedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -794,7 +841,7 @@ type IfFollowedResponse struct {
 func (x *IfFollowedResponse) Reset() {
 	*x = IfFollowedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_proto_msgTypes[11]
+		mi := &file_user_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -807,7 +854,7 @@ func (x *IfFollowedResponse) String() string {
 func (*IfFollowedResponse) ProtoMessage() {}
 
 func (x *IfFollowedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[11]
+	mi := &file_user_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -820,7 +867,7 @@ func (x *IfFollowedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IfFollowedResponse.ProtoReflect.Descriptor instead.
 func (*IfFollowedResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{11}
+	return file_user_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *IfFollowedResponse) GetIsFollowed() bool {
@@ -830,6 +877,116 @@ func (x *IfFollowedResponse) GetIsFollowed() bool {
 	return false
 }
 
+type Blocks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockerID int64 `protobuf:"varint,1,opt,name=BlockerID,proto3" json:"BlockerID,omitempty"`
+	BlockedID int64 `protobuf:"varint,2,opt,name=BlockedID,proto3" json:"BlockedID,omitempty"`
+}
+
+func (x *Blocks) Reset() {
+	*x = Blocks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Blocks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Blocks) ProtoMessage() {}
+
+func (x *Blocks) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Blocks.ProtoReflect.Descriptor instead.
+func (*Blocks) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Blocks) GetBlockerID() int64 {
+	if x != nil {
+		return x.BlockerID
+	}
+	return 0
+}
+
+func (x *Blocks) GetBlockedID() int64 {
+	if x != nil {
+		return x.BlockedID
+	}
+	return 0
+}
+
+type BlockStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsBlocked   bool `protobuf:"varint,1,opt,name=isBlocked,proto3" json:"isBlocked,omitempty"`     // BlockerID blocked BlockedID
+	IsBlockedBy bool `protobuf:"varint,2,opt,name=isBlockedBy,proto3" json:"isBlockedBy,omitempty"` // BlockedID blocked BlockerID
+}
+
+func (x *BlockStatus) Reset() {
+	*x = BlockStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockStatus) ProtoMessage() {}
+
+func (x *BlockStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockStatus.ProtoReflect.Descriptor instead.
+func (*BlockStatus) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BlockStatus) GetIsBlocked() bool {
+	if x != nil {
+		return x.IsBlocked
+	}
+	return false
+}
+
+func (x *BlockStatus) GetIsBlockedBy() bool {
+	if x != nil {
+		return x.IsBlockedBy
+	}
+	return false
+}
+
 type Password struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -842,7 +999,7 @@ type Password struct {
 func (x *Password) Reset() {
 	*x = Password{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_proto_msgTypes[12]
+		mi := &file_user_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -855,7 +1012,7 @@ func (x *Password) String() string {
 func (*Password) ProtoMessage() {}
 
 func (x *Password) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[12]
+	mi := &file_user_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -868,7 +1025,7 @@ func (x *Password) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Password.ProtoReflect.Descriptor instead.
 func (*Password) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{12}
+	return file_user_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Password) GetPassword() string {
@@ -890,13 +1047,14 @@ type SearchInput struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KeyWords string `protobuf:"bytes,1,opt,name=keyWords,proto3" json:"keyWords,omitempty"`
+	KeyWords    string `protobuf:"bytes,1,opt,name=keyWords,proto3" json:"keyWords,omitempty"`
+	RequesterID int64  `protobuf:"varint,2,opt,name=RequesterID,proto3" json:"RequesterID,omitempty"` // Users who blocked requester or were blocked by them are skipped, 0 if requester is not logged in
 }
 
 func (x *SearchInput) Reset() {
 	*x = SearchInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_proto_msgTypes[13]
+		mi := &file_user_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -909,7 +1067,7 @@ func (x *SearchInput) String() string {
 func (*SearchInput) ProtoMessage() {}
 
 func (x *SearchInput) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[13]
+	mi := &file_user_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,7 +1080,7 @@ func (x *SearchInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchInput.ProtoReflect.Descriptor instead.
 func (*SearchInput) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{13}
+	return file_user_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SearchInput) GetKeyWords() string {
@@ -932,6 +1090,13 @@ func (x *SearchInput) GetKeyWords() string {
 	return ""
 }
 
+func (x *SearchInput) GetRequesterID() int64 {
+	if x != nil {
+		return x.RequesterID
+	}
+	return 0
+}
+
 type Error struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -941,7 +1106,7 @@ type Error struct {
 func (x *Error) Reset() {
 	*x = Error{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_proto_msgTypes[14]
+		mi := &file_user_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -954,7 +1119,7 @@ func (x *Error) String() string {
 func (*Error) ProtoMessage() {}
 
 func (x *Error) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[14]
+	mi := &file_user_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -967,7 +1132,70 @@ func (x *Error) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Error.ProtoReflect.Descriptor instead.
 func (*Error) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{14}
+	return file_user_proto_rawDescGZIP(), []int{17}
+}
+
+type PrivacySettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID       int64 `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	HidePresence bool  `protobuf:"varint,2,opt,name=HidePresence,proto3" json:"HidePresence,omitempty"` // Nobody sees if user is online or when they were last seen
+	IsPrivate    bool  `protobuf:"varint,3,opt,name=IsPrivate,proto3" json:"IsPrivate,omitempty"`       // Only followers see user's pins, boards and followers, new followers have to be accepted
+}
+
+func (x *PrivacySettings) Reset() {
+	*x = PrivacySettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrivacySettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacySettings) ProtoMessage() {}
+
+func (x *PrivacySettings) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacySettings.ProtoReflect.Descriptor instead.
+func (*PrivacySettings) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PrivacySettings) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *PrivacySettings) GetHidePresence() bool {
+	if x != nil {
+		return x.HidePresence
+	}
+	return false
+}
+
+func (x *PrivacySettings) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
 }
 
 var File_user_proto protoreflect.FileDescriptor
@@ -1051,66 +1279,114 @@ var file_user_proto_rawDesc = []byte{
 	0x01, 0x28, 0x03, 0x52, 0x0a, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x49, 0x44, 0x12,
 	0x1e, 0x0a, 0x0a, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x03, 0x52, 0x0a, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x44, 0x22,
-	0x34, 0x0a, 0x12, 0x49, 0x66, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x46, 0x6f, 0x6c,
-	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x22, 0x3e, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x29, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73,
-	0x22, 0x07, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x32, 0x91, 0x06, 0x0a, 0x04, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x2b, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x1a,
-	0x0c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x00, 0x12,
-	0x2e, 0x0a, 0x08, 0x53, 0x61, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12,
-	0x42, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12,
-	0x12, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x61,
-	0x74, 0x61, 0x72, 0x1a, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x28, 0x01, 0x12, 0x2b, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c,
-	0x65, 0x12, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74,
-	0x68, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00,
-	0x12, 0x29, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0c,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x0b, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x07, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x10, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22,
-	0x00, 0x12, 0x3b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x12, 0x26,
-	0x0a, 0x06, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
-	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x08, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x73, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00,
-	0x12, 0x3c, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x65, 0x64, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x73, 0x1a, 0x18, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x66, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39,
-	0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x11, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x1a, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0e, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a, 0x0b, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x0c, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x15, 0x2e, 0x75, 0x73,
+	0x30, 0x0a, 0x0c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x22, 0x34, 0x0a, 0x12, 0x49, 0x66, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x22, 0x44, 0x0a, 0x06, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x44, 0x22, 0x4d, 0x0a,
+	0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x22, 0x3e, 0x0a, 0x08,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x4b, 0x0a, 0x0b,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6b,
+	0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b,
+	0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x6b, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a,
+	0x0c, 0x48, 0x69, 0x64, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x48, 0x69, 0x64, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x32,
+	0xbb, 0x09, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x67, 0x1a, 0x0c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x08, 0x53, 0x61, 0x76, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x69,
+	0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x12, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x1a, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x2b, 0x0a, 0x0a, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x29, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x0c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x00, 0x12, 0x2b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0c, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x12, 0x37,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x1a, 0x10, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x75, 0x73,
 	0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x0c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x1a, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x42, 0x04, 0x5a,
+	0x75, 0x74, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x06, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x0d,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x1a, 0x12, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x08, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12,
+	0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x1a, 0x0b,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x3c, 0x0a,
+	0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x1a,
+	0x18, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x49, 0x66, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0b, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x11, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x15, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x0c, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22,
+	0x00, 0x12, 0x37, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x12, 0x0c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x1a, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x0c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x15,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x13, 0x53, 0x61, 0x76, 0x65, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x15,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x00, 0x12, 0x24, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0c, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x0b, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x07, 0x55, 0x6e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x00, 0x12, 0x33, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x0c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x1a, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x0c, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x15, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0d, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x13, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x1a, 0x0b,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x00, 0x42, 0x04, 0x5a,
 	0x02, 0x2e, 0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
@@ -1126,7 +1402,7 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_user_proto_goTypes = []interface{}{
 	(*UserReg)(nil),              // 0: user.UserReg
 	(*UserEditInput)(nil),        // 1: user.UserEditInput
@@ -1139,11 +1415,15 @@ var file_user_proto_goTypes = []interface{}{
 	(*UploadAvatarResponse)(nil), // 8: user.UploadAvatarResponse
 	(*FilePath)(nil),             // 9: user.FilePath
 	(*Follows)(nil),              // 10: user.Follows
-	(*IfFollowedResponse)(nil),   // 11: user.IfFollowedResponse
-	(*Password)(nil),             // 12: user.Password
-	(*SearchInput)(nil),          // 13: user.SearchInput
-	(*Error)(nil),                // 14: user.Error
-	(*empty.Empty)(nil),          // 15: google.protobuf.Empty
+	(*FollowResult)(nil),         // 11: user.FollowResult
+	(*IfFollowedResponse)(nil),   // 12: user.IfFollowedResponse
+	(*Blocks)(nil),               // 13: user.Blocks
+	(*BlockStatus)(nil),          // 14: user.BlockStatus
+	(*Password)(nil),             // 15: user.Password
+	(*SearchInput)(nil),          // 16: user.SearchInput
+	(*Error)(nil),                // 17: user.Error
+	(*PrivacySettings)(nil),      // 18: user.PrivacySettings
+	(*empty.Empty)(nil),          // 19: google.protobuf.Empty
 }
 var file_user_proto_depIdxs = []int32{
 	3,  // 0: user.UsersListOutput.Users:type_name -> user.UserOutput
@@ -1154,31 +1434,47 @@ var file_user_proto_depIdxs = []int32{
 	5,  // 5: user.User.DeleteUser:input_type -> user.UserID
 	5,  // 6: user.User.GetUser:input_type -> user.UserID
 	6,  // 7: user.User.GetUserByUsername:input_type -> user.Username
-	15, // 8: user.User.GetUsers:input_type -> google.protobuf.Empty
+	19, // 8: user.User.GetUsers:input_type -> google.protobuf.Empty
 	10, // 9: user.User.Follow:input_type -> user.Follows
 	10, // 10: user.User.Unfollow:input_type -> user.Follows
 	10, // 11: user.User.CheckIfFollowed:input_type -> user.Follows
-	13, // 12: user.User.SearchUsers:input_type -> user.SearchInput
-	12, // 13: user.User.ChangePassword:input_type -> user.Password
+	16, // 12: user.User.SearchUsers:input_type -> user.SearchInput
+	15, // 13: user.User.ChangePassword:input_type -> user.Password
 	5,  // 14: user.User.GetAllFollowers:input_type -> user.UserID
 	5,  // 15: user.User.GetAllFollowed:input_type -> user.UserID
-	5,  // 16: user.User.CreateUser:output_type -> user.UserID
-	14, // 17: user.User.SaveUser:output_type -> user.Error
-	8,  // 18: user.User.UpdateAvatar:output_type -> user.UploadAvatarResponse
-	14, // 19: user.User.DeleteFile:output_type -> user.Error
-	14, // 20: user.User.DeleteUser:output_type -> user.Error
-	3,  // 21: user.User.GetUser:output_type -> user.UserOutput
-	3,  // 22: user.User.GetUserByUsername:output_type -> user.UserOutput
-	4,  // 23: user.User.GetUsers:output_type -> user.UsersListOutput
-	14, // 24: user.User.Follow:output_type -> user.Error
-	14, // 25: user.User.Unfollow:output_type -> user.Error
-	11, // 26: user.User.CheckIfFollowed:output_type -> user.IfFollowedResponse
-	4,  // 27: user.User.SearchUsers:output_type -> user.UsersListOutput
-	14, // 28: user.User.ChangePassword:output_type -> user.Error
-	4,  // 29: user.User.GetAllFollowers:output_type -> user.UsersListOutput
-	4,  // 30: user.User.GetAllFollowed:output_type -> user.UsersListOutput
-	16, // [16:31] is the sub-list for method output_type
-	1,  // [1:16] is the sub-list for method input_type
+	5,  // 16: user.User.GetPrivacySettings:input_type -> user.UserID
+	18, // 17: user.User.SavePrivacySettings:input_type -> user.PrivacySettings
+	13, // 18: user.User.Block:input_type -> user.Blocks
+	13, // 19: user.User.Unblock:input_type -> user.Blocks
+	13, // 20: user.User.GetBlockStatus:input_type -> user.Blocks
+	5,  // 21: user.User.GetFollowRequests:input_type -> user.UserID
+	10, // 22: user.User.AcceptFollowRequest:input_type -> user.Follows
+	10, // 23: user.User.RejectFollowRequest:input_type -> user.Follows
+	5,  // 24: user.User.CreateUser:output_type -> user.UserID
+	17, // 25: user.User.SaveUser:output_type -> user.Error
+	8,  // 26: user.User.UpdateAvatar:output_type -> user.UploadAvatarResponse
+	17, // 27: user.User.DeleteFile:output_type -> user.Error
+	17, // 28: user.User.DeleteUser:output_type -> user.Error
+	3,  // 29: user.User.GetUser:output_type -> user.UserOutput
+	3,  // 30: user.User.GetUserByUsername:output_type -> user.UserOutput
+	4,  // 31: user.User.GetUsers:output_type -> user.UsersListOutput
+	11, // 32: user.User.Follow:output_type -> user.FollowResult
+	17, // 33: user.User.Unfollow:output_type -> user.Error
+	12, // 34: user.User.CheckIfFollowed:output_type -> user.IfFollowedResponse
+	4,  // 35: user.User.SearchUsers:output_type -> user.UsersListOutput
+	17, // 36: user.User.ChangePassword:output_type -> user.Error
+	4,  // 37: user.User.GetAllFollowers:output_type -> user.UsersListOutput
+	4,  // 38: user.User.GetAllFollowed:output_type -> user.UsersListOutput
+	18, // 39: user.User.GetPrivacySettings:output_type -> user.PrivacySettings
+	17, // 40: user.User.SavePrivacySettings:output_type -> user.Error
+	17, // 41: user.User.Block:output_type -> user.Error
+	17, // 42: user.User.Unblock:output_type -> user.Error
+	14, // 43: user.User.GetBlockStatus:output_type -> user.BlockStatus
+	4,  // 44: user.User.GetFollowRequests:output_type -> user.UsersListOutput
+	17, // 45: user.User.AcceptFollowRequest:output_type -> user.Error
+	17, // 46: user.User.RejectFollowRequest:output_type -> user.Error
+	24, // [24:47] is the sub-list for method output_type
+	1,  // [1:24] is the sub-list for method input_type
 	1,  // [1:1] is the sub-list for extension type_name
 	1,  // [1:1] is the sub-list for extension extendee
 	0,  // [0:1] is the sub-list for field type_name
@@ -1323,7 +1619,7 @@ func file_user_proto_init() {
 			}
 		}
 		file_user_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IfFollowedResponse); i {
+			switch v := v.(*FollowResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1335,7 +1631,7 @@ func file_user_proto_init() {
 			}
 		}
 		file_user_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Password); i {
+			switch v := v.(*IfFollowedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1347,7 +1643,7 @@ func file_user_proto_init() {
 			}
 		}
 		file_user_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchInput); i {
+			switch v := v.(*Blocks); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1359,6 +1655,42 @@ func file_user_proto_init() {
 			}
 		}
 		file_user_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Password); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Error); i {
 			case 0:
 				return &v.state
@@ -1370,6 +1702,18 @@ func file_user_proto_init() {
 				return nil
 			}
 		}
+		file_user_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivacySettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_user_proto_msgTypes[7].OneofWrappers = []interface{}{
 		(*UploadAvatar_Extension)(nil),
@@ -1381,7 +1725,7 @@ func file_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_user_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1415,13 +1759,21 @@ type UserClient interface {
 	GetUser(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*UserOutput, error)
 	GetUserByUsername(ctx context.Context, in *Username, opts ...grpc.CallOption) (*UserOutput, error)
 	GetUsers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*UsersListOutput, error)
-	Follow(ctx context.Context, in *Follows, opts ...grpc.CallOption) (*Error, error)
+	Follow(ctx context.Context, in *Follows, opts ...grpc.CallOption) (*FollowResult, error)
 	Unfollow(ctx context.Context, in *Follows, opts ...grpc.CallOption) (*Error, error)
 	CheckIfFollowed(ctx context.Context, in *Follows, opts ...grpc.CallOption) (*IfFollowedResponse, error)
 	SearchUsers(ctx context.Context, in *SearchInput, opts ...grpc.CallOption) (*UsersListOutput, error)
 	ChangePassword(ctx context.Context, in *Password, opts ...grpc.CallOption) (*Error, error)
 	GetAllFollowers(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*UsersListOutput, error)
 	GetAllFollowed(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*UsersListOutput, error)
+	GetPrivacySettings(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*PrivacySettings, error)
+	SavePrivacySettings(ctx context.Context, in *PrivacySettings, opts ...grpc.CallOption) (*Error, error)
+	Block(ctx context.Context, in *Blocks, opts ...grpc.CallOption) (*Error, error)
+	Unblock(ctx context.Context, in *Blocks, opts ...grpc.CallOption) (*Error, error)
+	GetBlockStatus(ctx context.Context, in *Blocks, opts ...grpc.CallOption) (*BlockStatus, error)
+	GetFollowRequests(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*UsersListOutput, error)
+	AcceptFollowRequest(ctx context.Context, in *Follows, opts ...grpc.CallOption) (*Error, error)
+	RejectFollowRequest(ctx context.Context, in *Follows, opts ...grpc.CallOption) (*Error, error)
 }
 
 type userClient struct {
@@ -1529,8 +1881,8 @@ func (c *userClient) GetUsers(ctx context.Context, in *empty.Empty, opts ...grpc
 	return out, nil
 }
 
-func (c *userClient) Follow(ctx context.Context, in *Follows, opts ...grpc.CallOption) (*Error, error) {
-	out := new(Error)
+func (c *userClient) Follow(ctx context.Context, in *Follows, opts ...grpc.CallOption) (*FollowResult, error) {
+	out := new(FollowResult)
 	err := c.cc.Invoke(ctx, "/user.User/Follow", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -1592,6 +1944,78 @@ func (c *userClient) GetAllFollowed(ctx context.Context, in *UserID, opts ...grp
 	return out, nil
 }
 
+func (c *userClient) GetPrivacySettings(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*PrivacySettings, error) {
+	out := new(PrivacySettings)
+	err := c.cc.Invoke(ctx, "/user.User/GetPrivacySettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SavePrivacySettings(ctx context.Context, in *PrivacySettings, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/user.User/SavePrivacySettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Block(ctx context.Context, in *Blocks, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/user.User/Block", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Unblock(ctx context.Context, in *Blocks, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/user.User/Unblock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetBlockStatus(ctx context.Context, in *Blocks, opts ...grpc.CallOption) (*BlockStatus, error) {
+	out := new(BlockStatus)
+	err := c.cc.Invoke(ctx, "/user.User/GetBlockStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetFollowRequests(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*UsersListOutput, error) {
+	out := new(UsersListOutput)
+	err := c.cc.Invoke(ctx, "/user.User/GetFollowRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AcceptFollowRequest(ctx context.Context, in *Follows, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/user.User/AcceptFollowRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RejectFollowRequest(ctx context.Context, in *Follows, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/user.User/RejectFollowRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServer is the server API for User service.
 type UserServer interface {
 	CreateUser(context.Context, *UserReg) (*UserID, error)
@@ -1602,13 +2026,21 @@ type UserServer interface {
 	GetUser(context.Context, *UserID) (*UserOutput, error)
 	GetUserByUsername(context.Context, *Username) (*UserOutput, error)
 	GetUsers(context.Context, *empty.Empty) (*UsersListOutput, error)
-	Follow(context.Context, *Follows) (*Error, error)
+	Follow(context.Context, *Follows) (*FollowResult, error)
 	Unfollow(context.Context, *Follows) (*Error, error)
 	CheckIfFollowed(context.Context, *Follows) (*IfFollowedResponse, error)
 	SearchUsers(context.Context, *SearchInput) (*UsersListOutput, error)
 	ChangePassword(context.Context, *Password) (*Error, error)
 	GetAllFollowers(context.Context, *UserID) (*UsersListOutput, error)
 	GetAllFollowed(context.Context, *UserID) (*UsersListOutput, error)
+	GetPrivacySettings(context.Context, *UserID) (*PrivacySettings, error)
+	SavePrivacySettings(context.Context, *PrivacySettings) (*Error, error)
+	Block(context.Context, *Blocks) (*Error, error)
+	Unblock(context.Context, *Blocks) (*Error, error)
+	GetBlockStatus(context.Context, *Blocks) (*BlockStatus, error)
+	GetFollowRequests(context.Context, *UserID) (*UsersListOutput, error)
+	AcceptFollowRequest(context.Context, *Follows) (*Error, error)
+	RejectFollowRequest(context.Context, *Follows) (*Error, error)
 }
 
 // UnimplementedUserServer can be embedded to have forward compatible implementations.
@@ -1639,7 +2071,7 @@ func (*UnimplementedUserServer) GetUserByUsername(context.Context, *Username) (*
 func (*UnimplementedUserServer) GetUsers(context.Context, *empty.Empty) (*UsersListOutput, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUsers not implemented")
 }
-func (*UnimplementedUserServer) Follow(context.Context, *Follows) (*Error, error) {
+func (*UnimplementedUserServer) Follow(context.Context, *Follows) (*FollowResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Follow not implemented")
 }
 func (*UnimplementedUserServer) Unfollow(context.Context, *Follows) (*Error, error) {
@@ -1660,6 +2092,30 @@ func (*UnimplementedUserServer) GetAllFollowers(context.Context, *UserID) (*User
 func (*UnimplementedUserServer) GetAllFollowed(context.Context, *UserID) (*UsersListOutput, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllFollowed not implemented")
 }
+func (*UnimplementedUserServer) GetPrivacySettings(context.Context, *UserID) (*PrivacySettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrivacySettings not implemented")
+}
+func (*UnimplementedUserServer) SavePrivacySettings(context.Context, *PrivacySettings) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SavePrivacySettings not implemented")
+}
+func (*UnimplementedUserServer) Block(context.Context, *Blocks) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Block not implemented")
+}
+func (*UnimplementedUserServer) Unblock(context.Context, *Blocks) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unblock not implemented")
+}
+func (*UnimplementedUserServer) GetBlockStatus(context.Context, *Blocks) (*BlockStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockStatus not implemented")
+}
+func (*UnimplementedUserServer) GetFollowRequests(context.Context, *UserID) (*UsersListOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowRequests not implemented")
+}
+func (*UnimplementedUserServer) AcceptFollowRequest(context.Context, *Follows) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptFollowRequest not implemented")
+}
+func (*UnimplementedUserServer) RejectFollowRequest(context.Context, *Follows) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectFollowRequest not implemented")
+}
 
 func RegisterUserServer(s *grpc.Server, srv UserServer) {
 	s.RegisterService(&_User_serviceDesc, srv)
@@ -1943,6 +2399,150 @@ func _User_GetAllFollowed_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_GetPrivacySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetPrivacySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/GetPrivacySettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetPrivacySettings(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SavePrivacySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrivacySettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SavePrivacySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/SavePrivacySettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SavePrivacySettings(ctx, req.(*PrivacySettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Blocks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Block(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/Block",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Block(ctx, req.(*Blocks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Unblock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Blocks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Unblock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/Unblock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Unblock(ctx, req.(*Blocks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetBlockStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Blocks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetBlockStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/GetBlockStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetBlockStatus(ctx, req.(*Blocks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetFollowRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetFollowRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/GetFollowRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetFollowRequests(ctx, req.(*UserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AcceptFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Follows)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AcceptFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/AcceptFollowRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AcceptFollowRequest(ctx, req.(*Follows))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RejectFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Follows)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RejectFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/RejectFollowRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RejectFollowRequest(ctx, req.(*Follows))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _User_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "user.User",
 	HandlerType: (*UserServer)(nil),
@@ -2003,6 +2603,38 @@ var _User_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllFollowed",
 			Handler:    _User_GetAllFollowed_Handler,
 		},
+		{
+			MethodName: "GetPrivacySettings",
+			Handler:    _User_GetPrivacySettings_Handler,
+		},
+		{
+			MethodName: "SavePrivacySettings",
+			Handler:    _User_SavePrivacySettings_Handler,
+		},
+		{
+			MethodName: "Block",
+			Handler:    _User_Block_Handler,
+		},
+		{
+			MethodName: "Unblock",
+			Handler:    _User_Unblock_Handler,
+		},
+		{
+			MethodName: "GetBlockStatus",
+			Handler:    _User_GetBlockStatus_Handler,
+		},
+		{
+			MethodName: "GetFollowRequests",
+			Handler:    _User_GetFollowRequests_Handler,
+		},
+		{
+			MethodName: "AcceptFollowRequest",
+			Handler:    _User_AcceptFollowRequest_Handler,
+		},
+		{
+			MethodName: "RejectFollowRequest",
+			Handler:    _User_RejectFollowRequest_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -42,6 +42,13 @@ const createUserQueryDefaulAvatar string = "INSERT INTO Users (username, passwor
 func (s *service) CreateUser(ctx context.Context, us *UserReg) (*UserID, error) {
 	user := FillFromRegForm(us)
 
+	passwordHash, salt, err := entity.HashPassword(user.Password)
+	if err != nil {
+		return nil, err
+	}
+	user.Password = passwordHash
+	user.Salt = salt
+
 	tx, err := s.db.Begin(context.Background())
 	if err != nil {
 		return nil, entity.TransactionBeginError
@@ -176,17 +183,22 @@ func (s *service) DeleteFile(ctx context.Context, filename *FilePath) (*Error, e
 }
 
 const changePasswordQuery string = "UPDATE Users\n" +
-	"SET passwordhash=$1\n" +
-	"WHERE userID=$2"
+	"SET passwordhash=$1, salt=$2\n" +
+	"WHERE userID=$3"
 
 func (s *service) ChangePassword(ctx context.Context, pswrd *Password) (*Error, error) {
+	passwordHash, salt, err := entity.HashPassword(pswrd.Password)
+	if err != nil {
+		return &Error{}, err
+	}
+
 	tx, err := s.db.Begin(context.Background())
 	if err != nil {
 		return &Error{}, entity.TransactionBeginError
 	}
 	defer tx.Rollback(context.Background())
 
-	_, err = tx.Exec(context.Background(), changePasswordQuery, pswrd.Password, pswrd.UserID)
+	_, err = tx.Exec(context.Background(), changePasswordQuery, passwordHash, salt, pswrd.UserID)
 	if err != nil {
 		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "Duplicate") {
 			return &Error{}, entity.UsernameEmailDuplicateError
@@ -358,47 +370,112 @@ func (s *service) GetUserByUsername(ctx context.Context, username *Username) (*U
 	return &user, nil
 }
 
-const followQuery string = "INSERT INTO Followers(followerID, followedID) VALUES ($1, $2)"
+const followQuery string = "INSERT INTO Followers(followerID, followedID)\n" +
+	"SELECT $1, $2\n" +
+	"WHERE NOT EXISTS (SELECT 1 FROM Blocks\n" +
+	"WHERE (blockerID=$1 AND blockedID=$2) OR (blockerID=$2 AND blockedID=$1))" // Nothing is inserted if one of users blocked the other
 const updateFollowingQuery string = "UPDATE Users SET following = following + 1 WHERE userID=$1"
 const updateFollowedByQuery string = "UPDATE Users SET followed_by = followed_by + 1 WHERE userID=$1"
+const getIsPrivateQuery string = "SELECT is_private FROM Users WHERE userID=$1"
 
-func (s *service) Follow(ctx context.Context, follows *Follows) (*Error, error) {
+// Follow makes FollowerID follow FollowedID
+// If FollowedID's account is private, follow request is created instead, and it has to be accepted by FollowedID
+func (s *service) Follow(ctx context.Context, follows *Follows) (*FollowResult, error) {
 	tx, err := s.db.Begin(context.Background())
 	if err != nil {
-		return &Error{}, entity.TransactionBeginError
+		return &FollowResult{}, entity.TransactionBeginError
 	}
 	defer tx.Rollback(context.Background()) // Will help if one of updateX queries fails
 
-	_, err = tx.Exec(context.Background(), followQuery, follows.FollowerID, follows.FollowedID)
+	var isPrivate bool
+	err = tx.QueryRow(context.Background(), getIsPrivateQuery, follows.FollowedID).Scan(&isPrivate)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return &FollowResult{}, entity.UserNotFoundError
+		}
+		return &FollowResult{}, err
+	}
+
+	switch isPrivate {
+	case true:
+		err = addFollowRequest(tx, follows.FollowerID, follows.FollowedID)
+	case false:
+		err = addFollow(tx, follows.FollowerID, follows.FollowedID)
+	}
+	if err != nil {
+		return &FollowResult{}, err
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &FollowResult{}, entity.TransactionCommitError
+	}
+	return &FollowResult{IsRequested: isPrivate}, nil
+}
+
+// addFollow makes first user follow second and updates follow counters
+func addFollow(tx pgx.Tx, followerID int64, followedID int64) error {
+	result, err := tx.Exec(context.Background(), followQuery, followerID, followedID)
 	if err != nil {
 		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "Duplicate") {
-			return &Error{}, entity.FollowAlreadyExistsError
+			return entity.FollowAlreadyExistsError
 		}
 		if strings.Contains(err.Error(), `violates foreign key constraint "followers_users_followed"`) {
-			return &Error{}, entity.UserNotFoundError
+			return entity.UserNotFoundError
 		}
 		if strings.Contains(err.Error(), `violates foreign key constraint "followers_users_follower"`) { // Actually does not usually happen because of checks in middleware
-			return &Error{}, entity.UserNotFoundError
+			return entity.UserNotFoundError
 		}
 
-		return &Error{}, err
+		return err
+	}
+	if result.RowsAffected() != 1 {
+		return entity.UserBlockedError
 	}
 
-	_, err = tx.Exec(context.Background(), updateFollowingQuery, follows.FollowerID)
+	_, err = tx.Exec(context.Background(), updateFollowingQuery, followerID)
 	if err != nil {
-		return &Error{}, entity.FollowCountUpdateError
+		return entity.FollowCountUpdateError
 	}
 
-	_, err = tx.Exec(context.Background(), updateFollowedByQuery, follows.FollowedID)
+	_, err = tx.Exec(context.Background(), updateFollowedByQuery, followedID)
 	if err != nil {
-		return &Error{}, entity.FollowCountUpdateError
+		return entity.FollowCountUpdateError
 	}
+	return nil
+}
 
-	err = tx.Commit(context.Background())
+const followRequestQuery string = "INSERT INTO Follow_requests(followerID, followedID)\n" +
+	"SELECT $1, $2\n" +
+	"WHERE NOT EXISTS (SELECT 1 FROM Blocks\n" +
+	"WHERE (blockerID=$1 AND blockedID=$2) OR (blockerID=$2 AND blockedID=$1))" // Nothing is inserted if one of users blocked the other
+
+// addFollowRequest creates request of first user to follow second, unless first user already follows second
+func addFollowRequest(tx pgx.Tx, followerID int64, followedID int64) error {
+	var resultingOne int
+	err := tx.QueryRow(context.Background(), checkIfFollowedQuery, followerID, followedID).Scan(&resultingOne)
+	if err == nil {
+		return entity.FollowAlreadyExistsError
+	}
+	if err != pgx.ErrNoRows {
+		return err
+	}
+
+	result, err := tx.Exec(context.Background(), followRequestQuery, followerID, followedID)
 	if err != nil {
-		return nil, entity.TransactionCommitError
+		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "Duplicate") {
+			return entity.FollowRequestAlreadyExistsError
+		}
+		if strings.Contains(err.Error(), "violates foreign key constraint") {
+			return entity.UserNotFoundError
+		}
+
+		return err
 	}
-	return &Error{}, nil
+	if result.RowsAffected() != 1 {
+		return entity.UserBlockedError
+	}
+	return nil
 }
 
 const unfollowQuery string = "DELETE FROM Followers WHERE followerID=$1 AND followedID=$2"
@@ -467,9 +544,12 @@ func (s *service) CheckIfFollowed(ctx context.Context, follows *Follows) (*IfFol
 const SearchUsersQuery string = "SELECT userID, username, email, first_name, last_name, avatar, " +
 	"followed_by, following, boards_count, pins_count, vk_id\n" +
 	"FROM Users\n" +
-	"WHERE LOWER(username) LIKE $1;"
+	"WHERE LOWER(username) LIKE $1\n" +
+	"AND NOT EXISTS (SELECT 1 FROM Blocks\n" +
+	"WHERE (blockerID = userID AND blockedID = $2) OR (blockerID = $2 AND blockedID = userID));"
 
 // SearchUsers fetches all users from database suitable with passed keywords
+// Users who blocked requester or were blocked by them are not returned
 // It returns slice of users and nil on success, nil and error on failure
 func (s *service) SearchUsers(ctx context.Context, keyWords *SearchInput) (*UsersListOutput, error) {
 	tx, err := s.db.Begin(context.Background())
@@ -479,7 +559,7 @@ func (s *service) SearchUsers(ctx context.Context, keyWords *SearchInput) (*User
 	defer tx.Rollback(context.Background())
 
 	users := make([]*UserOutput, 0)
-	rows, err := tx.Query(context.Background(), SearchUsersQuery, "%"+keyWords.KeyWords+"%")
+	rows, err := tx.Query(context.Background(), SearchUsersQuery, "%"+keyWords.KeyWords+"%", keyWords.RequesterID)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, entity.UsersNotFoundError
@@ -627,7 +707,7 @@ func FillFromRegForm(us *UserReg) entity.User {
 		LastName:   us.LastName,
 		Email:      us.Email,
 		Avatar:     "",
-		Salt:       "", // TODO salt realize
+		Salt:       "", // Generated in CreateUser along with password hash
 		Following:  0,
 		FollowedBy: 0,
 		VkID:       int(us.VkID),
@@ -643,7 +723,7 @@ func FillFromEditForm(us *UserEditInput) *entity.User {
 		LastName:   us.LastName,
 		Email:      us.Email,
 		Avatar:     us.AvatarLink,
-		Salt:       us.Salt, // TODO salt realize
+		Salt:       us.Salt,
 		Following:  0,
 		FollowedBy: 0,
 		VkID:       int(us.VkID),
@@ -679,3 +759,312 @@ func handleS3Error(err error) error {
 
 	return fmt.Errorf("Not an S3 error")
 }
+
+const getPrivacySettingsQuery string = "SELECT hide_presence, is_private FROM Users WHERE userID=$1"
+
+// GetPrivacySettings returns user's privacy settings
+// It returns UserNotFoundError if there is no such user
+func (s *service) GetPrivacySettings(ctx context.Context, userID *UserID) (*PrivacySettings, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &PrivacySettings{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	settings := PrivacySettings{UserID: userID.Uid}
+	err = tx.QueryRow(context.Background(), getPrivacySettingsQuery, userID.Uid).Scan(&settings.HidePresence, &settings.IsPrivate)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return &PrivacySettings{}, entity.UserNotFoundError
+		}
+		return &PrivacySettings{}, err
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &PrivacySettings{}, entity.TransactionCommitError
+	}
+	return &settings, nil
+}
+
+const savePrivacySettingsQuery string = "UPDATE Users\n" +
+	"SET hide_presence=$1, is_private=$2\n" +
+	"WHERE userID=$3"
+
+// SavePrivacySettings saves user's privacy settings
+// It returns UserNotFoundError if there is no such user
+func (s *service) SavePrivacySettings(ctx context.Context, settings *PrivacySettings) (*Error, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	commandTag, err := tx.Exec(context.Background(), savePrivacySettingsQuery, settings.HidePresence, settings.IsPrivate, settings.UserID)
+	if err != nil {
+		return &Error{}, err
+	}
+	if commandTag.RowsAffected() != 1 {
+		return &Error{}, entity.UserNotFoundError
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionCommitError
+	}
+	return &Error{}, nil
+}
+
+const blockQuery string = "INSERT INTO Blocks(blockerID, blockedID) VALUES ($1, $2)"
+
+// Block makes BlockerID block BlockedID, removing follow relations and follow requests between them in both directions
+// Follows of each other's boards are removed too
+// It returns BlockAlreadyExistsError if block already exists, UserNotFoundError if there is no such user
+func (s *service) Block(ctx context.Context, blocks *Blocks) (*Error, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background()) // Will help if one of follow removals fails
+
+	_, err = tx.Exec(context.Background(), blockQuery, blocks.BlockerID, blocks.BlockedID)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "Duplicate") {
+			return &Error{}, entity.BlockAlreadyExistsError
+		}
+		if strings.Contains(err.Error(), `violates foreign key constraint "blocks_users_blocked"`) {
+			return &Error{}, entity.UserNotFoundError
+		}
+		if strings.Contains(err.Error(), `violates foreign key constraint "blocks_users_blocker"`) { // Actually does not usually happen because of checks in middleware
+			return &Error{}, entity.UserNotFoundError
+		}
+
+		return &Error{}, err
+	}
+
+	err = removeFollowIfExists(tx, blocks.BlockerID, blocks.BlockedID)
+	if err != nil {
+		return &Error{}, err
+	}
+
+	err = removeFollowIfExists(tx, blocks.BlockedID, blocks.BlockerID)
+	if err != nil {
+		return &Error{}, err
+	}
+
+	_, err = tx.Exec(context.Background(), deleteFollowRequestsBetweenQuery, blocks.BlockerID, blocks.BlockedID)
+	if err != nil {
+		return &Error{}, err
+	}
+
+	_, err = tx.Exec(context.Background(), deleteBoardFollowsBetweenQuery, blocks.BlockerID, blocks.BlockedID)
+	if err != nil {
+		return &Error{}, entity.FollowCountUpdateError
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionCommitError
+	}
+	return &Error{}, nil
+}
+
+// removeFollowIfExists deletes follow relation and updates follow counters if first user follows second one
+func removeFollowIfExists(tx pgx.Tx, followerID int64, followedID int64) error {
+	result, err := tx.Exec(context.Background(), unfollowQuery, followerID, followedID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() != 1 {
+		return nil
+	}
+
+	_, err = tx.Exec(context.Background(), updateUnfollowingQuery, followerID)
+	if err != nil {
+		return entity.FollowCountUpdateError
+	}
+
+	_, err = tx.Exec(context.Background(), updateUnfollowedByQuery, followedID)
+	if err != nil {
+		return entity.FollowCountUpdateError
+	}
+	return nil
+}
+
+const deleteFollowRequestsBetweenQuery string = "DELETE FROM Follow_requests\n" +
+	"WHERE (followerID=$1 AND followedID=$2) OR (followerID=$2 AND followedID=$1)"
+
+const deleteBoardFollowsBetweenQuery string = "WITH removed AS (\n" +
+	"DELETE FROM board_followers USING Boards\n" +
+	"WHERE board_followers.boardID = Boards.boardID\n" +
+	"AND ((board_followers.userID=$1 AND Boards.userID=$2) OR (board_followers.userID=$2 AND Boards.userID=$1))\n" +
+	"RETURNING board_followers.boardID)\n" +
+	"UPDATE Boards SET followers_count = followers_count - 1 WHERE boardID IN (SELECT boardID FROM removed)"
+
+const unblockQuery string = "DELETE FROM Blocks WHERE blockerID=$1 AND blockedID=$2"
+
+// Unblock removes block of BlockedID by BlockerID
+// It returns BlockNotFoundError if there was no such block
+func (s *service) Unblock(ctx context.Context, blocks *Blocks) (*Error, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	result, err := tx.Exec(context.Background(), unblockQuery, blocks.BlockerID, blocks.BlockedID)
+	if err != nil {
+		return &Error{}, err
+	}
+	if result.RowsAffected() != 1 {
+		return &Error{}, entity.BlockNotFoundError
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionCommitError
+	}
+	return &Error{}, nil
+}
+
+const getBlocksBetweenQuery string = "SELECT blockerID FROM Blocks\n" +
+	"WHERE (blockerID=$1 AND blockedID=$2) OR (blockerID=$2 AND blockedID=$1)"
+
+// GetBlockStatus checks if BlockerID blocked BlockedID and vice versa
+func (s *service) GetBlockStatus(ctx context.Context, blocks *Blocks) (*BlockStatus, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &BlockStatus{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	rows, err := tx.Query(context.Background(), getBlocksBetweenQuery, blocks.BlockerID, blocks.BlockedID)
+	if err != nil {
+		return &BlockStatus{}, err
+	}
+
+	blockStatus := BlockStatus{}
+	for rows.Next() {
+		var blockerID int64
+		err = rows.Scan(&blockerID)
+		if err != nil {
+			return &BlockStatus{}, err
+		}
+
+		switch blockerID {
+		case blocks.BlockerID:
+			blockStatus.IsBlocked = true
+		case blocks.BlockedID:
+			blockStatus.IsBlockedBy = true
+		}
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &BlockStatus{}, entity.TransactionCommitError
+	}
+	return &blockStatus, nil
+}
+
+const getFollowRequestsQuery = "SELECT userID, username, email, first_name, last_name, avatar, " +
+	"followed_by, following, boards_count, pins_count, vk_id\n" +
+	"FROM Users\n" +
+	"INNER JOIN (SELECT * FROM Follow_requests WHERE followedID = $1) as users_requests\n" +
+	"ON followerID = userID"
+
+// GetFollowRequests fetches all users that requested to follow user with passed ID
+// It returns slice of users, nil on success, nil, error on failure
+func (s *service) GetFollowRequests(ctx context.Context, userID *UserID) (*UsersListOutput, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return nil, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	requesters := make([]*UserOutput, 0)
+	rows, err := tx.Query(context.Background(), getFollowRequestsQuery, userID.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		user := UserOutput{}
+		firstNamePtr := new(string)
+		secondNamePtr := new(string)
+		avatarPtr := new(string)
+
+		err = rows.Scan(&user.UserID, &user.Username, &user.Email, &firstNamePtr,
+			&secondNamePtr, &avatarPtr, &user.FollowedBy, &user.Following,
+			&user.BoardsCount, &user.PinsCount, &user.VkID)
+		if err != nil {
+			return nil, entity.UserScanError
+		}
+
+		user.FirstName = *emptyIfNil(firstNamePtr)
+		user.LastName = *emptyIfNil(secondNamePtr)
+		user.Avatar = *emptyIfNil(avatarPtr)
+		requesters = append(requesters, &user)
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return nil, entity.TransactionCommitError
+	}
+
+	return &UsersListOutput{Users: requesters}, nil
+}
+
+const deleteFollowRequestQuery string = "DELETE FROM Follow_requests WHERE followerID=$1 AND followedID=$2"
+
+// AcceptFollowRequest makes FollowerID follow FollowedID if there was such follow request
+// It returns FollowRequestNotFoundError if there was no such request
+func (s *service) AcceptFollowRequest(ctx context.Context, follows *Follows) (*Error, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background()) // Request is kept if follow could not be added
+
+	result, err := tx.Exec(context.Background(), deleteFollowRequestQuery, follows.FollowerID, follows.FollowedID)
+	if err != nil {
+		return &Error{}, err
+	}
+	if result.RowsAffected() != 1 {
+		return &Error{}, entity.FollowRequestNotFoundError
+	}
+
+	err = addFollow(tx, follows.FollowerID, follows.FollowedID)
+	if err != nil {
+		return &Error{}, err
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionCommitError
+	}
+	return &Error{}, nil
+}
+
+// RejectFollowRequest removes request of FollowerID to follow FollowedID
+// It returns FollowRequestNotFoundError if there was no such request
+func (s *service) RejectFollowRequest(ctx context.Context, follows *Follows) (*Error, error) {
+	tx, err := s.db.Begin(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionBeginError
+	}
+	defer tx.Rollback(context.Background())
+
+	result, err := tx.Exec(context.Background(), deleteFollowRequestQuery, follows.FollowerID, follows.FollowedID)
+	if err != nil {
+		return &Error{}, err
+	}
+	if result.RowsAffected() != 1 {
+		return &Error{}, entity.FollowRequestNotFoundError
+	}
+
+	err = tx.Commit(context.Background())
+	if err != nil {
+		return &Error{}, entity.TransactionCommitError
+	}
+	return &Error{}, nil
+}
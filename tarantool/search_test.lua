@@ -0,0 +1,42 @@
+-- Checks message search on empty instance, run with "tarantool search_test.lua"
+local fio = require('fio')
+local data_dir = fio.tempdir()
+box.cfg{memtx_dir = data_dir, wal_dir = data_dir, vinyl_dir = data_dir, wal_mode = 'none'}
+dofile((debug.getinfo(1, 'S').source:sub(2):match('(.*/)') or '') .. 'tarantool-create.lua')
+
+local tap = require('tap')
+local test = tap.test('search')
+
+local function add_message(chat_id, text)
+    return box.space.messages:insert({nil, chat_id, 1, text, '', 0, 0, false, {}})[1]
+end
+
+test:plan(8)
+
+local rare_id = add_message(1, 'Common word, rare one')
+local common_ids = {}
+for i = 1, 30 do
+    table.insert(common_ids, add_message(1, 'Common word number ' .. i))
+end
+local short_id = add_message(1, 'We are at home')
+add_message(1, 'Attic is upstairs')
+local other_chat_id = add_message(2, 'Common word in other chat')
+
+test:is_deeply(search_messages({1}, 'common rare', 0, 1), {rare_id},
+    'rarest word is looked up, though common word alone has more entries than are checked')
+test:is_deeply(search_messages({1}, 'comm numb', 0, 2), {common_ids[30], common_ids[29]},
+    'words are matched by their beginning, newest first')
+test:is_deeply(search_messages({1}, 'comm numb', common_ids[29], 2), {common_ids[28], common_ids[27]},
+    'only messages before passed one are returned')
+test:is_deeply(search_messages({1}, 'at', 0, 10), {short_id}, 'short word matches whole words only')
+test:is_deeply(search_messages({1}, 'common missing', 0, 10), {}, 'word missing from chat finds nothing')
+test:is_deeply(search_messages({1, 2}, 'common word', 0, 2), {other_chat_id, short_id - 1},
+    'results of several chats are merged newest first')
+test:is_deeply(search_messages({1}, ' ?! ', 0, 10), {}, 'query without words finds nothing')
+
+box.space.messages:update(rare_id, {{'=', 4, 'Edited text'}})
+test:is_deeply(search_messages({1}, 'rare', 0, 10), {}, 'edited message is found by its new text only')
+
+local ok = test:check()
+fio.rmtree(data_dir)
+os.exit(ok and 0 or 1)
@@ -4,13 +4,22 @@ box.schema.user.passwd('pass')
 function restore_sessions_schema()
     sessions = box.schema.space.create('sessions')
     sessions:format({
+             {name = 'session_id', type = 'unsigned'},
              {name = 'user_id', type = 'unsigned'},
              {name = 'session_value', type = 'string'},
-             {name = 'expiration_date', type = 'unsigned'}
+             {name = 'expiration_date', type = 'unsigned'},
+             {name = 'device', type = 'string'},
+             {name = 'ip', type = 'string'},
+             {name = 'user_agent', type = 'string'},
+             {name = 'creation_date', type = 'unsigned'},
+             {name = 'last_seen', type = 'unsigned'},
              })
+
+    box.schema.sequence.create('session_id_sequence')
     sessions:create_index('primary', {
              type = 'tree',
-             parts = {'user_id'},
+             parts = {'session_id'},
+             sequence = 'session_id_sequence',
              unique = true
              })
     sessions:create_index('secondary', {
@@ -18,6 +27,16 @@ function restore_sessions_schema()
              parts = {'session_value'},
              unique= true
              })
+    sessions:create_index('by_user', {
+             type = 'tree',
+             parts = {'user_id'},
+             unique = false
+             })
+end
+
+-- Sessions used to be unique per user, such space can't be migrated and is recreated (logging everyone out once)
+if box.space.sessions ~= nil and box.space.sessions.index.by_user == nil then
+    box.space.sessions:drop()
 end
 
 pcall(restore_sessions_schema)
@@ -53,10 +72,9 @@ function restore_chats_schema()
     chats = box.schema.space.create('chats')
     chats:format({
              {name = 'chat_id', type = 'unsigned'},
-             {name = 'first_user_id', type = 'unsigned'},
-             {name = 'second_user_id', type = 'unsigned'},
-             {name = 'first_user_read', type = 'boolean'},
-             {name = 'second_user_read', type = 'boolean'},
+             {name = 'chat_type', type = 'string'}, -- 'direct' or 'group'
+             {name = 'title', type = 'string'},
+             {name = 'avatar_link', type = 'string'},
              })
 
     box.schema.sequence.create('chat_id_sequence')
@@ -66,26 +84,56 @@ function restore_chats_schema()
              sequence = 'chat_id_sequence',
              unique = true
              })
+end
 
-    chats:create_index('secondary', {
+pcall(restore_chats_schema)
+
+function restore_chat_members_schema()
+    chat_members = box.schema.space.create('chat_members')
+    chat_members:format({
+             {name = 'chat_id', type = 'unsigned'},
+             {name = 'user_id', type = 'unsigned'},
+             {name = 'role', type = 'string'}, -- 'owner', 'admin' or 'member'
+             {name = 'last_read_message_id', type = 'unsigned'},
+             {name = 'last_delivered_message_id', type = 'unsigned', is_nullable = true},
+             })
+
+    chat_members:create_index('primary', {
              type = 'tree',
-             parts = {'first_user_id', 'second_user_id'},
+             parts = {'chat_id', 'user_id'},
              unique = true
              })
-
-    chats:create_index('by_first_user', {
+    chat_members:create_index('by_user', {
              type = 'tree',
-             parts = {'first_user_id'},
+             parts = {'user_id'},
              unique = false
              })
-    chats:create_index('by_second_user', {
+end
+
+pcall(restore_chat_members_schema)
+
+-- There can be only one direct chat between two users, first_user_id is always the smaller one
+function restore_direct_chats_schema()
+    direct_chats = box.schema.space.create('direct_chats')
+    direct_chats:format({
+             {name = 'first_user_id', type = 'unsigned'},
+             {name = 'second_user_id', type = 'unsigned'},
+             {name = 'chat_id', type = 'unsigned'},
+             })
+
+    direct_chats:create_index('primary', {
              type = 'tree',
-             parts = {'second_user_id'},
-             unique = false
+             parts = {'first_user_id', 'second_user_id'},
+             unique = true
+             })
+    direct_chats:create_index('by_chat', {
+             type = 'tree',
+             parts = {'chat_id'},
+             unique = true
              })
 end
 
-pcall(restore_chats_schema)
+pcall(restore_direct_chats_schema)
 
 function restore_messages_schema()
     messages = box.schema.space.create('messages')
@@ -95,6 +143,10 @@ function restore_messages_schema()
              {name = 'author_id', type = 'unsigned'},
              {name = 'text', type = 'string'},
              {name = 'creation_time', type = 'string'},
+             {name = 'creation_date', type = 'unsigned', is_nullable = true}, -- Unix time, used to check if message can still be changed
+             {name = 'edit_date', type = 'unsigned', is_nullable = true}, -- 0 if message was never edited
+             {name = 'is_deleted', type = 'boolean', is_nullable = true},
+             {name = 'attachments', type = 'array', is_nullable = true}, -- {type, id} pairs of shared pins and boards
              })
 
     box.schema.sequence.create('message_id_sequence')
@@ -106,9 +158,614 @@ function restore_messages_schema()
              })
     messages:create_index('secondary', {
              type = 'tree',
-             parts = {'chat_id'},
+             parts = {'chat_id', 'message_id'},
+             unique = true
+             })
+end
+
+pcall(restore_messages_schema)
+
+-- Messages used to be indexed by chat only, index is extended so that chat history could be paginated
+if #box.space.messages.index.secondary.parts == 1 then
+    box.space.messages.index.secondary:alter({parts = {'chat_id', 'message_id'}, unique = true})
+end
+
+-- Messages could not be edited or deleted before, and members' delivery state was not tracked
+if #box.space.messages:format() == 5 then
+    local format = box.space.messages:format()
+    table.insert(format, {name = 'creation_date', type = 'unsigned', is_nullable = true})
+    table.insert(format, {name = 'edit_date', type = 'unsigned', is_nullable = true})
+    table.insert(format, {name = 'is_deleted', type = 'boolean', is_nullable = true})
+    box.space.messages:format(format)
+end
+if box.space.chat_members ~= nil and #box.space.chat_members:format() == 4 then
+    local format = box.space.chat_members:format()
+    table.insert(format, {name = 'last_delivered_message_id', type = 'unsigned', is_nullable = true})
+    box.space.chat_members:format(format)
+end
+
+-- Messages could not carry attachments before
+if #box.space.messages:format() == 8 then
+    local format = box.space.messages:format()
+    table.insert(format, {name = 'attachments', type = 'array', is_nullable = true})
+    box.space.messages:format(format)
+end
+
+-- Chats used to keep exactly two users and their read flags, they are turned into direct chats with two members
+-- Unread chat is marked as read up to the message before the last one
+function migrate_two_user_chats()
+    local old_chats = box.space.chats:select()
+    for _, index_name in ipairs({'secondary', 'by_first_user', 'by_second_user'}) do
+        box.space.chats.index[index_name]:drop()
+    end
+    box.space.chats:format({})
+
+    for _, chat in ipairs(old_chats) do
+        local chat_id = chat[1]
+        local last_messages = box.space.messages.index.secondary:select({chat_id}, {iterator = 'LE', limit = 2})
+        local last_message_id, previous_message_id = 0, 0
+        if #last_messages > 0 and last_messages[1][2] == chat_id then
+            last_message_id = last_messages[1][1]
+        end
+        if #last_messages > 1 and last_messages[2][2] == chat_id then
+            previous_message_id = last_messages[2][1]
+        end
+
+        for i = 2, 3 do
+            local last_read_message_id = previous_message_id
+            if chat[i + 2] then
+                last_read_message_id = last_message_id
+            end
+            box.space.chat_members:replace({chat_id, chat[i], 'member', last_read_message_id, last_read_message_id})
+        end
+        box.space.direct_chats:replace({math.min(chat[2], chat[3]), math.max(chat[2], chat[3]), chat_id})
+        box.space.chats:replace({chat_id, 'direct', '', ''})
+    end
+
+    box.space.chats:format({
+             {name = 'chat_id', type = 'unsigned'},
+             {name = 'chat_type', type = 'string'},
+             {name = 'title', type = 'string'},
+             {name = 'avatar_link', type = 'string'},
+             })
+end
+
+if box.space.chats.index.by_first_user ~= nil then
+    migrate_two_user_chats()
+end
+
+function create_direct_chat(first_user_id, second_user_id)
+    return box.atomic(function()
+        local chat = box.space.chats:insert({nil, 'direct', '', ''})
+        box.space.direct_chats:insert({math.min(first_user_id, second_user_id), math.max(first_user_id, second_user_id), chat[1]})
+        box.space.chat_members:insert({chat[1], first_user_id, 'member', 0, 0})
+        box.space.chat_members:insert({chat[1], second_user_id, 'member', 0, 0})
+        return chat[1]
+    end)
+end
+
+-- members is a list of {user_id, role} pairs
+function create_group_chat(title, avatar_link, members)
+    return box.atomic(function()
+        local chat = box.space.chats:insert({nil, 'group', title, avatar_link})
+        for _, member in ipairs(members) do
+            box.space.chat_members:insert({chat[1], member[1], member[2], 0, 0})
+        end
+        return chat[1]
+    end)
+end
+
+-- Moves member's delivered and read pointers forward in one transaction, so that concurrent updates can't move them back
+-- Returns updated member, nothing if user is not in chat
+function move_chat_member_receipts(chat_id, user_id, last_delivered_message_id, last_read_message_id)
+    return box.atomic(function()
+        local member = box.space.chat_members:get({chat_id, user_id})
+        if member == nil then
+            return nil
+        end
+        local old_delivered_message_id = member[5] or member[4] -- Members saved before delivery was tracked have no such field
+        return box.space.chat_members:update({chat_id, user_id}, {
+            {'=', 4, math.max(member[4], last_read_message_id)},
+            {'=', 5, math.max(old_delivered_message_id, last_delivered_message_id, last_read_message_id)},
+        })
+    end)
+end
+
+-- Counts chat's messages newer than last_read_message_id, stopping at max_count
+function count_unread_messages(chat_id, last_read_message_id, max_count)
+    local count = 0
+    for _, message in box.space.messages.index.secondary:pairs({chat_id, last_read_message_id}, {iterator = 'GT'}) do
+        if message[2] ~= chat_id or count >= max_count then
+            break
+        end
+        if message[8] ~= true then -- Deleted messages are not counted
+            count = count + 1
+        end
+    end
+    return count
+end
+
+function delete_chat(chat_id)
+    box.atomic(function()
+        local member_keys = {}
+        for _, member in box.space.chat_members:pairs({chat_id}) do
+            table.insert(member_keys, {member[1], member[2]})
+        end
+        for _, key in ipairs(member_keys) do
+            box.space.chat_members:delete(key)
+        end
+
+        local message_ids = {}
+        for _, message in box.space.messages.index.secondary:pairs({chat_id}) do
+            table.insert(message_ids, message[1])
+        end
+        for _, message_id in ipairs(message_ids) do
+            box.space.messages:delete(message_id)
+        end
+
+        box.space.direct_chats.index.by_chat:delete(chat_id)
+        box.space.chats:delete(chat_id)
+    end)
+end
+-- Real-time events are passed between server instances through this space
+-- Each instance long-polls it with wait_realtime_events, events are kept for a short time only
+local fiber = require('fiber')
+
+realtime_events_retention = 60 -- In seconds
+realtime_events_cond = fiber.cond()
+
+function restore_realtime_events_schema()
+    realtime_events = box.schema.space.create('realtime_events')
+    realtime_events:format({
+             {name = 'event_id', type = 'unsigned'},
+             {name = 'user_id', type = 'unsigned'},
+             {name = 'payload', type = 'string'},
+             {name = 'creation_time', type = 'unsigned'},
+             })
+
+    box.schema.sequence.create('realtime_event_id_sequence')
+    realtime_events:create_index('primary', {
+             type = 'tree',
+             parts = {'event_id'},
+             sequence = 'realtime_event_id_sequence',
+             unique = true
+             })
+end
+
+pcall(restore_realtime_events_schema)
+
+-- Control events (e.g. ended sessions) are told apart from messages for clients, they have empty payload
+if #box.space.realtime_events:format() == 4 then
+    local format = box.space.realtime_events:format()
+    table.insert(format, {name = 'control_event', type = 'string', is_nullable = true})
+    box.space.realtime_events:format(format)
+end
+
+function publish_realtime_event(user_id, payload, control_event)
+    local event = box.space.realtime_events:insert({nil, user_id, payload, fiber.time64() / 1000000ULL, control_event})
+    realtime_events_cond:broadcast()
+    return event[1]
+end
+
+function last_realtime_event_id()
+    local event = box.space.realtime_events.index.primary:max()
+    if event == nil then
+        return 0
+    end
+    return event[1]
+end
+
+function wait_realtime_events(after_id, timeout)
+    local events = box.space.realtime_events:select({after_id}, {iterator = 'GT', limit = 1000})
+    if #events == 0 then
+        realtime_events_cond:wait(timeout)
+        events = box.space.realtime_events:select({after_id}, {iterator = 'GT', limit = 1000})
+    end
+    return events
+end
+
+fiber.create(function()
+    while true do
+        fiber.sleep(realtime_events_retention)
+        local expiration_time = fiber.time64() / 1000000ULL - realtime_events_retention
+        local expired_ids = {}
+        for _, event in box.space.realtime_events:pairs() do
+            if event[4] >= expiration_time then
+                break
+            end
+            table.insert(expired_ids, event[1])
+        end
+        for _, event_id in ipairs(expired_ids) do
+            box.space.realtime_events:delete(event_id)
+        end
+    end
+end)
+
+-- Outbox keeps every real-time event sent to user, so that reconnected clients could get what they missed
+-- Events are numbered per user, numbers only grow, even after old events are removed
+outbox_retention = 24 * 60 * 60 -- In seconds
+outbox_max_events_per_user = 1000
+
+function restore_outbox_schema()
+    outbox_counters = box.schema.space.create('outbox_counters')
+    outbox_counters:format({
+             {name = 'user_id', type = 'unsigned'},
+             {name = 'last_sequence', type = 'unsigned'},
+             })
+    outbox_counters:create_index('primary', {
+             type = 'tree',
+             parts = {'user_id'},
+             unique = true
+             })
+
+    outbox = box.schema.space.create('outbox')
+    outbox:format({
+             {name = 'user_id', type = 'unsigned'},
+             {name = 'sequence', type = 'unsigned'},
+             {name = 'payload', type = 'string'},
+             {name = 'creation_time', type = 'unsigned'},
+             })
+    outbox:create_index('primary', {
+             type = 'tree',
+             parts = {'user_id', 'sequence'},
+             unique = true
+             })
+    outbox:create_index('by_creation_time', {
+             type = 'tree',
+             parts = {'creation_time'},
              unique = false
              })
 end
 
-pcall(restore_messages_schema)
\ No newline at end of file
+pcall(restore_outbox_schema)
+
+function last_outbox_sequence(user_id)
+    local counter = box.space.outbox_counters:get(user_id)
+    if counter == nil then
+        return 0
+    end
+    return counter[2]
+end
+
+function add_outbox_event(user_id, payload)
+    return box.atomic(function()
+        local sequence = last_outbox_sequence(user_id) + 1
+        box.space.outbox_counters:replace({user_id, sequence})
+        box.space.outbox:insert({user_id, sequence, payload, fiber.time64() / 1000000ULL})
+
+        if sequence > outbox_max_events_per_user then
+            box.space.outbox:delete({user_id, sequence - outbox_max_events_per_user})
+        end
+        return sequence
+    end)
+end
+
+-- Returns events with sequence greater than after_sequence and true,
+-- or empty list and false if some of them were already removed
+function get_outbox_events(user_id, after_sequence)
+    local last_sequence = last_outbox_sequence(user_id)
+    if after_sequence > last_sequence then -- Client has seen events that do not exist anymore
+        return {}, false
+    end
+    if after_sequence == last_sequence then
+        return {}, true
+    end
+
+    local events = box.space.outbox:select({user_id, after_sequence}, {iterator = 'GT', limit = outbox_max_events_per_user})
+    local user_events = {}
+    for _, event in ipairs(events) do
+        if event[1] ~= user_id then
+            break
+        end
+        table.insert(user_events, event)
+    end
+
+    if #user_events == 0 or user_events[1][2] ~= after_sequence + 1 then
+        return {}, false
+    end
+    return user_events, true
+end
+
+fiber.create(function()
+    while true do
+        fiber.sleep(60)
+        local expiration_time = fiber.time64() / 1000000ULL - outbox_retention
+        local expired_keys = {}
+        for _, event in box.space.outbox.index.by_creation_time:pairs({expiration_time}, {iterator = 'LT'}) do
+            table.insert(expired_keys, {event[1], event[2]})
+        end
+        for _, key in ipairs(expired_keys) do
+            box.space.outbox:delete(key)
+        end
+    end
+end)
+
+-- Presence tells if user has live connections to any server instance
+-- Instances keep marking their connected users online for a short time, so users of crashed instances go offline by themselves
+function restore_presence_schema()
+    presence = box.schema.space.create('presence')
+    presence:format({
+             {name = 'user_id', type = 'unsigned'},
+             {name = 'online_until', type = 'unsigned'}, -- Unix time, user is online while it has not come
+             {name = 'last_seen', type = 'unsigned'}, -- Unix time
+             })
+    presence:create_index('primary', {
+             type = 'tree',
+             parts = {'user_id'},
+             unique = true
+             })
+end
+
+pcall(restore_presence_schema)
+
+function set_users_online(user_ids, ttl)
+    local now = fiber.time64() / 1000000ULL
+    box.atomic(function()
+        for _, user_id in ipairs(user_ids) do
+            box.space.presence:upsert({user_id, now + ttl, now}, {{'=', 2, now + ttl}, {'=', 3, now}})
+        end
+    end)
+end
+
+-- User stays online until online_until, as they may still be connected to some other instance
+function set_user_last_seen(user_id)
+    local now = fiber.time64() / 1000000ULL
+    box.space.presence:upsert({user_id, 0, now}, {{'=', 3, now}})
+end
+
+-- Returns if user is online and when they were last seen, 0 if never
+function get_user_presence(user_id)
+    local user_presence = box.space.presence:get(user_id)
+    if user_presence == nil then
+        return false, 0
+    end
+    return user_presence[2] > fiber.time64() / 1000000ULL, user_presence[3]
+end
+
+-- Search index keeps every word of every message, so that messages could be found by words' beginnings
+-- It is kept up to date by trigger, so added, edited and deleted messages are indexed without any extra calls
+search_max_word_length = 32 -- In characters, longer words are indexed by their beginning
+search_min_prefix_length = 3 -- In characters, shorter query words match whole words only, as their beginnings are too common
+search_max_prefix_words = 100 -- Query word is searched as the beginning of at most that many alphabetically first words of chat
+search_rarity_factor = 10 -- Query words are counted up to limit * search_rarity_factor entries, words with more are equally common
+
+function restore_message_words_schema()
+    message_words = box.schema.space.create('message_words')
+    message_words:format({
+             {name = 'chat_id', type = 'unsigned'},
+             {name = 'word', type = 'string'},
+             {name = 'message_id', type = 'unsigned'},
+             })
+    message_words:create_index('primary', {
+             type = 'tree',
+             parts = {'chat_id', 'word', 'message_id'},
+             unique = true
+             })
+    message_words:create_index('by_message', {
+             type = 'tree',
+             parts = {'message_id', 'word'},
+             unique = true
+             })
+end
+
+pcall(restore_message_words_schema)
+
+-- Keeps how far background indexing of messages sent before search was introduced has gone
+function restore_search_progress_schema()
+    search_progress = box.schema.space.create('search_progress')
+    search_progress:format({
+             {name = 'name', type = 'string'},
+             {name = 'last_message_id', type = 'unsigned'},
+             {name = 'is_complete', type = 'boolean'},
+             })
+    search_progress:create_index('primary', {
+             type = 'tree',
+             parts = {'name'},
+             unique = true
+             })
+end
+
+pcall(restore_search_progress_schema)
+
+-- Splits text into distinct lowercase words, anything but ASCII letters and digits and non-ASCII characters separates words
+function split_words(text)
+    local words = {}
+    local seen = {}
+    for word in utf8.lower(text):gmatch('[^%s%p%c]+') do
+        word = utf8.sub(word, 1, search_max_word_length)
+        if not seen[word] then
+            seen[word] = true
+            table.insert(words, word)
+        end
+    end
+    return words
+end
+
+local function index_message_words(old_message, new_message)
+    if old_message ~= nil then
+        local old_keys = {}
+        for _, entry in box.space.message_words.index.by_message:pairs({old_message[1]}) do
+            table.insert(old_keys, {entry[1], entry[2], entry[3]})
+        end
+        for _, key in ipairs(old_keys) do
+            box.space.message_words:delete(key)
+        end
+    end
+
+    if new_message ~= nil and new_message[8] ~= true then
+        for _, word in ipairs(split_words(new_message[4])) do
+            box.space.message_words:replace({new_message[2], word, new_message[1]})
+        end
+    end
+end
+
+box.space.messages:on_replace(index_message_words)
+
+-- Messages sent before search was introduced are indexed in background
+-- Progress is saved with every batch, so that indexing goes on from where it stopped if instance was restarted
+local search_indexing = box.space.search_progress:get('message_words')
+if search_indexing == nil or not search_indexing[3] then
+    fiber.create(function()
+        local last_message_id = 0
+        if search_indexing ~= nil then
+            last_message_id = search_indexing[2]
+        end
+
+        while true do
+            local batch = box.space.messages:select({last_message_id}, {iterator = 'GT', limit = 1000})
+            if #batch == 0 then
+                box.space.search_progress:replace({'message_words', last_message_id, true})
+                break
+            end
+            box.atomic(function()
+                for _, message in ipairs(batch) do
+                    index_message_words(nil, message)
+                end
+                last_message_id = batch[#batch][1]
+                box.space.search_progress:replace({'message_words', last_message_id, false})
+            end)
+            fiber.yield()
+        end
+    end)
+end
+
+-- Returns true if query word matches word of message, short query words have to match it as a whole
+local function term_matches(word, term)
+    if utf8.len(term) < search_min_prefix_length then
+        return word == term
+    end
+    return word:sub(1, #term) == term
+end
+
+-- Returns true if message has words matching each of terms
+local function message_has_terms(message_id, terms)
+    for _, term in ipairs(terms) do
+        local entry = box.space.message_words.index.by_message:select({message_id, term}, {iterator = 'GE', limit = 1})[1]
+        if entry == nil or entry[3] ~= message_id or not term_matches(entry[2], term) then
+            return false
+        end
+    end
+    return true
+end
+
+-- Counts chat's index entries with words matching term, stopping as soon as max_count is reached
+local function count_term_entries(chat_id, term, max_count)
+    local count = 0
+    for _, entry in box.space.message_words.index.primary:pairs({chat_id, term}, {iterator = 'GE'}) do
+        if entry[1] ~= chat_id or not term_matches(entry[2], term) or count >= max_count then
+            break
+        end
+        count = count + 1
+    end
+    return count
+end
+
+-- Returns term which matches the fewest of chat's indexed words, or nil if some of terms match none of them
+-- Terms are counted up to max_count entries, terms that reach it are equally common and the longest of them is taken
+local function rarest_term(chat_id, terms, max_count)
+    local rarest, rarest_count = nil, nil
+    for _, term in ipairs(terms) do
+        local count = count_term_entries(chat_id, term, max_count)
+        if count == 0 then
+            return nil
+        end
+        if rarest == nil or count < rarest_count or (count == rarest_count and #term > #rarest) then
+            rarest, rarest_count = term, count
+        end
+    end
+    return rarest
+end
+
+-- Returns index entry of word's newest message in chat sent before before_message_id (0 means newest), or nil
+local function newest_word_entry(chat_id, word, before_message_id)
+    local key, iterator = {chat_id, word}, 'LE'
+    if before_message_id ~= 0 then
+        key, iterator = {chat_id, word, before_message_id}, 'LT'
+    end
+
+    local entry = box.space.message_words.index.primary:select(key, {iterator = iterator, limit = 1})[1]
+    if entry == nil or entry[1] ~= chat_id or entry[2] ~= word then
+        return nil
+    end
+    return entry
+end
+
+-- Returns iterator over IDs of chat's messages sent before before_message_id (0 means newest)
+-- that have words matching term, newest first
+-- Term is looked up as at most search_max_prefix_words words, so that its cost does not grow with chat's vocabulary
+local function term_message_ids(chat_id, term, before_message_id)
+    local primary = box.space.message_words.index.primary
+
+    local entries = {} -- Newest not yet returned entry of every word matching term
+    local entry = primary:select({chat_id, term}, {iterator = 'GE', limit = 1})[1]
+    local words = 0
+    while entry ~= nil and entry[1] == chat_id and term_matches(entry[2], term) and words < search_max_prefix_words do
+        local word_entry = newest_word_entry(chat_id, entry[2], before_message_id)
+        if word_entry ~= nil then
+            table.insert(entries, word_entry)
+        end
+        words = words + 1
+        entry = primary:select({chat_id, entry[2]}, {iterator = 'GT', limit = 1})[1] -- Skipping to the next word
+    end
+
+    return function()
+        local message_id = nil
+        for _, word_entry in pairs(entries) do
+            if message_id == nil or word_entry[3] > message_id then
+                message_id = word_entry[3]
+            end
+        end
+        if message_id == nil then
+            return nil
+        end
+
+        for i, word_entry in pairs(entries) do -- Message is returned once, even if several of its words match term
+            if word_entry[3] == message_id then
+                entries[i] = newest_word_entry(chat_id, word_entry[2], message_id)
+            end
+        end
+        return message_id
+    end
+end
+
+-- Returns IDs of newest messages sent to passed chats before before_message_id (0 means newest)
+-- that have words starting with each of query's words (or equal to them, if they are short)
+-- Candidates are taken from the index by the rarest of query's words, every step of the lookup is capped,
+-- so cost of search depends on limit and number of chats, not on size of chats' history
+-- At most limit * search_rarity_factor candidates are checked in every chat, so if all of query's words are common
+-- and rarely met together, older matching messages may be missed
+function search_messages(chat_ids, query, before_message_id, limit)
+    local terms = split_words(query)
+    if #terms == 0 then
+        return {}
+    end
+
+    local max_candidates = limit * search_rarity_factor
+    local found = {}
+    for _, chat_id in ipairs(chat_ids) do
+        local term = rarest_term(chat_id, terms, max_candidates)
+        if term ~= nil then
+            local chat_found, candidates = 0, 0
+            for message_id in term_message_ids(chat_id, term, before_message_id) do
+                if message_has_terms(message_id, terms) then
+                    table.insert(found, message_id)
+                    chat_found = chat_found + 1
+                    if chat_found >= limit then
+                        break
+                    end
+                end
+                candidates = candidates + 1
+                if candidates >= max_candidates then
+                    break
+                end
+            end
+        end
+    end
+
+    table.sort(found, function(first, second) return first > second end)
+    local result = {}
+    for i = 1, math.min(limit, #found) do
+        table.insert(result, found[i])
+    end
+    return result
+end